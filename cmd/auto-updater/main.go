@@ -6,24 +6,34 @@ package main
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/internal/fpath"
 	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pkcrypto"
 )
 
 var (
+	mon = monkit.Package()
+
 	rootCmd = &cobra.Command{
 		Use:   "auto-updater",
 		Short: "Auto-updater for storage node",
 	}
 	runCmd = &cobra.Command{
-		Use:   "run",
-		Short: "Run the auto updater for storage node",
+		Use:   "run [flags] [-- storage node arguments]",
+		Short: "Run the storage node and keep it updated",
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			err = cmdRun(cmd, args)
 			if err != nil {
@@ -37,6 +47,11 @@ var (
 	interval       string
 	versionURL     string
 	binaryLocation string
+	identityDir    string
+	publicKeyPath  string
+	healthURL      string
+	healthTimeout  time.Duration
+	requestTimeout time.Duration
 )
 
 func init() {
@@ -45,6 +60,11 @@ func init() {
 	runCmd.Flags().StringVar(&interval, "interval", "06h", "interval for checking the new version")
 	runCmd.Flags().StringVar(&versionURL, "version-url", "https://version.storj.io/release/", "version server URL")
 	runCmd.Flags().StringVar(&binaryLocation, "binary-location", "storagenode.exe", "the storage node executable binary location")
+	runCmd.Flags().StringVar(&identityDir, "identity-dir", fpath.ApplicationDir("storj", "identity", "storagenode"), "main directory for storagenode identity credentials")
+	runCmd.Flags().StringVar(&publicKeyPath, "public-key", "", "PEM file with the public key releases are signed with")
+	runCmd.Flags().StringVar(&healthURL, "health-url", "", "URL which responds with 200 OK when the storage node is healthy (default: the storage node has to keep running)")
+	runCmd.Flags().DurationVar(&healthTimeout, "health-timeout", time.Minute, "how long a new release has to pass the health check before it's rolled back")
+	runCmd.Flags().DurationVar(&requestTimeout, "request-timeout", 10*time.Minute, "timeout for version checks and downloads")
 }
 
 func cmdRun(cmd *cobra.Command, args []string) (err error) {
@@ -64,11 +84,46 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		return fmt.Errorf("unable to parse interval parameter: %v", err)
 	}
 
+	if publicKeyPath == "" {
+		return fmt.Errorf("no public key specified, use --public-key")
+	}
+	keyPEM, err := ioutil.ReadFile(publicKeyPath)
+	if err != nil {
+		return err
+	}
+	key, err := pkcrypto.PublicKeyFromPEM(keyPEM)
+	if err != nil {
+		return err
+	}
+
+	nodeID, err := identity.NodeIDFromCertPath(filepath.Join(identityDir, "identity.cert"))
+	if err != nil {
+		return fmt.Errorf("unable to load the node ID: %v", err)
+	}
+
+	log := zap.L()
+	updater := NewUpdater(log, Config{
+		VersionURL:     versionURL,
+		BinaryLocation: binaryLocation,
+		Args:           args,
+		NodeID:         nodeID,
+		HealthURL:      healthURL,
+		HealthTimeout:  healthTimeout,
+		RequestTimeout: requestTimeout,
+	}, key)
+
 	loop := sync2.NewCycle(loopInterval)
 	err = loop.Run(ctx, func(ctx context.Context) (err error) {
-		fmt.Println("check new version")
+		if _, err := updater.Update(ctx); err != nil {
+			log.Error("update failed", zap.Error(err))
+		}
+		// restart the storage node if it exited
+		if err := updater.Start(); err != nil {
+			log.Error("starting storage node failed", zap.Error(err))
+		}
 		return nil
 	})
+	err = errs.Combine(err, updater.Stop())
 	if err != context.Canceled {
 		return err
 	}
@@ -0,0 +1,376 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/version"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+)
+
+// stopTimeout is how long the storage node has to exit after it was
+// interrupted, before it is killed
+const stopTimeout = 30 * time.Second
+
+// Error is the class of errors returned by the updater
+var Error = errs.Class("auto-updater")
+
+// Config contains the configuration of the updater
+type Config struct {
+	// VersionURL is the address of the version control server
+	VersionURL string
+	// BinaryLocation is the path of the storage node binary
+	BinaryLocation string
+	// Args are the arguments the storage node is started with
+	Args []string
+	// NodeID is the ID of the storage node, it decides whether the node is
+	// inside the staged rollout of a release
+	NodeID storj.NodeID
+
+	// HealthURL, if set, must respond with 200 OK within HealthTimeout
+	// after the storage node was started. Otherwise the storage node must
+	// keep running for HealthTimeout.
+	HealthURL     string
+	HealthTimeout time.Duration
+
+	// RequestTimeout is the timeout of the requests to the version control
+	// server and of the downloads
+	RequestTimeout time.Duration
+}
+
+// Updater runs the storage node binary and replaces it with the suggested
+// release of the version control server. Releases which are rolled out in
+// stages are only installed once the node is inside the rollout. Releases are
+// verified against the pinned public key and rolled back if they fail the
+// health check.
+type Updater struct {
+	log    *zap.Logger
+	config Config
+	key    crypto.PublicKey
+
+	process *exec.Cmd
+	exited  chan struct{}
+	waitErr error
+
+	// rejected is the release which failed the health check. It isn't
+	// installed again.
+	rejected version.SemVer
+}
+
+// NewUpdater returns an updater which verifies releases with key
+func NewUpdater(log *zap.Logger, config Config, key crypto.PublicKey) *Updater {
+	return &Updater{
+		log:    log,
+		config: config,
+		key:    key,
+	}
+}
+
+// Start starts the storage node, unless it's running
+func (updater *Updater) Start() error {
+	if updater.Running() {
+		return nil
+	}
+
+	process := exec.Command(updater.config.BinaryLocation, updater.config.Args...)
+	process.Stdout = os.Stdout
+	process.Stderr = os.Stderr
+	if err := process.Start(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	exited := make(chan struct{})
+	updater.process, updater.exited = process, exited
+	go func() {
+		updater.waitErr = process.Wait()
+		close(exited)
+	}()
+	return nil
+}
+
+// Running returns whether the started storage node is still running
+func (updater *Updater) Running() bool {
+	if updater.process == nil {
+		return false
+	}
+	select {
+	case <-updater.exited:
+		return false
+	default:
+		return true
+	}
+}
+
+// Stop interrupts the storage node and kills it if it doesn't exit in time
+func (updater *Updater) Stop() error {
+	if !updater.Running() {
+		return nil
+	}
+
+	// interrupting isn't supported on Windows
+	if err := updater.process.Process.Signal(os.Interrupt); err != nil {
+		_ = updater.process.Process.Kill()
+	}
+
+	select {
+	case <-updater.exited:
+	case <-time.After(stopTimeout):
+		if err := updater.process.Process.Kill(); err != nil {
+			return Error.Wrap(err)
+		}
+		<-updater.exited
+	}
+	return nil
+}
+
+// Update installs the suggested release if it's newer than the installed
+// binary and the node is inside its rollout, and restarts the storage node. The previous binary is restored and
+// restarted if the release fails the health check.
+func (updater *Updater) Update(ctx context.Context) (updated bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	allowed, err := version.QueryAllowedVersions(ctx, updater.config.VersionURL, updater.config.RequestTimeout)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+
+	release := allowed.Processes.Storagenode.Suggested
+	if release.URL == "" {
+		return false, nil
+	}
+	suggested, err := version.NewSemVer(release.Version)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	if suggested == updater.rejected {
+		return false, nil
+	}
+
+	rollout := allowed.Rollouts.Storagenode
+	if rollout.Version != (version.SemVer{}) && suggested.Compare(rollout.Version) >= 0 && !rollout.Contains(updater.config.NodeID) {
+		updater.log.Debug("node is outside the rollout", zap.String("version", suggested.String()), zap.Int("cursor", rollout.Cursor))
+		return false, nil
+	}
+
+	current, err := binaryVersion(ctx, updater.config.BinaryLocation)
+	if err != nil {
+		return false, err
+	}
+	if suggested.Compare(current) <= 0 {
+		return false, nil
+	}
+
+	updater.log.Info("downloading release", zap.String("version", suggested.String()), zap.String("current", current.String()))
+
+	next, err := updater.download(ctx, release.ReleaseURL(runtime.GOOS, runtime.GOARCH))
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = os.Remove(next) }()
+
+	downloaded, err := binaryVersion(ctx, next)
+	if err != nil {
+		return false, err
+	}
+	if downloaded != suggested {
+		return false, Error.New("downloaded binary is %s instead of %s", downloaded.String(), suggested.String())
+	}
+
+	backup := updater.config.BinaryLocation + ".old"
+	if err := updater.Stop(); err != nil {
+		return false, err
+	}
+	if err := swap(updater.config.BinaryLocation, next, backup); err != nil {
+		return false, errs.Combine(err, updater.Start())
+	}
+
+	updater.log.Info("restarting storage node", zap.String("version", suggested.String()))
+	if err := updater.Start(); err == nil {
+		err = updater.healthCheck(ctx)
+		if err == nil {
+			return true, nil
+		}
+		updater.log.Error("release failed the health check, rolling back", zap.String("version", suggested.String()), zap.Error(err))
+	}
+
+	updater.rejected = suggested
+	err = errs.Combine(
+		updater.Stop(),
+		restore(updater.config.BinaryLocation, backup),
+	)
+	if err != nil {
+		return false, err
+	}
+	if err := updater.Start(); err != nil {
+		return false, err
+	}
+	return false, Error.New("release %s failed the health check and was rolled back", suggested.String())
+}
+
+// download downloads the binary at url and its detached signature at
+// url + ".sig" and verifies the signature. The verified binary is written to
+// an executable file next to the installed binary, so that it can be renamed
+// over it.
+func (updater *Updater) download(ctx context.Context, url string) (path string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	binary, err := updater.get(ctx, url)
+	if err != nil {
+		return "", err
+	}
+	signature, err := updater.get(ctx, url+".sig")
+	if err != nil {
+		return "", err
+	}
+	if err := pkcrypto.HashAndVerifySignature(updater.key, binary, signature); err != nil {
+		return "", Error.New("invalid signature of %s: %v", url, err)
+	}
+
+	file, err := ioutil.TempFile(filepath.Dir(updater.config.BinaryLocation), filepath.Base(updater.config.BinaryLocation)+".download-")
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	_, err = file.Write(binary)
+	err = errs.Combine(err, file.Chmod(0755), file.Close())
+	if err != nil {
+		return "", Error.Wrap(errs.Combine(err, os.Remove(file.Name())))
+	}
+	return file.Name(), nil
+}
+
+// get returns the body of url
+func (updater *Updater) get(ctx context.Context, url string) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	client := http.Client{Timeout: updater.config.RequestTimeout}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, Error.New("downloading %s failed: %s", url, resp.Status)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	return data, Error.Wrap(err)
+}
+
+// healthCheck waits until the health URL responds with 200 OK, or checks
+// that the storage node keeps running for the health timeout if there is no
+// health URL
+func (updater *Updater) healthCheck(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ctx, cancel := context.WithTimeout(ctx, updater.config.HealthTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(updater.config.HealthTimeout / 10)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-updater.exited:
+			return Error.New("storage node exited: %v", updater.waitErr)
+		case <-ctx.Done():
+			if updater.config.HealthURL == "" {
+				return nil
+			}
+			return Error.New("storage node didn't become healthy in %v", updater.config.HealthTimeout)
+		case <-ticker.C:
+			if updater.config.HealthURL != "" && updater.healthy(ctx) {
+				return nil
+			}
+		}
+	}
+}
+
+// healthy returns whether the health URL responds with 200 OK
+func (updater *Updater) healthy(ctx context.Context) bool {
+	req, err := http.NewRequest(http.MethodGet, updater.config.HealthURL, nil)
+	if err != nil {
+		return false
+	}
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return false
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	_ = resp.Body.Close()
+	return resp.StatusCode == http.StatusOK
+}
+
+// binaryVersion returns the version the "version" command of the binary at
+// path prints. Development builds have no version.
+func binaryVersion(ctx context.Context, path string) (version.SemVer, error) {
+	output, err := exec.CommandContext(ctx, path, "version").Output()
+	if err != nil {
+		return version.SemVer{}, Error.New("unable to get the version of %s: %v", path, err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "Version:") {
+			sv, err := version.NewSemVer(strings.TrimSpace(strings.TrimPrefix(line, "Version:")))
+			return sv, Error.Wrap(err)
+		}
+	}
+	return version.SemVer{}, Error.Wrap(scanner.Err())
+}
+
+// swap replaces binary with next and keeps the replaced binary at backup.
+// On Windows the binary is renamed before it is replaced, as running
+// executables can't be replaced there.
+func swap(binary, next, backup string) error {
+	if err := os.Remove(backup); err != nil && !os.IsNotExist(err) {
+		return Error.Wrap(err)
+	}
+
+	if runtime.GOOS == "windows" {
+		if err := os.Rename(binary, backup); err != nil {
+			return Error.Wrap(err)
+		}
+		if err := os.Rename(next, binary); err != nil {
+			return Error.Wrap(errs.Combine(err, os.Rename(backup, binary)))
+		}
+		return nil
+	}
+
+	if err := os.Link(binary, backup); err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(os.Rename(next, binary))
+}
+
+// restore replaces binary with backup
+func restore(binary, backup string) error {
+	if runtime.GOOS == "windows" {
+		if err := os.Remove(binary); err != nil && !os.IsNotExist(err) {
+			return Error.Wrap(err)
+		}
+	}
+	return Error.Wrap(os.Rename(backup, binary))
+}
@@ -0,0 +1,213 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"crypto"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/internal/version"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/versioncontrol"
+)
+
+// fakeNode returns a shell script which prints version like a storage node
+// and keeps running, unless it's broken
+func fakeNode(version string, broken bool) []byte {
+	run := "exec sleep 60"
+	if broken {
+		run = "exit 1"
+	}
+	return []byte(fmt.Sprintf("#!/bin/sh\nif [ \"$1\" = \"version\" ]; then\n\techo \"Release build\"\n\techo \"Version: %s\"\n\texit 0\nfi\n%s\n", version, run))
+}
+
+func TestUpdater(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake storage node is a shell script")
+	}
+
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	key, err := pkcrypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	otherKey, err := pkcrypto.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	files := map[string][]byte{}
+	publish := func(name string, binary []byte, key crypto.PrivateKey) {
+		signature, err := pkcrypto.HashAndSign(key, binary)
+		require.NoError(t, err)
+		files["/"+name] = binary
+		files["/"+name+".sig"] = signature
+	}
+	publish(fmt.Sprintf("v0.0.2/storagenode-%s-%s", runtime.GOOS, runtime.GOARCH), fakeNode("v0.0.2", false), key)
+	publish("v0.0.3/storagenode", fakeNode("v0.0.3", true), key)
+	publish("v0.0.4/storagenode", fakeNode("v0.0.4", false), otherKey)
+	publish("v0.0.5/storagenode", fakeNode("v0.0.5", false), key)
+
+	fileServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	defer fileServer.Close()
+
+	// startVersionControl starts a version control server suggesting the
+	// release of the given version, rolled out in stages if rollout is set
+	startVersionControl := func(release, path string, rollout versioncontrol.Rollout) *versioncontrol.Peer {
+		peer, err := versioncontrol.New(zaptest.NewLogger(t), &versioncontrol.Config{
+			Address: "127.0.0.1:0",
+			Versions: versioncontrol.ServiceVersions{
+				Bootstrap:   "v0.0.1",
+				Satellite:   "v0.0.1",
+				Storagenode: "v0.0.1",
+				Uplink:      "v0.0.1",
+				Gateway:     "v0.0.1",
+				Identity:    "v0.0.1",
+			},
+			Rollouts: versioncontrol.ServiceRollouts{
+				Storagenode: rollout,
+			},
+			Binary: versioncontrol.Versions{
+				Storagenode: versioncontrol.Binary{
+					Suggested: versioncontrol.Release{
+						Version: release,
+						URL:     fileServer.URL + "/" + release + "/" + path,
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+		ctx.Go(func() error { return peer.Run(ctx) })
+		return peer
+	}
+
+	binary := ctx.File("storagenode")
+	require.NoError(t, ioutil.WriteFile(binary, fakeNode("v0.0.1", false), 0755))
+
+	updater := NewUpdater(zaptest.NewLogger(t), Config{
+		BinaryLocation: binary,
+		HealthTimeout:  time.Second,
+		RequestTimeout: 10 * time.Second,
+	}, pkcrypto.PublicKeyFromPrivate(key))
+	require.NoError(t, updater.Start())
+	defer ctx.Check(updater.Stop)
+
+	installed := func() string {
+		sv, err := binaryVersion(ctx, binary)
+		require.NoError(t, err)
+		return sv.String()
+	}
+
+	{ // a signed release is installed and started
+		peer := startVersionControl("v0.0.2", "storagenode-{os}-{arch}", versioncontrol.Rollout{})
+		updater.config.VersionURL = "http://" + peer.Addr()
+
+		updated, err := updater.Update(ctx)
+		require.NoError(t, err)
+		assert.True(t, updated)
+		assert.Equal(t, "v0.0.2", installed())
+		assert.True(t, updater.Running())
+
+		// the installed release isn't installed again
+		updated, err = updater.Update(ctx)
+		require.NoError(t, err)
+		assert.False(t, updated)
+
+		ctx.Check(peer.Close)
+	}
+
+	{ // a release failing the health check is rolled back
+		peer := startVersionControl("v0.0.3", "storagenode", versioncontrol.Rollout{})
+		updater.config.VersionURL = "http://" + peer.Addr()
+
+		updated, err := updater.Update(ctx)
+		require.Error(t, err)
+		assert.False(t, updated)
+		assert.Equal(t, "v0.0.2", installed())
+		assert.True(t, updater.Running())
+
+		// the rejected release isn't installed again
+		updated, err = updater.Update(ctx)
+		require.NoError(t, err)
+		assert.False(t, updated)
+
+		ctx.Check(peer.Close)
+	}
+
+	{ // a release signed with another key isn't installed
+		peer := startVersionControl("v0.0.4", "storagenode", versioncontrol.Rollout{})
+		updater.config.VersionURL = "http://" + peer.Addr()
+
+		updated, err := updater.Update(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid signature")
+		assert.False(t, updated)
+		assert.Equal(t, "v0.0.2", installed())
+		assert.True(t, updater.Running())
+
+		ctx.Check(peer.Close)
+	}
+
+	{ // a staged release is only installed by nodes inside the rollout
+		rollout := versioncontrol.Rollout{Version: "v0.0.5", Seed: "seed", Cursor: 50}
+		semVer, err := version.NewSemVer(rollout.Version)
+		require.NoError(t, err)
+		staged := version.Rollout{Version: semVer, Seed: rollout.Seed, Cursor: rollout.Cursor}
+
+		var inside, outside storj.NodeID
+		for inside.IsZero() || outside.IsZero() {
+			nodeID := testrand.NodeID()
+			if staged.Contains(nodeID) {
+				inside = nodeID
+			} else {
+				outside = nodeID
+			}
+		}
+
+		peer := startVersionControl("v0.0.5", "storagenode", rollout)
+		updater.config.VersionURL = "http://" + peer.Addr()
+
+		updater.config.NodeID = outside
+		updated, err := updater.Update(ctx)
+		require.NoError(t, err)
+		assert.False(t, updated)
+		assert.Equal(t, "v0.0.2", installed())
+
+		updater.config.NodeID = inside
+		updated, err = updater.Update(ctx)
+		require.NoError(t, err)
+		assert.True(t, updated)
+		assert.Equal(t, "v0.0.5", installed())
+		assert.True(t, updater.Running())
+
+		ctx.Check(peer.Close)
+	}
+
+	// downloads are removed, only the backup of the previous release remains
+	remaining, err := filepath.Glob(binary + "*")
+	require.NoError(t, err)
+	assert.Equal(t, []string{binary, binary + ".old"}, remaining)
+	backup, err := binaryVersion(ctx, binary+".old")
+	require.NoError(t, err)
+	assert.Equal(t, "v0.0.2", backup.String())
+}
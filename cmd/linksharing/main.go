@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
@@ -27,6 +28,21 @@ type LinkSharing struct {
 	CertFile  string `user:"true" help:"server certificate file" devDefault:"" releaseDefault:"server.crt.pem"`
 	KeyFile   string `user:"true" help:"server key file" devDefault:"" releaseDefault:"server.key.pem"`
 	PublicURL string `user:"true" help:"public url for the server" devDefault:"http://localhost:8080" releaseDefault:""`
+
+	Website      bool   `user:"true" help:"serve index.html for prefixes instead of listing them" default:"false"`
+	NotFoundPage string `user:"true" help:"path of the object within the bucket served when an object is not found in website mode" default:""`
+
+	CustomDomains    bool          `user:"true" help:"serve custom domains mapped to scopes by the TXT records of _storj.<domain>" default:"false"`
+	HostsFile        string        `user:"true" help:"JSON file mapping custom domains to scopes, used instead of DNS when set" default:""`
+	DNSCacheTTL      time.Duration `user:"true" help:"how long custom domain DNS lookups are cached" default:"5m"`
+	DNSCacheSize     int           `user:"true" help:"maximum number of custom domains whose DNS lookups are cached" default:"10000"`
+	CertDir          string        `user:"true" help:"directory containing <domain>.crt.pem and <domain>.key.pem keypairs for custom domains" default:""`
+	ProjectCacheSize int           `user:"true" help:"number of unused projects kept open" default:"100"`
+
+	ProjectIdleTimeout time.Duration `user:"true" help:"how long unused projects are kept open" default:"10m"`
+	ObjectCacheTTL     time.Duration `user:"true" help:"how long opened objects are cached for repeated requests" default:"10s"`
+
+	ArchiveConcurrency int `user:"true" help:"number of objects downloaded concurrently when serving a prefix as an archive" default:"4"`
 }
 
 var (
@@ -71,18 +87,32 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
-	tlsConfig, err := configureTLS(runCfg.CertFile, runCfg.KeyFile)
+	tlsConfig, err := configureTLS(runCfg.CertFile, runCfg.KeyFile, runCfg.CertDir)
+	if err != nil {
+		return err
+	}
+
+	resolver, err := configureResolver(runCfg)
 	if err != nil {
 		return err
 	}
 
 	handler, err := linksharing.NewHandler(log, linksharing.HandlerConfig{
-		Uplink:  uplink,
-		URLBase: runCfg.PublicURL,
+		Uplink:           uplink,
+		URLBase:          runCfg.PublicURL,
+		Website:          runCfg.Website,
+		NotFoundPage:     runCfg.NotFoundPage,
+		Resolver:         resolver,
+		ProjectCacheSize: runCfg.ProjectCacheSize,
+
+		ProjectIdleTimeout: runCfg.ProjectIdleTimeout,
+		ObjectCacheTTL:     runCfg.ObjectCacheTTL,
+		ArchiveConcurrency: runCfg.ArchiveConcurrency,
 	})
 	if err != nil {
 		return err
 	}
+	defer func() { err = errs.Combine(err, handler.Close()) }()
 
 	server, err := httpserver.New(log, httpserver.Config{
 		Name:            "Link Sharing",
@@ -117,11 +147,16 @@ func cmdSetup(cmd *cobra.Command, args []string) (err error) {
 	return process.SaveConfig(cmd, filepath.Join(setupDir, "config.yaml"))
 }
 
-func configureTLS(certFile, keyFile string) (*tls.Config, error) {
+func configureTLS(certFile, keyFile, certDir string) (*tls.Config, error) {
 	switch {
 	case certFile != "" && keyFile != "":
 	case certFile == "" && keyFile == "":
-		return nil, nil
+		if certDir == "" {
+			return nil, nil
+		}
+		return &tls.Config{
+			GetCertificate: httpserver.NewCertDirectory(certDir, nil).GetCertificate,
+		}, nil
 	case certFile != "" && keyFile == "":
 		return nil, errs.New("key file must be provided with cert file")
 	case certFile == "" && keyFile != "":
@@ -133,11 +168,28 @@ func configureTLS(certFile, keyFile string) (*tls.Config, error) {
 		return nil, errs.New("unable to load server keypair: %v", err)
 	}
 
+	if certDir != "" {
+		return &tls.Config{
+			GetCertificate: httpserver.NewCertDirectory(certDir, &cert).GetCertificate,
+		}, nil
+	}
+
 	return &tls.Config{
 		Certificates: []tls.Certificate{cert},
 	}, nil
 }
 
+func configureResolver(config LinkSharing) (linksharing.HostResolver, error) {
+	switch {
+	case !config.CustomDomains:
+		return nil, nil
+	case config.HostsFile != "":
+		return linksharing.LoadStaticResolver(config.HostsFile)
+	default:
+		return linksharing.NewDNSResolver(config.DNSCacheTTL, config.DNSCacheSize), nil
+	}
+}
+
 func main() {
 	process.Exec(rootCmd)
 }
@@ -0,0 +1,294 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+)
+
+// aggregateInstance is the instance of the metrics emitted by an Aggregator
+const aggregateInstance = "aggregate"
+
+// aggregatePercentiles are the percentiles emitted for every key
+var aggregatePercentiles = []struct {
+	suffix  string
+	percent float64
+}{
+	{"p50", 50},
+	{"p90", 90},
+	{"p99", 99},
+}
+
+// aggregateKey identifies a group of aggregated metrics
+type aggregateKey struct {
+	application string
+	key         string
+	window      int64
+}
+
+// aggregateStat is a single emitted statistic of a group
+type aggregateStat struct {
+	suffix string
+	val    float64
+}
+
+// aggregateValues are the aggregated values of a group over a window
+type aggregateValues struct {
+	sum, min, max float64
+	count         int64
+	reservoir     []float64
+}
+
+// add adds the value, keeping a sample of at most size values
+func (v *aggregateValues) add(rng *rand.Rand, val float64, size int) {
+	if v.count == 0 || val < v.min {
+		v.min = val
+	}
+	if v.count == 0 || val > v.max {
+		v.max = val
+	}
+	v.sum += val
+	v.count++
+
+	// reservoir sampling keeps a uniform sample of the values
+	if len(v.reservoir) < size {
+		// grow up to size only, so the reservoir doesn't take more memory
+		if len(v.reservoir) == cap(v.reservoir) {
+			n := 2*len(v.reservoir) + 1
+			if n > size {
+				n = size
+			}
+			grown := make([]float64, len(v.reservoir), n)
+			copy(grown, v.reservoir)
+			v.reservoir = grown
+		}
+		v.reservoir = append(v.reservoir, val)
+	} else if i := rng.Int63n(v.count); i < int64(size) {
+		v.reservoir[i] = val
+	}
+}
+
+// percentile returns the nearest-rank percentile of the sorted reservoir
+func (v *aggregateValues) percentile(percent float64) float64 {
+	rank := int(percent/100*float64(len(v.reservoir))+0.5) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(v.reservoir) {
+		rank = len(v.reservoir) - 1
+	}
+	return v.reservoir[rank]
+}
+
+// Aggregator is a MetricDest that groups metrics by application and key
+// across all instances over fixed time windows. At the end of every window it
+// emits the sum, min, max, count and percentiles of each group as the metrics
+// <key>.sum, <key>.min, <key>.max, <key>.count, <key>.p50, <key>.p90 and
+// <key>.p99 with the instance "aggregate" and the end of the window as the
+// timestamp. Every window is emitted once, metrics arriving after their window
+// was emitted are dropped.
+type Aggregator struct {
+	window    time.Duration
+	maxKeys   int
+	reservoir int
+	detail    *regexp.Regexp
+	dest      MetricDest
+
+	mu      sync.Mutex
+	groups  map[aggregateKey]*aggregateValues
+	dropped int64
+	rng     *rand.Rand
+	stopped bool
+
+	// windows before emitted were emitted already, late counts the metrics
+	// dropped for them
+	emitted int64
+	late    int64
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewAggregator creates an Aggregator sending to dest. window is the length
+// of the aggregation windows (e.g. "1m"). maxKeys bounds the number of groups
+// held in memory, metrics for new groups are dropped once it is reached.
+// reservoir is the number of values per group kept to estimate percentiles,
+// so the sampled values take at most maxKeys * reservoir * 8 bytes. Metrics
+// with a key matching detailPattern are additionally passed along
+// unaggregated, so per-instance detail is kept for them; an empty pattern
+// keeps no detail. Because this function is called in a Lua pipeline
+// domain-specific language, the DSL wants the aggregator to be emitting every
+// window, so this constructor will start that process. Use Close to stop it.
+func NewAggregator(window string, maxKeys, reservoir int, detailPattern string, dest MetricDest) *Aggregator {
+	d, err := time.ParseDuration(window)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("invalid aggregation window %q", window))
+	}
+	if maxKeys <= 0 {
+		panic(fmt.Sprintf("invalid maximum number of keys %d", maxKeys))
+	}
+	if reservoir <= 0 {
+		panic(fmt.Sprintf("invalid reservoir size %d", reservoir))
+	}
+
+	a := &Aggregator{
+		window:    d,
+		maxKeys:   maxKeys,
+		reservoir: reservoir,
+		dest:      dest,
+		groups:    map[aggregateKey]*aggregateValues{},
+		rng:       rand.New(rand.NewSource(time.Now().UnixNano())),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	if detailPattern != "" {
+		a.detail = regexp.MustCompile(detailPattern)
+	}
+	go a.run()
+	return a
+}
+
+// Metric implements MetricDest
+func (a *Aggregator) Metric(application, instance string, key []byte, val float64, ts time.Time) error {
+	if err := a.aggregate(application, key, val, ts); err != nil {
+		return err
+	}
+	if a.detail != nil && a.detail.Match(key) {
+		return a.dest.Metric(application, instance, key, val, ts)
+	}
+	return nil
+}
+
+func (a *Aggregator) aggregate(application string, key []byte, val float64, ts time.Time) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.stopped {
+		return fmt.Errorf("closed aggregator")
+	}
+
+	group := aggregateKey{
+		application: application,
+		key:         string(key),
+		window:      ts.UnixNano() / int64(a.window),
+	}
+	if group.window < a.emitted {
+		a.late++
+		return nil
+	}
+	values, ok := a.groups[group]
+	if !ok {
+		if len(a.groups) >= a.maxKeys {
+			a.dropped++
+			return nil
+		}
+		values = &aggregateValues{}
+		a.groups[group] = values
+	}
+	values.add(a.rng, val, a.reservoir)
+	return nil
+}
+
+// Close emits all pending windows and stops the emitting goroutine
+func (a *Aggregator) Close() error {
+	a.mu.Lock()
+	if a.stopped {
+		a.mu.Unlock()
+		return nil
+	}
+	a.stopped = true
+	a.mu.Unlock()
+
+	close(a.stop)
+	<-a.done
+	return nil
+}
+
+func (a *Aggregator) run() {
+	defer close(a.done)
+
+	ticker := time.NewTicker(a.window)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			a.emit(now, false)
+		case <-a.stop:
+			a.emit(time.Now(), true)
+			return
+		}
+	}
+}
+
+// emit sends the aggregates of the windows that ended before now, or of all
+// windows if all is set. The emitted windows are closed for further metrics.
+func (a *Aggregator) emit(now time.Time, all bool) {
+	current := now.UnixNano() / int64(a.window)
+
+	a.mu.Lock()
+	if current > a.emitted {
+		a.emitted = current
+	}
+	var keys []aggregateKey
+	for group := range a.groups {
+		if all || group.window < current {
+			keys = append(keys, group)
+		}
+	}
+	emitted := make(map[aggregateKey]*aggregateValues, len(keys))
+	for _, group := range keys {
+		emitted[group] = a.groups[group]
+		delete(a.groups, group)
+	}
+	dropped, late := a.dropped, a.late
+	a.dropped, a.late = 0, 0
+	a.mu.Unlock()
+
+	if dropped > 0 {
+		log.Printf("aggregator reached %d keys, dropped %d metrics", a.maxKeys, dropped)
+	}
+	if late > 0 {
+		log.Printf("aggregator dropped %d metrics of already emitted windows", late)
+	}
+
+	sort.Slice(keys, func(i, k int) bool {
+		if keys[i].window != keys[k].window {
+			return keys[i].window < keys[k].window
+		}
+		if keys[i].application != keys[k].application {
+			return keys[i].application < keys[k].application
+		}
+		return keys[i].key < keys[k].key
+	})
+
+	for _, group := range keys {
+		values := emitted[group]
+		sort.Float64s(values.reservoir)
+		ts := time.Unix(0, (group.window+1)*int64(a.window))
+
+		stats := []aggregateStat{
+			{"sum", values.sum},
+			{"min", values.min},
+			{"max", values.max},
+			{"count", float64(values.count)},
+		}
+		for _, p := range aggregatePercentiles {
+			stats = append(stats, aggregateStat{p.suffix, values.percentile(p.percent)})
+		}
+
+		for _, stat := range stats {
+			err := a.dest.Metric(group.application, aggregateInstance, []byte(group.key+"."+stat.suffix), stat.val, ts)
+			if err != nil {
+				log.Printf("failed delivering aggregated metric: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// collector is a MetricDest remembering all metrics
+type collector struct {
+	mu      sync.Mutex
+	metrics []Metric
+}
+
+func (c *collector) Metric(application, instance string, key []byte, val float64, ts time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.metrics = append(c.metrics, Metric{
+		Application: application,
+		Instance:    instance,
+		Key:         append([]byte(nil), key...),
+		Val:         val,
+		TS:          ts,
+	})
+	return nil
+}
+
+// values returns the values of the metrics by instance and key
+func (c *collector) values() map[string]map[string]float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	values := map[string]map[string]float64{}
+	for _, m := range c.metrics {
+		if values[m.Instance] == nil {
+			values[m.Instance] = map[string]float64{}
+		}
+		values[m.Instance][string(m.Key)] = m.Val
+	}
+	return values
+}
+
+func TestAggregator(t *testing.T) {
+	dest := &collector{}
+	aggregator := NewAggregator("1h", 100, 1024, "^detail$", dest)
+
+	ts := time.Unix(3600*1000+10, 0)
+	for i := 1; i <= 100; i++ {
+		require.NoError(t, aggregator.Metric("storagenode", "node"+string(rune('a'+i%5)), []byte("latency"), float64(i), ts))
+	}
+	require.NoError(t, aggregator.Metric("storagenode", "nodea", []byte("detail"), 5, ts))
+	require.NoError(t, aggregator.Metric("storagenode", "nodeb", []byte("detail"), 7, ts))
+
+	// only the detail keys are passed along before the window ends
+	assert.Equal(t, map[string]map[string]float64{
+		"nodea": {"detail": 5},
+		"nodeb": {"detail": 7},
+	}, dest.values())
+
+	require.NoError(t, aggregator.Close())
+	assert.Error(t, aggregator.Metric("storagenode", "nodea", []byte("latency"), 1, ts))
+
+	values := dest.values()
+	assert.Equal(t, map[string]float64{
+		"latency.sum":   5050,
+		"latency.min":   1,
+		"latency.max":   100,
+		"latency.count": 100,
+		"latency.p50":   50,
+		"latency.p90":   90,
+		"latency.p99":   99,
+		"detail.sum":    12,
+		"detail.min":    5,
+		"detail.max":    7,
+		"detail.count":  2,
+		"detail.p50":    5,
+		"detail.p90":    7,
+		"detail.p99":    7,
+	}, values[aggregateInstance])
+
+	dest.mu.Lock()
+	defer dest.mu.Unlock()
+	for _, m := range dest.metrics {
+		if m.Instance == aggregateInstance {
+			assert.Equal(t, "storagenode", m.Application)
+			assert.Equal(t, time.Unix(3600*1001, 0), m.TS)
+		}
+	}
+}
+
+func TestAggregatorWindows(t *testing.T) {
+	dest := &collector{}
+	aggregator := NewAggregator("1m", 100, 1024, "", dest)
+
+	// windows which already ended are emitted on the next tick
+	start := time.Now().Truncate(time.Minute).Add(-10 * time.Minute)
+	require.NoError(t, aggregator.Metric("satellite", "a", []byte("key"), 1, start))
+	require.NoError(t, aggregator.Metric("satellite", "a", []byte("key"), 2, start.Add(time.Minute)))
+
+	aggregator.emit(time.Now(), false)
+
+	sums := func() []Metric {
+		dest.mu.Lock()
+		defer dest.mu.Unlock()
+		var sums []Metric
+		for _, m := range dest.metrics {
+			if string(m.Key) == "key.sum" {
+				sums = append(sums, m)
+			}
+		}
+		return sums
+	}
+
+	emitted := sums()
+	require.Len(t, emitted, 2)
+	assert.Equal(t, 1.0, emitted[0].Val)
+	assert.Equal(t, start.Add(time.Minute), emitted[0].TS)
+	assert.Equal(t, 2.0, emitted[1].Val)
+	assert.Equal(t, start.Add(2*time.Minute), emitted[1].TS)
+
+	// late metrics of emitted windows are dropped instead of emitting a
+	// partial aggregate for the same window again
+	require.NoError(t, aggregator.Metric("satellite", "a", []byte("key"), 3, start))
+	aggregator.emit(time.Now(), false)
+	require.NoError(t, aggregator.Close())
+	assert.Equal(t, emitted, sums())
+}
+
+func TestAggregatorMaxKeys(t *testing.T) {
+	dest := &collector{}
+	aggregator := NewAggregator("1h", 2, 1024, "", dest)
+
+	ts := time.Now()
+	for _, key := range []string{"a", "b", "c", "a"} {
+		require.NoError(t, aggregator.Metric("uplink", "instance", []byte(key), 1, ts))
+	}
+	require.NoError(t, aggregator.Close())
+
+	values := dest.values()[aggregateInstance]
+	assert.Equal(t, 2.0, values["a.count"])
+	assert.Equal(t, 1.0, values["b.count"])
+	assert.NotContains(t, values, "c.count")
+}
+
+func TestAggregatorReservoir(t *testing.T) {
+	dest := &collector{}
+	aggregator := NewAggregator("1h", 1, 100, "", dest)
+
+	ts := time.Now()
+	for i := 0; i < 10000; i++ {
+		require.NoError(t, aggregator.Metric("uplink", "instance", []byte("key"), float64(i%100), ts))
+	}
+
+	aggregator.mu.Lock()
+	for _, values := range aggregator.groups {
+		assert.Len(t, values.reservoir, 100)
+		assert.Equal(t, 100, cap(values.reservoir))
+	}
+	aggregator.mu.Unlock()
+
+	require.NoError(t, aggregator.Close())
+
+	values := dest.values()[aggregateInstance]
+	assert.Equal(t, float64(10000), values["key.count"])
+	assert.Equal(t, 0.0, values["key.min"])
+	assert.Equal(t, 99.0, values["key.max"])
+	assert.InDelta(t, 50, values["key.p50"], 10)
+}
@@ -0,0 +1,189 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	defaultMaxBatch   = 5000
+	defaultMaxPending = 100000
+	defaultRetries    = 5
+	defaultBackoff    = 250 * time.Millisecond
+	defaultMaxBackoff = 10 * time.Second
+)
+
+// permanentError is an error that retrying a batch will not fix, such as a
+// rejected request.
+type permanentError struct{ err error }
+
+func (err permanentError) Error() string { return err.err.Error() }
+
+// batcher collects metrics and hands them to send in batches, either every
+// flush interval or whenever a full batch is pending. Failed batches are
+// retried with exponential backoff and dropped once the retries run out.
+type batcher struct {
+	name     string
+	interval time.Duration
+	send     func(batch []Metric) error
+
+	maxBatch   int
+	maxPending int
+	retries    int
+	backoff    time.Duration
+	maxBackoff time.Duration
+
+	mu      sync.Mutex
+	pending []Metric
+	stopped bool
+
+	full chan struct{}
+	stop chan struct{}
+	done chan struct{}
+}
+
+// newBatcher creates a batcher named name for log messages and starts its
+// flushing goroutine. Use close to stop it.
+func newBatcher(name string, interval time.Duration, send func(batch []Metric) error) *batcher {
+	b := &batcher{
+		name:     name,
+		interval: interval,
+		send:     send,
+
+		maxBatch:   defaultMaxBatch,
+		maxPending: defaultMaxPending,
+		retries:    defaultRetries,
+		backoff:    defaultBackoff,
+		maxBackoff: defaultMaxBackoff,
+
+		full: make(chan struct{}, 1),
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	go b.run()
+	return b
+}
+
+// add queues a metric for the next batch. The key is copied, because the
+// parser reuses its memory.
+func (b *batcher) add(application, instance string, key []byte, val float64, ts time.Time) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.stopped {
+		return fmt.Errorf("%s: closed destination", b.name)
+	}
+	if len(b.pending) >= b.maxPending {
+		return fmt.Errorf("%s: metric buffer overrun", b.name)
+	}
+
+	b.pending = append(b.pending, Metric{
+		Application: application,
+		Instance:    instance,
+		Key:         append([]byte(nil), key...),
+		Val:         val,
+		TS:          ts,
+	})
+	if len(b.pending) >= b.maxBatch {
+		select {
+		case b.full <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// close stops the flushing goroutine after sending the pending metrics.
+func (b *batcher) close() error {
+	b.mu.Lock()
+	if b.stopped {
+		b.mu.Unlock()
+		return nil
+	}
+	b.stopped = true
+	b.mu.Unlock()
+
+	close(b.stop)
+	<-b.done
+	return nil
+}
+
+func (b *batcher) run() {
+	defer close(b.done)
+
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-b.full:
+		case <-b.stop:
+			b.flush()
+			return
+		}
+		b.flush()
+	}
+}
+
+// flush sends all pending metrics in batches of at most maxBatch metrics.
+func (b *batcher) flush() {
+	b.mu.Lock()
+	pending := b.pending
+	b.pending = nil
+	b.mu.Unlock()
+
+	for len(pending) > 0 {
+		n := len(pending)
+		if n > b.maxBatch {
+			n = b.maxBatch
+		}
+		err := b.sendWithRetry(pending[:n])
+		if err != nil {
+			log.Printf("%s: dropping %d metrics: %v", b.name, n, err)
+		}
+		pending = pending[n:]
+	}
+}
+
+// sendWithRetry sends the batch, retrying with exponential backoff until it
+// succeeds, the retries run out or the error is permanent.
+func (b *batcher) sendWithRetry(batch []Metric) error {
+	backoff := b.backoff
+	for attempt := 0; ; attempt++ {
+		err := b.send(batch)
+		if err == nil {
+			return nil
+		}
+		if _, ok := err.(permanentError); ok || attempt >= b.retries {
+			return err
+		}
+
+		log.Printf("%s: failed sending batch, retrying in %v: %v", b.name, backoff, err)
+		select {
+		case <-time.After(backoff):
+		case <-b.stop:
+			// keep retrying while closing, but without waiting
+		}
+
+		backoff *= 2
+		if backoff > b.maxBackoff {
+			backoff = b.maxBackoff
+		}
+	}
+}
+
+// parseFlushInterval parses the flush interval given in the Lua
+// configuration, such as "10s".
+func parseFlushInterval(interval string) time.Duration {
+	d, err := time.ParseDuration(interval)
+	if err != nil || d <= 0 {
+		panic(fmt.Sprintf("invalid flush interval %q", interval))
+	}
+	return d
+}
@@ -10,6 +10,11 @@ source = udpin("localhost:9000")
 --  * print() goes to stdout
 --  * db("sqlite3", path) goes to sqlite
 --  * db("postgres", connstring) goes to postgres
+--  * influx(url, interval) goes to influxdb with the line protocol, where url
+--    is either an http(s) write endpoint or a udp://host:port listener
+--  * promremote(url, interval) goes to a prometheus remote write endpoint
+-- influx and promremote send batches every interval (e.g. "10s") and retry
+-- failed batches with backoff.
 graphite_out = graphite("localhost:5555")
 db_out = mcopy(
   db("sqlite3", "db.db"),
@@ -27,6 +32,19 @@ metric_handlers = mcopy(
         "|hw\\.disk\\..*Avail" ..
         "|hw\\.network\\.stats\\..*\\.(tx|rx)_bytes\\.(deriv|val)",
       db_out)),
+  -- send storagenode data to influxdb and prometheus as well
+  appfilter("storagenode-prod",
+    mcopy(
+      influx("http://localhost:8086/write?db=storj", "10s"),
+      promremote("http://localhost:9201/write", "15s"))),
+  -- aggregate storagenode data across all nodes into one minute windows for
+  -- graphite, keeping per-node detail only for the process metrics. at most
+  -- 100000 keys are held in memory, each keeping 128 values to estimate
+  -- percentiles, so the samples take at most 100000 * 128 * 8 bytes (~100MB).
+  -- keyfilter can be used in front of differently configured aggregators to
+  -- treat key patterns differently.
+  appfilter("storagenode-prod",
+    aggregate("1m", 100000, 128, "env\\.process\\.", graphite_out)),
   -- just print uplink stuff
   appfilter("uplink-prod",
     print()))
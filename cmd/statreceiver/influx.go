@@ -0,0 +1,152 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxInfluxPacket is the maximum size of a UDP packet sent to InfluxDB. Lines
+// are never split, so a single longer line is sent in its own packet.
+const maxInfluxPacket = 1400
+
+var (
+	influxMeasurementEscaper = strings.NewReplacer(`,`, `\,`, ` `, `\ `)
+	influxTagEscaper         = strings.NewReplacer(`,`, `\,`, `=`, `\=`, ` `, `\ `)
+)
+
+// InfluxDest is a MetricDest that sends data with the InfluxDB line protocol,
+// either over HTTP(S) or UDP. Every metric becomes a line with the metric key
+// as the measurement, the application and instance as tags, and the metric
+// value as the field "value".
+type InfluxDest struct {
+	address string
+	client  *http.Client
+	conn    net.Conn
+	batcher *batcher
+}
+
+// NewInfluxDest creates an InfluxDest sending to address, which is either the
+// write endpoint of an InfluxDB HTTP API, such as
+// "http://localhost:8086/write?db=storj", or the address of an InfluxDB UDP
+// listener, such as "udp://localhost:8089". Because this function is called
+// in a Lua pipeline domain-specific language, the DSL wants the destination to
+// be flushing every flushInterval (e.g. "10s"), so this constructor will start
+// that process. Use Close to stop it.
+func NewInfluxDest(address, flushInterval string) *InfluxDest {
+	u, err := url.Parse(address)
+	if err != nil {
+		panic(fmt.Sprintf("invalid influx address %q: %v", address, err))
+	}
+
+	d := &InfluxDest{address: address}
+	switch u.Scheme {
+	case "http", "https":
+		d.client = &http.Client{Timeout: 30 * time.Second}
+		d.batcher = newBatcher("influx", parseFlushInterval(flushInterval), d.sendHTTP)
+	case "udp":
+		conn, err := net.Dial("udp", u.Host)
+		if err != nil {
+			panic(fmt.Sprintf("invalid influx address %q: %v", address, err))
+		}
+		d.conn = conn
+		d.batcher = newBatcher("influx", parseFlushInterval(flushInterval), d.sendUDP)
+	default:
+		panic(fmt.Sprintf("unsupported influx address %q", address))
+	}
+	return d
+}
+
+// Metric implements MetricDest
+func (d *InfluxDest) Metric(application, instance string, key []byte, val float64, ts time.Time) error {
+	return d.batcher.add(application, instance, key, val, ts)
+}
+
+// Close sends the pending metrics and stops the flushing goroutine
+func (d *InfluxDest) Close() error {
+	err := d.batcher.close()
+	if d.conn != nil {
+		if closeErr := d.conn.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+func (d *InfluxDest) sendHTTP(batch []Metric) error {
+	var body bytes.Buffer
+	for _, m := range batch {
+		writeInfluxLine(&body, m)
+	}
+
+	resp, err := d.client.Post(d.address, "text/plain; charset=utf-8", &body)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp)
+}
+
+func (d *InfluxDest) sendUDP(batch []Metric) error {
+	var packet, line bytes.Buffer
+	for _, m := range batch {
+		line.Reset()
+		writeInfluxLine(&line, m)
+
+		if packet.Len() > 0 && packet.Len()+line.Len() > maxInfluxPacket {
+			if _, err := d.conn.Write(packet.Bytes()); err != nil {
+				return err
+			}
+			packet.Reset()
+		}
+		_, _ = packet.Write(line.Bytes())
+	}
+	if packet.Len() > 0 {
+		_, err := d.conn.Write(packet.Bytes())
+		return err
+	}
+	return nil
+}
+
+// writeInfluxLine writes the metric as a single line of the InfluxDB line
+// protocol with a nanosecond timestamp.
+func writeInfluxLine(w *bytes.Buffer, m Metric) {
+	_, _ = w.WriteString(influxMeasurementEscaper.Replace(string(m.Key)))
+	_, _ = w.WriteString(",application=")
+	_, _ = w.WriteString(influxTagEscaper.Replace(m.Application))
+	_, _ = w.WriteString(",instance=")
+	_, _ = w.WriteString(influxTagEscaper.Replace(m.Instance))
+	_, _ = w.WriteString(" value=")
+	_, _ = w.WriteString(strconv.FormatFloat(m.Val, 'g', -1, 64))
+	_ = w.WriteByte(' ')
+	_, _ = w.WriteString(strconv.FormatInt(m.TS.UnixNano(), 10))
+	_ = w.WriteByte('\n')
+}
+
+// checkResponse closes the response body and returns an error for
+// unsuccessful responses. Client errors other than rate limiting are
+// permanent, since resending the same batch will not be accepted either.
+func checkResponse(resp *http.Response) error {
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+
+	message, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+	err := fmt.Errorf("unexpected status %q: %s", resp.Status, bytes.TrimSpace(message))
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+		return permanentError{err}
+	}
+	return err
+}
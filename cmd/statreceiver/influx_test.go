@@ -0,0 +1,112 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInfluxHTTP(t *testing.T) {
+	var mu sync.Mutex
+	var requests int
+	var lines []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// the first request fails to exercise the retry
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		assert.Equal(t, "storj", r.URL.Query().Get("db"))
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		lines = append(lines, strings.Split(strings.TrimSpace(string(body)), "\n")...)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	dest := NewInfluxDest(server.URL+"/write?db=storj", "1h")
+	dest.batcher.backoff = time.Millisecond
+
+	ts := time.Unix(1500000000, 5)
+	require.NoError(t, dest.Metric("satellite", "a b", []byte("env.process,x"), 1.5, ts))
+	require.NoError(t, dest.Metric("satellite", "a=b", []byte("uploads"), 2, ts))
+	require.NoError(t, dest.Close())
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, []string{
+		`env.process\,x,application=satellite,instance=a\ b value=1.5 1500000000000000005`,
+		`uploads,application=satellite,instance=a\=b value=2 1500000000000000005`,
+	}, lines)
+
+	assert.Error(t, dest.Metric("satellite", "a", []byte("uploads"), 2, ts))
+}
+
+func TestInfluxHTTPPermanentError(t *testing.T) {
+	var mu sync.Mutex
+	var requests int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		http.Error(w, "bad line", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	dest := NewInfluxDest(server.URL+"/write", "1h")
+	dest.batcher.backoff = time.Millisecond
+
+	require.NoError(t, dest.Metric("satellite", "a", []byte("uploads"), 1, time.Now()))
+	require.NoError(t, dest.Close())
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 1, requests)
+}
+
+func TestInfluxUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	dest := NewInfluxDest("udp://"+conn.LocalAddr().String(), "10ms")
+	defer func() { _ = dest.Close() }()
+
+	ts := time.Unix(1500000000, 0)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, dest.Metric("storagenode", "instance", []byte("key"), float64(i), ts))
+	}
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(10*time.Second)))
+
+	var received []string
+	buf := make([]byte, 65536)
+	for len(received) < 100 {
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+		assert.True(t, n <= maxInfluxPacket)
+		received = append(received, strings.Split(strings.TrimSpace(string(buf[:n])), "\n")...)
+	}
+
+	assert.Equal(t, "key,application=storagenode,instance=instance value=0 1500000000000000000", received[0])
+	assert.Equal(t, "key,application=storagenode,instance=instance value=99 1500000000000000000", received[99])
+}
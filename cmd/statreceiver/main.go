@@ -78,8 +78,11 @@ func Main(cmd *cobra.Command, args []string) error {
 		scope.RegisterVal("instfilter", NewInstanceFilter),
 		scope.RegisterVal("keyfilter", NewKeyFilter),
 		scope.RegisterVal("sanitize", NewSanitizer),
+		scope.RegisterVal("aggregate", NewAggregator),
 		scope.RegisterVal("graphite", NewGraphiteDest),
 		scope.RegisterVal("db", NewDBDest),
+		scope.RegisterVal("influx", NewInfluxDest),
+		scope.RegisterVal("promremote", NewPrometheusDest),
 		scope.RegisterVal("pbufprep", NewPacketBufPrep),
 		scope.RegisterVal("mbufprep", NewMetricBufPrep),
 	)
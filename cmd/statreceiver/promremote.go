@@ -0,0 +1,176 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"math"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/golang/snappy"
+)
+
+// field numbers of the prometheus remote write protobuf messages
+// https://github.com/prometheus/prometheus/blob/master/prompb/remote.proto
+const (
+	promWriteRequestTimeseries = 1
+	promTimeSeriesLabels       = 1
+	promTimeSeriesSamples      = 2
+	promLabelName              = 1
+	promLabelValue             = 2
+	promSampleValue            = 1
+	promSampleTimestamp        = 2
+
+	protoWireVarint  = 0
+	protoWireFixed64 = 1
+	protoWireBytes   = 2
+)
+
+// PrometheusDest is a MetricDest that sends data with the Prometheus remote
+// write protocol. Every metric key becomes a series named after the key with
+// invalid characters replaced by underscores, labeled with the application and
+// instance.
+type PrometheusDest struct {
+	address string
+	client  *http.Client
+	batcher *batcher
+}
+
+// NewPrometheusDest creates a PrometheusDest sending to the remote write
+// endpoint address, such as "http://localhost:9201/write". Because this
+// function is called in a Lua pipeline domain-specific language, the DSL
+// wants the destination to be flushing every flushInterval (e.g. "10s"), so
+// this constructor will start that process. Use Close to stop it.
+func NewPrometheusDest(address, flushInterval string) *PrometheusDest {
+	d := &PrometheusDest{
+		address: address,
+		client:  &http.Client{Timeout: 30 * time.Second},
+	}
+	d.batcher = newBatcher("prometheus", parseFlushInterval(flushInterval), d.send)
+	return d
+}
+
+// Metric implements MetricDest
+func (d *PrometheusDest) Metric(application, instance string, key []byte, val float64, ts time.Time) error {
+	return d.batcher.add(application, instance, key, val, ts)
+}
+
+// Close sends the pending metrics and stops the flushing goroutine
+func (d *PrometheusDest) Close() error {
+	return d.batcher.close()
+}
+
+func (d *PrometheusDest) send(batch []Metric) error {
+	body := snappy.Encode(nil, encodePromWriteRequest(batch))
+
+	req, err := http.NewRequest("POST", d.address, bytes.NewReader(body))
+	if err != nil {
+		return permanentError{err}
+	}
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp)
+}
+
+// promSeries is a single series of a remote write request
+type promSeries struct {
+	name, application, instance string
+	samples                     []Metric
+}
+
+// encodePromWriteRequest encodes the batch as a remote write WriteRequest
+// protobuf message, grouping the metrics into series sorted by timestamp.
+func encodePromWriteRequest(batch []Metric) []byte {
+	type seriesKey struct{ name, application, instance string }
+
+	var order []seriesKey
+	series := map[seriesKey]*promSeries{}
+	for _, m := range batch {
+		key := seriesKey{promMetricName(m.Key), m.Application, m.Instance}
+		s, ok := series[key]
+		if !ok {
+			s = &promSeries{name: key.name, application: key.application, instance: key.instance}
+			series[key] = s
+			order = append(order, key)
+		}
+		s.samples = append(s.samples, m)
+	}
+
+	var request, buf []byte
+	for _, key := range order {
+		s := series[key]
+		sort.SliceStable(s.samples, func(i, k int) bool { return s.samples[i].TS.Before(s.samples[k].TS) })
+
+		buf = buf[:0]
+		// labels must be sorted by name
+		buf = appendPromLabel(buf, "__name__", s.name)
+		buf = appendPromLabel(buf, "application", s.application)
+		buf = appendPromLabel(buf, "instance", s.instance)
+		for _, sample := range s.samples {
+			var encoded []byte
+			encoded = appendProtoTag(encoded, promSampleValue, protoWireFixed64)
+			encoded = appendFixed64(encoded, math.Float64bits(sample.Val))
+			encoded = appendProtoTag(encoded, promSampleTimestamp, protoWireVarint)
+			encoded = appendVarint(encoded, uint64(sample.TS.UnixNano()/int64(time.Millisecond)))
+			buf = appendProtoBytes(buf, promTimeSeriesSamples, encoded)
+		}
+		request = appendProtoBytes(request, promWriteRequestTimeseries, buf)
+	}
+	return request
+}
+
+// promMetricName converts the metric key into a valid prometheus metric name.
+func promMetricName(key []byte) string {
+	name := make([]byte, len(key))
+	for i, c := range key {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_', c == ':':
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			c = '_'
+		}
+		name[i] = c
+	}
+	return string(name)
+}
+
+func appendPromLabel(buf []byte, name, value string) []byte {
+	var label []byte
+	label = appendProtoBytes(label, promLabelName, []byte(name))
+	label = appendProtoBytes(label, promLabelValue, []byte(value))
+	return appendProtoBytes(buf, promTimeSeriesLabels, label)
+}
+
+func appendProtoTag(buf []byte, field, wire int) []byte {
+	return appendVarint(buf, uint64(field<<3|wire))
+}
+
+func appendProtoBytes(buf []byte, field int, data []byte) []byte {
+	buf = appendProtoTag(buf, field, protoWireBytes)
+	buf = appendVarint(buf, uint64(len(data)))
+	return append(buf, data...)
+}
+
+func appendVarint(buf []byte, x uint64) []byte {
+	for x >= 0x80 {
+		buf = append(buf, byte(x)|0x80)
+		x >>= 7
+	}
+	return append(buf, byte(x))
+}
+
+func appendFixed64(buf []byte, x uint64) []byte {
+	for i := 0; i < 8; i++ {
+		buf = append(buf, byte(x>>(8*uint(i))))
+	}
+	return buf
+}
@@ -0,0 +1,156 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// the remote write messages, decoded with reflection to check the encoding
+type testWriteRequest struct {
+	Timeseries []*testTimeSeries `protobuf:"bytes,1,rep,name=timeseries"`
+}
+
+type testTimeSeries struct {
+	Labels  []*testLabel  `protobuf:"bytes,1,rep,name=labels"`
+	Samples []*testSample `protobuf:"bytes,2,rep,name=samples"`
+}
+
+type testLabel struct {
+	Name  string `protobuf:"bytes,1,opt,name=name"`
+	Value string `protobuf:"bytes,2,opt,name=value"`
+}
+
+type testSample struct {
+	Value     float64 `protobuf:"fixed64,1,opt,name=value"`
+	Timestamp int64   `protobuf:"varint,2,opt,name=timestamp"`
+}
+
+func (m *testWriteRequest) Reset()         { *m = testWriteRequest{} }
+func (m *testWriteRequest) String() string { return proto.CompactTextString(m) }
+func (*testWriteRequest) ProtoMessage()    {}
+func (m *testTimeSeries) Reset()           { *m = testTimeSeries{} }
+func (m *testTimeSeries) String() string   { return proto.CompactTextString(m) }
+func (*testTimeSeries) ProtoMessage()      {}
+func (m *testLabel) Reset()                { *m = testLabel{} }
+func (m *testLabel) String() string        { return proto.CompactTextString(m) }
+func (*testLabel) ProtoMessage()           {}
+func (m *testSample) Reset()               { *m = testSample{} }
+func (m *testSample) String() string       { return proto.CompactTextString(m) }
+func (*testSample) ProtoMessage()          {}
+
+func TestPrometheusRemoteWrite(t *testing.T) {
+	var mu sync.Mutex
+	var requests int
+	var received []*testWriteRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// the first request fails to exercise the retry
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		assert.Equal(t, "snappy", r.Header.Get("Content-Encoding"))
+		assert.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+
+		compressed, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		data, err := snappy.Decode(nil, compressed)
+		assert.NoError(t, err)
+
+		var request testWriteRequest
+		assert.NoError(t, proto.Unmarshal(data, &request))
+		received = append(received, &request)
+	}))
+	defer server.Close()
+
+	dest := NewPrometheusDest(server.URL+"/write", "1h")
+	dest.batcher.backoff = time.Millisecond
+
+	first, second := time.Unix(1500000000, 0), time.Unix(1500000010, 0)
+	require.NoError(t, dest.Metric("satellite", "node1", []byte("env.process.uptime"), 2, second))
+	require.NoError(t, dest.Metric("satellite", "node1", []byte("env.process.uptime"), 1, first))
+	require.NoError(t, dest.Metric("satellite", "node2", []byte("1-bytes"), 5, first))
+	require.NoError(t, dest.Close())
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 2, requests)
+	require.Len(t, received, 1)
+	require.Len(t, received[0].Timeseries, 2)
+
+	uptime := received[0].Timeseries[0]
+	assert.Equal(t, []*testLabel{
+		{Name: "__name__", Value: "env_process_uptime"},
+		{Name: "application", Value: "satellite"},
+		{Name: "instance", Value: "node1"},
+	}, uptime.Labels)
+	assert.Equal(t, []*testSample{
+		{Value: 1, Timestamp: 1500000000000},
+		{Value: 2, Timestamp: 1500000010000},
+	}, uptime.Samples)
+
+	numbered := received[0].Timeseries[1]
+	assert.Equal(t, "__name__", numbered.Labels[0].Name)
+	assert.Equal(t, "__bytes", numbered.Labels[0].Value)
+	assert.Equal(t, "node2", numbered.Labels[2].Value)
+}
+
+func TestPrometheusRemoteWriteBatches(t *testing.T) {
+	var mu sync.Mutex
+	var samples int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		compressed, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		data, err := snappy.Decode(nil, compressed)
+		assert.NoError(t, err)
+
+		var request testWriteRequest
+		assert.NoError(t, proto.Unmarshal(data, &request))
+
+		mu.Lock()
+		defer mu.Unlock()
+		for _, series := range request.Timeseries {
+			samples += len(series.Samples)
+		}
+	}))
+	defer server.Close()
+
+	dest := NewPrometheusDest(server.URL, "10ms")
+	defer func() { _ = dest.Close() }()
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, dest.Metric("uplink", "instance", []byte("requests"), float64(i), time.Now()))
+	}
+
+	for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); {
+		mu.Lock()
+		done := samples == 10
+		mu.Unlock()
+		if done {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 10, samples)
+}
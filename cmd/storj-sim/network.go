@@ -204,6 +204,7 @@ func newNetwork(flags *Flags) (*Processes, error) {
 	versioncontrol.Arguments = withCommon(versioncontrol.Directory, Arguments{
 		"setup": {
 			"--address", versioncontrol.Address,
+			"--admin-address", net.JoinHostPort(host, port(versioncontrolPeer, 0, privateHTTP)),
 			"--debug.addr", net.JoinHostPort(host, port(versioncontrolPeer, 0, debugHTTP)),
 		},
 		"run": {},
@@ -16,14 +16,18 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
 
+	"storj.io/storj/internal/archive"
 	"storj.io/storj/internal/fpath"
 	libuplink "storj.io/storj/lib/uplink"
 	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
 )
 
 var (
-	progress *bool
-	expires  *string
+	progress      *bool
+	expires       *string
+	compress      *bool
+	archiveFormat *string
 )
 
 func init() {
@@ -34,6 +38,8 @@ func init() {
 	}, RootCmd)
 	progress = cpCmd.Flags().Bool("progress", true, "if true, show progress")
 	expires = cpCmd.Flags().String("expires", "", "optional expiration date of an object. Please use format (yyyy-mm-ddThh:mm:ssZhh:mm)")
+	compress = cpCmd.Flags().Bool("compress", false, "compress the object before encryption when uploading or copying")
+	archiveFormat = cpCmd.Flags().String("archive", "", "download all objects under the source prefix as a single archive (zip or tar)")
 }
 
 // upload transfers src from local machine to s3 compatible object dst
@@ -105,6 +111,9 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 
 	opts.Volatile.RedundancyScheme = cfg.GetRedundancyScheme()
 	opts.Volatile.EncryptionParameters = cfg.GetEncryptionParameters()
+	if *compress {
+		opts.Volatile.Compression = storj.CompressionGzip
+	}
 
 	if err := bucket.UploadObject(ctx, dst.Path(), reader, opts); err != nil {
 		return err
@@ -192,6 +201,100 @@ func download(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgres
 	return nil
 }
 
+// downloadArchive downloads all objects under the prefix src as an archive to dst on local machine
+func downloadArchive(ctx context.Context, src fpath.FPath, dst fpath.FPath, format archive.Format, showProgress bool) (err error) {
+	if src.IsLocal() {
+		return fmt.Errorf("source must be Storj URL: %s", src)
+	}
+
+	if !dst.IsLocal() {
+		return fmt.Errorf("destination must be local path: %s", dst)
+	}
+
+	project, bucket, err := cfg.GetProjectAndBucket(ctx, src.Bucket())
+	if err != nil {
+		return err
+	}
+	defer closeProjectAndBucket(project, bucket)
+
+	prefix := src.Path()
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	entries, err := archive.ListEntries(ctx, bucket, prefix)
+	if err != nil {
+		return convertError(err, src)
+	}
+
+	var total int64
+	for _, entry := range entries {
+		total += entry.Size
+	}
+
+	if len(entries) == 0 {
+		return fmt.Errorf("No objects found under %s", src)
+	}
+
+	var bar *progressbar.ProgressBar
+	if showProgress && dst.Base() != "-" {
+		bar = progressbar.New64(total).SetUnits(progressbar.U_BYTES).SetWidth(80)
+		bar.ShowSpeed = true
+		bar.Start()
+		for i := range entries {
+			open := entries[i].Open
+			entries[i].Open = func(ctx context.Context) (io.ReadCloser, error) {
+				rc, err := open(ctx)
+				if err != nil {
+					return nil, err
+				}
+				return struct {
+					io.Reader
+					io.Closer
+				}{bar.NewProxyReader(rc), rc}, nil
+			}
+		}
+	}
+
+	if fileInfo, err := os.Stat(dst.Path()); err == nil && fileInfo.IsDir() {
+		name := src.Base()
+		if name == "" {
+			name = src.Bucket()
+		}
+		dst = dst.Join(name + format.Extension())
+	}
+
+	var file *os.File
+	if dst.Base() == "-" {
+		file = os.Stdout
+	} else {
+		file, err = os.Create(dst.Path())
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := file.Close(); err != nil {
+				fmt.Printf("error closing file: %+v\n", err)
+			}
+		}()
+	}
+
+	err = archive.Write(ctx, file, format, entries, archive.DefaultConcurrency)
+	if err != nil {
+		return err
+	}
+
+	if bar != nil {
+		bar.Finish()
+	}
+
+	if dst.Base() != "-" {
+		fmt.Printf("Downloaded %s to %s\n", src.String(), dst.String())
+	}
+
+	return nil
+}
+
 // copy copies s3 compatible object src to s3 compatible object dst
 func copyObject(ctx context.Context, src fpath.FPath, dst fpath.FPath) (err error) {
 	if src.IsLocal() {
@@ -241,6 +344,9 @@ func copyObject(ctx context.Context, src fpath.FPath, dst fpath.FPath) (err erro
 	}
 	opts.Volatile.RedundancyScheme = cfg.GetRedundancyScheme()
 	opts.Volatile.EncryptionParameters = cfg.GetEncryptionParameters()
+	if *compress {
+		opts.Volatile.Compression = storj.CompressionGzip
+	}
 	err = bucket.UploadObject(ctx, dst.Path(), reader, opts)
 	if err != nil {
 		return err
@@ -281,6 +387,14 @@ func copyMain(cmd *cobra.Command, args []string) (err error) {
 		return errors.New("At least one of the source or the desination must be a Storj URL")
 	}
 
+	if *archiveFormat != "" {
+		format, err := archive.ParseFormat(*archiveFormat)
+		if err != nil {
+			return err
+		}
+		return downloadArchive(ctx, src, dst, format, *progress)
+	}
+
 	// if uploading
 	if src.IsLocal() {
 		return upload(ctx, src, dst, *progress)
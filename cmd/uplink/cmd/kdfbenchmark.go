@@ -0,0 +1,87 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/encryption"
+)
+
+var (
+	kdfTarget      *time.Duration
+	kdfMemory      = 64 * memory.MiB
+	kdfParallelism *uint8
+)
+
+func init() {
+	// kdf-benchmark doesn't need an uplink configuration, like setup
+	kdfBenchmarkCmd := &cobra.Command{
+		Use:         "kdf-benchmark",
+		Short:       "Measures how long deriving an encryption key from a passphrase takes on this machine",
+		RunE:        kdfBenchmark,
+		Annotations: map[string]string{"type": "setup"},
+	}
+	RootCmd.AddCommand(kdfBenchmarkCmd)
+	kdfTarget = kdfBenchmarkCmd.Flags().Duration("target", time.Second, "how long deriving a key should take")
+	kdfBenchmarkCmd.Flags().Var(&kdfMemory, "memory", "how much memory deriving a key should use")
+	kdfParallelism = kdfBenchmarkCmd.Flags().Uint8("parallelism", 4, "how many threads deriving a key should use")
+}
+
+// kdfBenchmark times the key derivation profiles and searches for the
+// number of passes which take at least the target duration with the given
+// memory
+func kdfBenchmark(cmd *cobra.Command, args []string) (err error) {
+	for _, name := range encryption.KDFProfileNames() {
+		params, err := encryption.KDFProfile(name)
+		if err != nil {
+			return err
+		}
+		elapsed, err := timeKDF(params)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%-10s %-28s %v\n", name, params, elapsed)
+	}
+
+	params := encryption.KDFParameters{
+		Version:     encryption.KDFArgon2id,
+		Time:        1,
+		Memory:      kdfMemory,
+		Parallelism: *kdfParallelism,
+	}
+
+	// the duration grows about linearly with the number of passes
+	elapsed, err := timeKDF(params)
+	if err != nil {
+		return err
+	}
+	for elapsed < *kdfTarget {
+		passes := uint32(float64(params.Time) * kdfTarget.Seconds() / elapsed.Seconds())
+		if passes <= params.Time {
+			passes = params.Time + 1
+		}
+		params.Time = passes
+
+		elapsed, err = timeKDF(params)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("\nDeriving a key with %v takes %v.\n", params, elapsed)
+	fmt.Printf("Use it with: uplink setup --kdf-profile %v\n", params)
+	return nil
+}
+
+// timeKDF returns how long deriving a key with params takes
+func timeKDF(params encryption.KDFParameters) (time.Duration, error) {
+	start := time.Now()
+	_, err := encryption.DeriveRootKeyWithParameters([]byte("passphrase"), []byte("salt"), "", params)
+	return time.Since(start), err
+}
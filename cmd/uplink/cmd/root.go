@@ -26,7 +26,8 @@ import (
 
 // UplinkFlags configuration flags
 type UplinkFlags struct {
-	NonInteractive bool `help:"disable interactive mode" default:"false" setup:"true"`
+	NonInteractive bool   `help:"disable interactive mode" default:"false" setup:"true"`
+	KDFProfile     string `help:"how to derive the encryption key from the passphrase: a profile (archive, default, mobile) or parameters printed by kdf-benchmark" default:"default" setup:"true"`
 	uplink.Config
 
 	Version version.Config
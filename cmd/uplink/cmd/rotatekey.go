@@ -0,0 +1,87 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/internal/fpath"
+	libuplink "storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+)
+
+var newKeyFile *string
+
+func init() {
+	rotateKeyCmd := addCmd(&cobra.Command{
+		Use:   "rotate-key",
+		Short: "Re-encrypts the paths and content keys of objects with a new root encryption key",
+		RunE:  rotateKey,
+	}, RootCmd)
+	newKeyFile = rotateKeyCmd.Flags().String("new-key-file", "", "the path to a file which contains the new root encryption key")
+}
+
+// rotateKey rekeys all objects under the given bucket or prefix for a new
+// root encryption key without re-uploading their data
+func rotateKey(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	if len(args) == 0 {
+		return fmt.Errorf("No bucket specified, use format sj://bucket/")
+	}
+	if *newKeyFile == "" {
+		return fmt.Errorf("No new key specified, use --new-key-file")
+	}
+
+	src, err := fpath.New(args[0])
+	if err != nil {
+		return err
+	}
+	if src.IsLocal() {
+		return fmt.Errorf("No bucket specified, use format sj://bucket/")
+	}
+
+	data, err := ioutil.ReadFile(*newKeyFile)
+	if err != nil {
+		return err
+	}
+	key, err := storj.NewKey(data)
+	if err != nil {
+		return err
+	}
+	newAccess := libuplink.NewEncryptionAccessWithDefaultKey(*key)
+
+	project, bucket, err := cfg.GetProjectAndBucket(ctx, src.Bucket())
+	if err != nil {
+		return convertError(err, src)
+	}
+	defer closeProjectAndBucket(project, bucket)
+
+	rotated, err := bucket.RotateEncryptionKey(ctx, src.Path(), newAccess)
+	fmt.Printf("Rotated %d objects in %s\n", rotated, src.String())
+	if err != nil {
+		fmt.Println("Run the command again with the same keys to resume the rotation.")
+		return err
+	}
+
+	scope, err := cfg.GetScope()
+	if err != nil {
+		return err
+	}
+	scope.EncryptionAccess = newAccess
+
+	serialized, err := scope.Serialize()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Use the following scope to access the rotated objects:")
+	fmt.Println(serialized)
+
+	return nil
+}
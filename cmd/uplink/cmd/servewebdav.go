@@ -0,0 +1,91 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/net/webdav"
+
+	libuplink "storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/webdavfs"
+)
+
+var (
+	webdavAddr     *string
+	webdavCacheDir *string
+)
+
+func init() {
+	serveWebDAVCmd := addCmd(&cobra.Command{
+		Use:   "serve-webdav",
+		Short: "Serves the buckets of the project as a WebDAV file system, which can be mounted as a drive",
+		RunE:  serveWebDAV,
+	}, RootCmd)
+	webdavAddr = serveWebDAVCmd.Flags().String("addr", "localhost:7778", "address to serve WebDAV on")
+	webdavCacheDir = serveWebDAVCmd.Flags().String("cache-dir", "", "directory where files are cached while they are written (default: the temporary directory)")
+}
+
+// serveWebDAV serves the buckets of the project as a WebDAV file system
+// until it's interrupted
+func serveWebDAV(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	scope, err := cfg.GetScope()
+	if err != nil {
+		return err
+	}
+
+	project, err := cfg.GetProject(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, project.Close()) }()
+
+	var opts libuplink.UploadOptions
+	opts.Volatile.RedundancyScheme = cfg.GetRedundancyScheme()
+	opts.Volatile.EncryptionParameters = cfg.GetEncryptionParameters()
+
+	fs := webdavfs.New(zap.L(), project, scope.EncryptionAccess, webdavfs.Config{
+		CacheDir:      *webdavCacheDir,
+		UploadOptions: opts,
+	})
+	defer func() { err = errs.Combine(err, fs.Close()) }()
+
+	listener, err := net.Listen("tcp", *webdavAddr)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Handler: &webdav.Handler{
+			FileSystem: fs,
+			LockSystem: webdav.NewMemLS(),
+			Logger: func(r *http.Request, err error) {
+				if err != nil {
+					zap.L().Debug("WebDAV request failed", zap.String("method", r.Method), zap.String("path", r.URL.Path), zap.Error(err))
+				}
+			},
+		},
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+
+	fmt.Printf("Serving WebDAV on http://%s/\n", listener.Addr())
+
+	err = server.Serve(listener)
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
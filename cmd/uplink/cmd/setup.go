@@ -17,6 +17,7 @@ import (
 	"storj.io/storj/internal/fpath"
 	libuplink "storj.io/storj/lib/uplink"
 	"storj.io/storj/pkg/cfgstruct"
+	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/process"
 )
 
@@ -115,6 +116,11 @@ func cmdSetupInteractive(cmd *cobra.Command, setupDir string) error {
 		return Error.Wrap(err)
 	}
 
+	kdf, err := encryption.ParseKDFParameters(setupCfg.KDFProfile)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
 	passphrase, err := wizard.PromptForEncryptionPassphrase()
 	if err != nil {
 		return Error.Wrap(err)
@@ -132,15 +138,18 @@ func cmdSetupInteractive(cmd *cobra.Command, setupDir string) error {
 	}
 	defer func() { err = errs.Combine(err, project.Close()) }()
 
-	key, err := project.SaltedKeyFromPassphrase(ctx, passphrase)
+	key, err := project.SaltedKeyFromPassphraseWithKDF(ctx, passphrase, kdf)
 	if err != nil {
 		return Error.Wrap(err)
 	}
 
+	access := libuplink.NewEncryptionAccessWithDefaultKey(*key)
+	access.SetKDFParameters(kdf)
+
 	scopeData, err := (&libuplink.Scope{
 		SatelliteAddr:    satelliteAddress,
 		APIKey:           apiKey,
-		EncryptionAccess: libuplink.NewEncryptionAccessWithDefaultKey(*key),
+		EncryptionAccess: access,
 	}).Serialize()
 	if err != nil {
 		return Error.Wrap(err)
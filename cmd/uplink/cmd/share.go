@@ -10,28 +10,39 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
+	"go.uber.org/zap"
 
 	"storj.io/storj/internal/fpath"
+	"storj.io/storj/internal/memory"
 	libuplink "storj.io/storj/lib/uplink"
 	"storj.io/storj/pkg/cfgstruct"
 	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/peertls/tlsopts"
 	"storj.io/storj/pkg/process"
 	"storj.io/storj/uplink"
 )
 
 var shareCfg struct {
-	DisallowReads     bool     `default:"false" help:"if true, disallow reads"`
-	DisallowWrites    bool     `default:"false" help:"if true, disallow writes"`
-	DisallowLists     bool     `default:"false" help:"if true, disallow lists"`
-	DisallowDeletes   bool     `default:"false" help:"if true, disallow deletes"`
-	Readonly          bool     `default:"false" help:"implies disallow_writes and disallow_deletes"`
-	Writeonly         bool     `default:"false" help:"implies disallow_reads and disallow_lists"`
-	NotBefore         string   `help:"disallow access before this time"`
-	NotAfter          string   `help:"disallow access after this time"`
-	AllowedPathPrefix []string `help:"whitelist of bucket path prefixes to require"`
+	DisallowReads     bool        `default:"false" help:"if true, disallow reads"`
+	DisallowWrites    bool        `default:"false" help:"if true, disallow writes"`
+	DisallowLists     bool        `default:"false" help:"if true, disallow lists"`
+	DisallowDeletes   bool        `default:"false" help:"if true, disallow deletes"`
+	Readonly          bool        `default:"false" help:"implies disallow_writes and disallow_deletes"`
+	Writeonly         bool        `default:"false" help:"implies disallow_reads and disallow_lists"`
+	NotBefore         string      `help:"disallow access before this time"`
+	NotAfter          string      `help:"disallow access after this time"`
+	AllowedPathPrefix []string    `help:"whitelist of bucket path prefixes to require"`
+	AllowedIPRange    []string    `help:"whitelist of client ip ranges in cidr notation to require"`
+	MaxObjectSize     memory.Size `default:"0B" help:"if set, the maximum encrypted size of uploaded objects"`
+	MaxSegments       int64       `default:"0" help:"if set, the maximum number of segments of uploaded objects"`
+	NoOverwrite       bool        `default:"false" help:"if true, disallow uploads replacing existing objects"`
+	Revoke            string      `help:"revoke the given api key derived from the current one instead of creating a new one"`
 
 	// Share requires information about the current scope
 	uplink.ScopeConfig
+
+	// Revoking requires connecting to the satellite
+	TLS tlsopts.Config
 }
 
 func init() {
@@ -78,6 +89,10 @@ func parseHumanDate(date string, now time.Time) (*time.Time, error) {
 
 // shareMain is the function executed when shareCmd is called
 func shareMain(cmd *cobra.Command, args []string) (err error) {
+	if shareCfg.Revoke != "" {
+		return revokeMain(cmd)
+	}
+
 	now := time.Now()
 
 	notBefore, err := parseHumanDate(shareCfg.NotBefore, now)
@@ -130,6 +145,16 @@ func shareMain(cmd *cobra.Command, args []string) (err error) {
 	caveat.NotBefore = notBefore
 	caveat.NotAfter = notAfter
 
+	err = libuplink.UploadRestrictions{
+		AllowedIPRanges: shareCfg.AllowedIPRange,
+		MaxObjectSize:   shareCfg.MaxObjectSize,
+		MaxSegments:     shareCfg.MaxSegments,
+		NoOverwrite:     shareCfg.NoOverwrite,
+	}.Apply(&caveat)
+	if err != nil {
+		return err
+	}
+
 	{
 		// Times don't marshal very well with MarshalTextString, and the nonce doesn't
 		// matter to humans, so handle those explicitly and then dispatch to the generic
@@ -173,3 +198,43 @@ func shareMain(cmd *cobra.Command, args []string) (err error) {
 	fmt.Println("scope  :", scopeData)
 	return nil
 }
+
+// revokeMain revokes the api key given with --revoke
+func revokeMain(cmd *cobra.Command) (err error) {
+	ctx := process.Ctx(cmd)
+
+	revoked, err := libuplink.ParseAPIKey(shareCfg.Revoke)
+	if err != nil {
+		return err
+	}
+
+	scope, err := shareCfg.GetScope()
+	if err != nil {
+		return err
+	}
+
+	libuplinkCfg := &libuplink.Config{}
+	libuplinkCfg.Volatile.Log = zap.L()
+	libuplinkCfg.Volatile.TLS.SkipPeerCAWhitelist = !shareCfg.TLS.UsePeerCAWhitelist
+	libuplinkCfg.Volatile.TLS.PeerCAWhitelistPath = shareCfg.TLS.PeerCAWhitelistPath
+
+	uplk, err := libuplink.NewUplink(ctx, libuplinkCfg)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, uplk.Close()) }()
+
+	project, err := uplk.OpenProject(ctx, scope.SatelliteAddr, scope.APIKey)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, project.Close()) }()
+
+	err = project.RevokeAPIKey(ctx, revoked)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("revoked:", revoked.Serialize())
+	return nil
+}
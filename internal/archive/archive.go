@@ -0,0 +1,264 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package archive streams ZIP and TAR archives of remote objects.
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"context"
+	"io"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// Error is the default error class for archives
+var Error = errs.Class("archive error")
+
+// DefaultConcurrency is the default number of entries downloaded concurrently
+const DefaultConcurrency = 4
+
+const (
+	// chunkSize is the size of the chunks read ahead from entries
+	chunkSize = 32 * 1024
+	// chunksAhead is the number of chunks buffered per entry
+	chunksAhead = 8
+)
+
+// Format is an archive format
+type Format string
+
+const (
+	// ZIP is the zip archive format
+	ZIP = Format("zip")
+	// TAR is the tar archive format
+	TAR = Format("tar")
+)
+
+// ParseFormat parses the name of an archive format
+func ParseFormat(name string) (Format, error) {
+	switch format := Format(name); format {
+	case ZIP, TAR:
+		return format, nil
+	}
+	return "", Error.New("unsupported format %q", name)
+}
+
+// Extension returns the file extension of the format including the dot
+func (format Format) Extension() string {
+	return "." + string(format)
+}
+
+// ContentType returns the MIME type of the format
+func (format Format) ContentType() string {
+	if format == ZIP {
+		return "application/zip"
+	}
+	return "application/x-tar"
+}
+
+// Entry is a file added to an archive
+type Entry struct {
+	// Path is the path of the file within the archive
+	Path string
+	// Size is the size of the file in bytes
+	Size int64
+	// Modified is the modification time of the file
+	Modified time.Time
+	// Open opens the contents of the file, it's not called for empty files
+	Open func(ctx context.Context) (io.ReadCloser, error)
+}
+
+// Write writes the entries as an archive of the given format to w.
+//
+// Up to concurrency entries are downloaded at the same time, each reading at
+// most chunksAhead chunks ahead of the archive, so that whole entries are
+// never buffered in memory.
+func Write(ctx context.Context, w io.Writer, format Format, entries []Entry, concurrency int) (err error) {
+	var archive archiveWriter
+	switch format {
+	case ZIP:
+		archive = &zipWriter{zip.NewWriter(w)}
+	case TAR:
+		archive = &tarWriter{tar.NewWriter(w)}
+	default:
+		return Error.New("unsupported format %q", format)
+	}
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer func() {
+		// stop pending downloads and wait until they have been closed
+		cancel()
+		wg.Wait()
+	}()
+
+	fetches := make(chan *prefetch, concurrency)
+	slots := make(chan struct{}, concurrency)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(fetches)
+
+		for _, entry := range entries {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			fetch := &prefetch{
+				entry:  entry,
+				chunks: make(chan []byte, chunksAhead),
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				fetch.run(ctx)
+				<-slots
+			}()
+
+			select {
+			case fetches <- fetch:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for fetch := range fetches {
+		if err := archive.Add(fetch.entry, fetch); err != nil {
+			return Error.New("%q: %v", fetch.entry.Path, err)
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(archive.Close())
+}
+
+// entryName returns the name of the entry within the archive, which must not
+// escape the directory the archive is extracted to
+func entryName(entryPath string) string {
+	return path.Clean("/" + entryPath)[1:]
+}
+
+// prefetch reads an entry ahead of the archive writer
+type prefetch struct {
+	entry  Entry
+	chunks chan []byte
+	// err is the error of reading the entry, it's set before chunks is closed
+	err     error
+	current []byte
+}
+
+// run reads the entry until it's done or ctx is canceled
+func (fetch *prefetch) run(ctx context.Context) {
+	defer close(fetch.chunks)
+
+	if fetch.entry.Size == 0 {
+		return
+	}
+
+	rc, err := fetch.entry.Open(ctx)
+	if err != nil {
+		fetch.err = err
+		return
+	}
+	defer func() { fetch.err = errs.Combine(fetch.err, rc.Close()) }()
+
+	for {
+		chunk := make([]byte, chunkSize)
+		n, err := io.ReadFull(rc, chunk)
+		if n > 0 {
+			select {
+			case fetch.chunks <- chunk[:n]:
+			case <-ctx.Done():
+				fetch.err = ctx.Err()
+				return
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return
+		}
+		if err != nil {
+			fetch.err = err
+			return
+		}
+	}
+}
+
+// Read reads the prefetched contents of the entry
+func (fetch *prefetch) Read(p []byte) (int, error) {
+	for len(fetch.current) == 0 {
+		chunk, ok := <-fetch.chunks
+		if !ok {
+			if fetch.err != nil {
+				return 0, fetch.err
+			}
+			return 0, io.EOF
+		}
+		fetch.current = chunk
+	}
+
+	n := copy(p, fetch.current)
+	fetch.current = fetch.current[n:]
+	return n, nil
+}
+
+// archiveWriter adds entries to an archive
+type archiveWriter interface {
+	Add(entry Entry, contents io.Reader) error
+	Close() error
+}
+
+type zipWriter struct{ w *zip.Writer }
+
+func (archive *zipWriter) Add(entry Entry, contents io.Reader) error {
+	w, err := archive.w.CreateHeader(&zip.FileHeader{
+		Name:     entryName(entry.Path),
+		Method:   zip.Deflate,
+		Modified: entry.Modified,
+	})
+	if err != nil {
+		return err
+	}
+	n, err := io.Copy(w, contents)
+	if err != nil {
+		return err
+	}
+	if n != entry.Size {
+		return errs.New("read %d bytes, expected %d", n, entry.Size)
+	}
+	return nil
+}
+
+func (archive *zipWriter) Close() error { return archive.w.Close() }
+
+type tarWriter struct{ w *tar.Writer }
+
+func (archive *tarWriter) Add(entry Entry, contents io.Reader) error {
+	err := archive.w.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     entryName(entry.Path),
+		Size:     entry.Size,
+		Mode:     0644,
+		ModTime:  entry.Modified,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(archive.w, contents)
+	return err
+}
+
+func (archive *tarWriter) Close() error { return archive.w.Close() }
@@ -0,0 +1,131 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package archive_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/archive"
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+)
+
+func TestWrite(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	modified := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+	files := map[string][]byte{
+		"a.txt":          testrand.Bytes(10 * memory.B),
+		"dir/b.bin":      testrand.Bytes(1 * memory.MiB),
+		"dir/empty":      {},
+		"dir/sub/c.html": testrand.Bytes(100 * memory.KiB),
+		"../escape":      testrand.Bytes(1 * memory.KiB),
+	}
+
+	var paths []string
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	var entries []archive.Entry
+	for _, p := range paths {
+		data := files[p]
+		entries = append(entries, archive.Entry{
+			Path:     p,
+			Size:     int64(len(data)),
+			Modified: modified,
+			Open: func(ctx context.Context) (io.ReadCloser, error) {
+				if len(data) == 0 {
+					return nil, errors.New("empty entries must not be opened")
+				}
+				return ioutil.NopCloser(bytes.NewReader(data)), nil
+			},
+		})
+	}
+
+	expected := map[string][]byte{}
+	for p, data := range files {
+		expected[p] = data
+	}
+	expected["escape"] = expected["../escape"]
+	delete(expected, "../escape")
+
+	for _, concurrency := range []int{1, 2, 8} {
+		var buf bytes.Buffer
+		require.NoError(t, archive.Write(ctx, &buf, archive.ZIP, entries, concurrency))
+
+		zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.NoError(t, err)
+
+		got := map[string][]byte{}
+		for _, file := range zr.File {
+			assert.True(t, file.Modified.Equal(modified), file.Name)
+			rc, err := file.Open()
+			require.NoError(t, err)
+			data, err := ioutil.ReadAll(rc)
+			require.NoError(t, err)
+			require.NoError(t, rc.Close())
+			got[file.Name] = data
+		}
+		assert.Equal(t, expected, got)
+
+		buf.Reset()
+		require.NoError(t, archive.Write(ctx, &buf, archive.TAR, entries, concurrency))
+
+		tr := tar.NewReader(&buf)
+		got = map[string][]byte{}
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			assert.True(t, header.ModTime.Equal(modified), header.Name)
+			data, err := ioutil.ReadAll(tr)
+			require.NoError(t, err)
+			got[header.Name] = data
+		}
+		assert.Equal(t, expected, got)
+	}
+}
+
+func TestWriteError(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	failure := errors.New("download failed")
+
+	var entries []archive.Entry
+	for i := 0; i < 10; i++ {
+		entries = append(entries, archive.Entry{
+			Path: string('a' + rune(i)),
+			Size: 1,
+			Open: func(ctx context.Context) (io.ReadCloser, error) {
+				return nil, failure
+			},
+		})
+	}
+
+	err := archive.Write(ctx, ioutil.Discard, archive.TAR, entries, 2)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), failure.Error())
+
+	_, err = archive.ParseFormat("rar")
+	assert.Error(t, err)
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package archive
+
+import (
+	"context"
+	"io"
+
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+)
+
+var mon = monkit.Package()
+
+// ListEntries lists all objects of the bucket under the prefix as entries
+// with paths relative to the prefix. The prefix is either empty or ends with
+// a slash.
+func ListEntries(ctx context.Context, bucket *uplink.Bucket, prefix string) (entries []Entry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	opts := uplink.ListOptions{
+		Prefix:    prefix,
+		Direction: storj.After,
+		Recursive: true,
+	}
+	for {
+		list, err := bucket.ListObjects(ctx, &opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range list.Items {
+			if object.IsPrefix {
+				continue
+			}
+			objectPath, size := prefix+object.Path, object.Size
+			entries = append(entries, Entry{
+				Path:     object.Path,
+				Size:     size,
+				Modified: object.Modified,
+				Open: func(ctx context.Context) (io.ReadCloser, error) {
+					return bucket.DownloadRange(ctx, objectPath, 0, size)
+				},
+			})
+		}
+
+		if !list.More || len(list.Items) == 0 {
+			return entries, nil
+		}
+		opts.Cursor = list.Items[len(list.Items)-1].Path
+	}
+}
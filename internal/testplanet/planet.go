@@ -19,12 +19,14 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
 	"golang.org/x/sync/errgroup"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/bootstrap"
 	"storj.io/storj/internal/testidentity"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/tracing"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/storagenode"
@@ -48,6 +50,10 @@ type Config struct {
 	StorageNodeCount int
 	UplinkCount      int
 
+	// DisableKademlia runs the planet without the bootstrap node and
+	// kademlia, storage nodes check in with the satellites instead.
+	DisableKademlia bool
+
 	Identities      *testidentity.Identities
 	IdentityVersion *storj.IDVersion
 	Reconfigure     Reconfigure
@@ -72,6 +78,11 @@ type Planet struct {
 	StorageNodes   []*storagenode.Peer
 	Uplinks        []*Uplink
 
+	// Tracing collects the spans of the traces started with
+	// tracing.StartTrace into Traces.
+	Tracing *tracing.Collector
+	Traces  *tracing.MemoryExporter
+
 	identities    *testidentity.Identities
 	whitelistPath string // TODO: in-memory
 
@@ -150,7 +161,12 @@ func NewCustom(log *zap.Logger, config Config) (*Planet, error) {
 		log:        log,
 		config:     config,
 		identities: config.Identities,
+		Traces:     tracing.NewMemoryExporter(),
 	}
+	planet.Tracing = tracing.NewCollector(log.Named("tracing"), monkit.Default, planet.Traces, tracing.Config{
+		Service:           "testplanet",
+		RemoteSampleLimit: 1000,
+	})
 
 	var err error
 	planet.directory, err = ioutil.TempDir("", "planet")
@@ -169,9 +185,11 @@ func NewCustom(log *zap.Logger, config Config) (*Planet, error) {
 		return nil, errs.Combine(err, planet.Shutdown())
 	}
 
-	planet.Bootstrap, err = planet.newBootstrap()
-	if err != nil {
-		return nil, errs.Combine(err, planet.Shutdown())
+	if !config.DisableKademlia {
+		planet.Bootstrap, err = planet.newBootstrap()
+		if err != nil {
+			return nil, errs.Combine(err, planet.Shutdown())
+		}
 	}
 
 	planet.Satellites, err = planet.newSatellites(config.SatelliteCount)
@@ -194,6 +212,10 @@ func NewCustom(log *zap.Logger, config Config) (*Planet, error) {
 		return nil, errs.Combine(err, planet.Shutdown())
 	}
 
+	if config.DisableKademlia {
+		return planet, nil
+	}
+
 	// init Satellites
 	for _, satellite := range planet.Satellites {
 		if len(satellite.Kademlia.Service.GetBootstrapNodes()) == 0 {
@@ -229,6 +251,11 @@ func (planet *Planet) Start(ctx context.Context) {
 
 	planet.started = true
 
+	if planet.config.DisableKademlia {
+		planet.Reconnect(ctx)
+		return
+	}
+
 	planet.Bootstrap.Kademlia.Service.WaitForBootstrap()
 
 	for _, peer := range planet.StorageNodes {
@@ -248,6 +275,22 @@ func (planet *Planet) Reconnect(ctx context.Context) {
 
 	var group errgroup.Group
 
+	if planet.config.DisableKademlia {
+		for _, storageNode := range planet.StorageNodes {
+			storageNode := storageNode
+			group.Go(func() error {
+				// the capacity has to be known before checking in and the
+				// check-in mustn't race with the one at startup
+				storageNode.Storage2.Monitor.Loop.TriggerWait()
+				storageNode.Contact.Chore.Loop.TriggerWait()
+				return nil
+			})
+		}
+
+		_ = group.Wait() // none of the goroutines return an error
+		return
+	}
+
 	// TODO: instead of pinging try to use Lookups or natural discovery to ensure
 	// everyone finds everyone else
 
@@ -333,11 +376,21 @@ func (planet *Planet) Shutdown() error {
 		errlist.Add(db.Close())
 	}
 	errlist.Add(planet.VersionControl.Close())
+	errlist.Add(planet.Tracing.Close())
 
 	errlist.Add(os.RemoveAll(planet.directory))
 	return errlist.Err()
 }
 
+// SpanTrees exports the collected spans and returns the span trees of the
+// trace. Spans which have not finished yet are missing from the trees.
+func (planet *Planet) SpanTrees(ctx context.Context, traceID int64) ([]*tracing.Tree, error) {
+	if err := planet.Tracing.Flush(ctx); err != nil {
+		return nil, err
+	}
+	return planet.Traces.Trace(traceID), nil
+}
+
 // Identities returns the identity provider for this planet.
 func (planet *Planet) Identities() *testidentity.Identities {
 	return planet.identities
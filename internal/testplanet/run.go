@@ -65,7 +65,9 @@ func Run(t *testing.T, config Config, test func(t *testing.T, ctx *testcontext.C
 			planet.Start(ctx)
 
 			// make sure nodes are refreshed in db
-			planet.Satellites[0].Discovery.Service.Refresh.TriggerWait()
+			if !planetConfig.DisableKademlia {
+				planet.Satellites[0].Discovery.Service.Refresh.TriggerWait()
+			}
 
 			test(t, ctx, planet)
 		})
@@ -19,6 +19,7 @@ import (
 	"storj.io/storj/pkg/revocation"
 	"storj.io/storj/pkg/server"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/accounting/alerts"
 	"storj.io/storj/satellite/accounting/rollup"
 	"storj.io/storj/satellite/accounting/tally"
 	"storj.io/storj/satellite/audit"
@@ -88,6 +89,7 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				},
 			},
 			Kademlia: kademlia.Config{
+				Disabled:             planet.config.DisableKademlia,
 				Alpha:                5,
 				BootstrapBackoffBase: 500 * time.Millisecond,
 				BootstrapBackoffMax:  2 * time.Second,
@@ -146,6 +148,12 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				Loop: metainfo.LoopConfig{
 					CoalesceDuration: 5 * time.Second,
 				},
+				KeyUsage: metainfo.KeyUsageConfig{
+					FlushInterval: 10 * time.Second,
+				},
+				Revocation: metainfo.RevocationConfig{
+					CacheExpiration: time.Minute,
+				},
 			},
 			Orders: orders.Config{
 				Expiration: 7 * 24 * time.Hour,
@@ -186,6 +194,11 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				MaxAlphaUsage: 25 * memory.GB,
 				DeleteTallies: false,
 			},
+			UsageAlerts: alerts.Config{
+				Interval:          10 * time.Second,
+				DefaultThresholds: "80,100",
+				WebhookTimeout:    10 * time.Second,
+			},
 			Mail: mailservice.Config{
 				SMTPServerAddress: "smtp.mail.test:587",
 				From:              "Labs <storj@mail.test>",
@@ -24,12 +24,15 @@ import (
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/collector"
 	"storj.io/storj/storagenode/console/consoleserver"
+	"storj.io/storj/storagenode/contact"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/nodestats"
 	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/payouts"
 	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/storagenodedb"
+	"storj.io/storj/storagenode/trust"
 )
 
 // newStorageNodes initializes storage nodes
@@ -72,6 +75,7 @@ func (planet *Planet) newStorageNodes(count int, whitelistedSatellites storj.Nod
 				},
 			},
 			Kademlia: kademlia.Config{
+				Disabled:             planet.config.DisableKademlia,
 				BootstrapBackoffBase: 500 * time.Millisecond,
 				BootstrapBackoffMax:  2 * time.Second,
 				Alpha:                5,
@@ -81,6 +85,14 @@ func (planet *Planet) newStorageNodes(count int, whitelistedSatellites storj.Nod
 					Wallet: "0x" + strings.Repeat("00", 20),
 				},
 			},
+			Contact: contact.Config{
+				Interval: 30 * time.Second,
+			},
+			Trust: trust.Config{
+				RefreshInterval: time.Hour,
+				RequestTimeout:  time.Minute,
+				CachePath:       filepath.Join(storageDir, "trust-cache.json"),
+			},
 			Storage: piecestore.OldConfig{
 				Path:                   filepath.Join(storageDir, "pieces/"),
 				AllocatedDiskSpace:     1 * memory.GB,
@@ -100,6 +112,14 @@ func (planet *Planet) newStorageNodes(count int, whitelistedSatellites storj.Nod
 				Address:   "127.0.0.1:0",
 				StaticDir: filepath.Join(developmentRoot, "web/operator/"),
 			},
+			Payouts: payouts.Config{
+				EgressRate:       2000,
+				RepairEgressRate: 1000,
+				AuditEgressRate:  1000,
+				DiskRate:         150,
+				HeldBack:         payouts.HeldBack{75, 75, 75, 50, 50, 50, 25, 25, 25, 0},
+				HistoryMonths:    15,
+			},
 			Storage2: piecestore.Config{
 				CacheSyncInterval:     time.Hour,
 				ExpirationGracePeriod: 0,
@@ -0,0 +1,66 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package version
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"reflect"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// Rollouts provides the staged rollout of a new version per Service
+type Rollouts struct {
+	Bootstrap   Rollout
+	Satellite   Rollout
+	Storagenode Rollout
+	Uplink      Rollout
+	Gateway     Rollout
+	Identity    Rollout
+}
+
+// Rollout describes the staged rollout of a version. Nodes inside the
+// rollout are required to run at least Version, all other nodes only the
+// minimum version of the service.
+type Rollout struct {
+	// Version is the version which is rolled out, there is no rollout if
+	// it's zero
+	Version SemVer `json:"version"`
+	// Seed selects which nodes are inside the rollout
+	Seed string `json:"seed"`
+	// Cursor is the percentage of nodes inside the rollout
+	Cursor int `json:"cursor"`
+}
+
+// Contains returns whether the node is inside the rollout. Nodes are placed
+// by hashing their ID with the seed, so that raising the cursor only adds
+// nodes to the rollout.
+func (rollout Rollout) Contains(nodeID storj.NodeID) bool {
+	if rollout.Version == (SemVer{}) || rollout.Cursor <= 0 {
+		return false
+	}
+	if rollout.Cursor >= 100 {
+		return true
+	}
+
+	hash := sha256.New()
+	_, _ = hash.Write([]byte(rollout.Seed))
+	_, _ = hash.Write(nodeID.Bytes())
+	sum := hash.Sum(nil)
+	return binary.BigEndian.Uint64(sum[:8])%100 < uint64(rollout.Cursor)
+}
+
+func getFieldRollout(rollouts *Rollouts, field string) Rollout {
+	r := reflect.ValueOf(rollouts)
+	f := reflect.Indirect(r).FieldByName(field)
+	if !f.IsValid() {
+		return Rollout{}
+	}
+	result, ok := f.Interface().(Rollout)
+	if ok {
+		return result
+	}
+	return Rollout{}
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package version_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/internal/version"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestRolloutContains(t *testing.T) {
+	nodes := make([]storj.NodeID, 1000)
+	for i := range nodes {
+		nodes[i] = testrand.NodeID()
+	}
+
+	rollout := version.Rollout{
+		Version: version.SemVer{Major: 0, Minor: 0, Patch: 2},
+		Seed:    "seed",
+	}
+
+	count := func(rollout version.Rollout) (inside int) {
+		for _, node := range nodes {
+			if rollout.Contains(node) {
+				inside++
+			}
+		}
+		return inside
+	}
+
+	rollout.Cursor = 0
+	assert.Equal(t, 0, count(rollout))
+
+	rollout.Cursor = 100
+	assert.Equal(t, len(nodes), count(rollout))
+
+	rollout.Cursor = 50
+	assert.InDelta(t, len(nodes)/2, count(rollout), float64(len(nodes))/10)
+
+	// raising the cursor keeps the nodes inside the rollout
+	lower, higher := rollout, rollout
+	lower.Cursor, higher.Cursor = 20, 60
+	for _, node := range nodes {
+		if lower.Contains(node) {
+			assert.True(t, higher.Contains(node))
+		}
+	}
+
+	// a zero version isn't rolled out
+	rollout.Version = version.SemVer{}
+	rollout.Cursor = 100
+	assert.Equal(t, 0, count(rollout))
+}
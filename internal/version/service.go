@@ -15,6 +15,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/storj"
 )
 
 // Config contains the necessary Information to check the Software Version
@@ -29,6 +30,7 @@ type Service struct {
 	log     *zap.Logger
 	config  Config
 	info    Info
+	nodeID  storj.NodeID
 	service string
 
 	Loop *sync2.Cycle
@@ -38,12 +40,14 @@ type Service struct {
 	allowed bool
 }
 
-// NewService creates a Version Check Client with default configuration
-func NewService(log *zap.Logger, config Config, info Info, service string) (client *Service) {
+// NewService creates a Version Check Client with default configuration. The
+// rollouts of the service apply to the node with nodeID, unless it's zero.
+func NewService(log *zap.Logger, config Config, info Info, nodeID storj.NodeID, service string) (client *Service) {
 	return &Service{
 		log:     log,
 		config:  config,
 		info:    info,
+		nodeID:  nodeID,
 		service: service,
 		Loop:    sync2.NewCycle(config.CheckInterval),
 		allowed: true,
@@ -63,7 +67,7 @@ func (srv *Service) CheckVersion(ctx context.Context) (err error) {
 // used for other utilities
 func CheckProcessVersion(ctx context.Context, log *zap.Logger, config Config, info Info, service string) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	return NewService(log, config, info, service).CheckVersion(ctx)
+	return NewService(log, config, info, storj.NodeID{}, service).CheckVersion(ctx)
 }
 
 // Run logs the current version information
@@ -118,6 +122,13 @@ func (srv *Service) checkVersion(ctx context.Context) (allowed bool) {
 		srv.log.Sugar().Errorf("no version from control server, accepting to run")
 		return true
 	}
+
+	rollout := getFieldRollout(&accepted.Rollouts, srv.service)
+	if !srv.nodeID.IsZero() && rollout.Contains(srv.nodeID) && rollout.Version.Compare(minimum) > 0 {
+		srv.log.Sugar().Debugf("node is inside the rollout of version %s", rollout.Version.String())
+		minimum = rollout.Version
+	}
+
 	if isAcceptedVersion(srv.info.Version, minimum) {
 		srv.log.Sugar().Infof("running on version %s", srv.info.Version.String())
 		return true
@@ -129,14 +140,21 @@ func (srv *Service) checkVersion(ctx context.Context) (allowed bool) {
 // QueryVersionFromControlServer handles the HTTP request to gather the allowed and latest version information
 func (srv *Service) queryVersionFromControlServer(ctx context.Context) (ver AllowedVersions, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return QueryAllowedVersions(ctx, srv.config.ServerAddress, srv.config.RequestTimeout)
+}
+
+// QueryAllowedVersions requests the allowed and latest version information
+// from the version control server at serverAddress
+func QueryAllowedVersions(ctx context.Context, serverAddress string, timeout time.Duration) (ver AllowedVersions, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	// Tune Client to have a custom Timeout (reduces hanging software)
 	client := http.Client{
-		Timeout: srv.config.RequestTimeout,
+		Timeout: timeout,
 	}
 
 	// New Request that used the passed in context
-	req, err := http.NewRequest("GET", srv.config.ServerAddress, nil)
+	req, err := http.NewRequest("GET", serverAddress, nil)
 	if err != nil {
 		return AllowedVersions{}, err
 	}
@@ -149,6 +167,10 @@ func (srv *Service) queryVersionFromControlServer(ctx context.Context) (ver Allo
 
 	defer func() { _ = resp.Body.Close() }()
 
+	if resp.StatusCode != http.StatusOK {
+		return AllowedVersions{}, verError.New("unexpected status from version control server: %s", resp.Status)
+	}
+
 	err = json.NewDecoder(resp.Body).Decode(&ver)
 	return ver, err
 }
@@ -58,6 +58,35 @@ type AllowedVersions struct {
 	Uplink      SemVer
 	Gateway     SemVer
 	Identity    SemVer
+
+	Rollouts  Rollouts  `json:"rollouts"`
+	Processes Processes `json:"processes"`
+}
+
+// Processes describes the releases of the binaries that are updated
+// automatically
+type Processes struct {
+	Storagenode Process `json:"storagenode"`
+}
+
+// Process describes the releases of a binary
+type Process struct {
+	// Suggested is the release binaries should be updated to
+	Suggested Release `json:"suggested"`
+}
+
+// Release is a release of a binary
+type Release struct {
+	Version string `json:"version"`
+	// URL is where the binary is downloaded from. The "{os}" and "{arch}"
+	// placeholders are replaced with the GOOS and GOARCH of the downloading
+	// machine. The detached signature of the binary is at URL + ".sig".
+	URL string `json:"url"`
+}
+
+// ReleaseURL returns the URL of the binary of the release for goos and goarch
+func (release Release) ReleaseURL(goos, goarch string) string {
+	return strings.NewReplacer("{os}", goos, "{arch}", goarch).Replace(release.URL)
 }
 
 // SemVerRegex is the regular expression used to parse a semantic version.
@@ -98,6 +127,19 @@ func (sem *SemVer) String() (version string) {
 	return fmt.Sprintf("v%d.%d.%d", sem.Major, sem.Minor, sem.Patch)
 }
 
+// Compare returns -1, 0 or 1 if the version is lower than, equal to or
+// higher than other
+func (sem SemVer) Compare(other SemVer) int {
+	switch {
+	case sem == other:
+		return 0
+	case isAcceptedVersion(sem, other):
+		return 1
+	default:
+		return -1
+	}
+}
+
 // New creates Version_Info from a json byte array
 func New(data []byte) (v Info, err error) {
 	err = json.Unmarshal(data, &v)
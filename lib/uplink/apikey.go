@@ -4,6 +4,7 @@
 package uplink
 
 import (
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/macaroon"
 )
 
@@ -51,3 +52,49 @@ func (a APIKey) Restrict(caveat macaroon.Caveat) (APIKey, error) {
 	}
 	return APIKey{key: k}, nil
 }
+
+// UploadRestrictions describe the limits of an API key handed out to an
+// untrusted device, such as one that should only be able to upload.
+type UploadRestrictions struct {
+	// AllowedIPRanges, when not empty, are the client address ranges in CIDR
+	// notation (or single addresses) all requests must come from.
+	AllowedIPRanges []string
+	// MaxObjectSize, when set, is the maximum encrypted size of an
+	// uploaded object.
+	MaxObjectSize memory.Size
+	// MaxSegments, when set, is the maximum number of segments of an
+	// uploaded object.
+	MaxSegments int64
+	// NoOverwrite allows uploads to create new objects only.
+	NoOverwrite bool
+}
+
+// Apply sets the restrictions on the caveat.
+func (restrictions UploadRestrictions) Apply(caveat *macaroon.Caveat) error {
+	for _, ipRange := range restrictions.AllowedIPRanges {
+		if _, err := macaroon.ParseIPRange(ipRange); err != nil {
+			return err
+		}
+	}
+	if restrictions.MaxObjectSize < 0 || restrictions.MaxSegments < 0 {
+		return Error.New("upload limits must not be negative")
+	}
+
+	caveat.AllowedIpRanges = append(caveat.AllowedIpRanges, restrictions.AllowedIPRanges...)
+	caveat.MaxObjectSize = restrictions.MaxObjectSize.Int64()
+	caveat.MaxSegments = restrictions.MaxSegments
+	caveat.DisallowOverwrites = restrictions.NoOverwrite
+	return nil
+}
+
+// RestrictUploads generates a new APIKey with the upload restrictions attached.
+func (a APIKey) RestrictUploads(restrictions UploadRestrictions) (APIKey, error) {
+	caveat, err := macaroon.NewCaveat()
+	if err != nil {
+		return APIKey{}, err
+	}
+	if err := restrictions.Apply(&caveat); err != nil {
+		return APIKey{}, err
+	}
+	return a.Restrict(caveat)
+}
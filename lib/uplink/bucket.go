@@ -6,6 +6,7 @@ package uplink
 import (
 	"context"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -50,6 +51,7 @@ func (b *Bucket) OpenObject(ctx context.Context, path storj.Path) (o *Object, er
 			Expires:     info.Expires,
 			Size:        info.Size,
 			Checksum:    info.Checksum,
+			MD5Checksum: info.MD5Checksum,
 			Volatile: struct {
 				EncryptionParameters storj.EncryptionParameters
 				RedundancyScheme     storj.RedundancyScheme
@@ -91,6 +93,12 @@ type UploadOptions struct {
 		// Error Correction encoding parameters to be used for this
 		// Object.
 		RedundancyScheme storj.RedundancyScheme
+
+		// Compression determines the compression applied to the Object's
+		// data before encryption. Compressed Objects are decompressed
+		// transparently on download, but can't be read by uplinks that
+		// don't support compression.
+		Compression storj.Compression
 	}
 }
 
@@ -164,6 +172,7 @@ func (b *Bucket) NewWriter(ctx context.Context, path storj.Path, opts *UploadOpt
 		Expires:              opts.Expires,
 		RedundancyScheme:     opts.Volatile.RedundancyScheme,
 		EncryptionParameters: opts.Volatile.EncryptionParameters,
+		Compression:          opts.Volatile.Compression,
 	}
 
 	obj, err := b.metainfo.CreateObject(ctx, b.Name, path, &createInfo)
@@ -187,7 +196,8 @@ func (b *Bucket) NewReader(ctx context.Context, path storj.Path) (_ io.ReadClose
 	return b.Download(ctx, path)
 }
 
-// Download creates a new reader that downloads the object data.
+// Download creates a new reader that downloads the object data. The data is
+// verified against the checksum stored with the object, see ErrIntegrity.
 func (b *Bucket) Download(ctx context.Context, path storj.Path) (_ io.ReadCloser, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -196,7 +206,8 @@ func (b *Bucket) Download(ctx context.Context, path storj.Path) (_ io.ReadCloser
 		return nil, err
 	}
 
-	return stream.NewDownload(ctx, segmentStream, b.streams), nil
+	download := stream.NewDownload(ctx, segmentStream, b.streams)
+	return verifyDownload(download, segmentStream.Info(), 0, -1), nil
 }
 
 // DownloadRange creates a new reader that downloads the object data starting from start and upto start + limit.
@@ -208,7 +219,38 @@ func (b *Bucket) DownloadRange(ctx context.Context, path storj.Path, start, limi
 		return nil, err
 	}
 
-	return stream.NewDownloadRange(ctx, segmentStream, b.streams, start, limit), nil
+	download := stream.NewDownloadRange(ctx, segmentStream, b.streams, start, limit)
+	return verifyDownload(download, segmentStream.Info(), start, limit), nil
+}
+
+// RekeyObject re-encrypts the object's path with the keys of access and
+// re-wraps the keys of its content with the content key derived from access.
+// The bucket must have been opened with the object's current encryption
+// access. The object's data stays on the storage nodes as it is.
+func (b *Bucket) RekeyObject(ctx context.Context, path storj.Path, access *EncryptionAccess) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if path == "" {
+		return storj.ErrNoPath.New("")
+	}
+
+	return b.streams.Rekey(ctx, storj.JoinPaths(b.Name, path), b.PathCipher, access.store)
+}
+
+// RotateEncryptionKey rekeys all objects under prefix for access, see
+// RekeyObject. It returns the number of rekeyed objects.
+//
+// If the rotation is interrupted, the bucket contains objects encrypted with
+// either key. Calling RotateEncryptionKey again with the same keys resumes
+// the rotation.
+func (b *Bucket) RotateEncryptionKey(ctx context.Context, prefix storj.Path, access *EncryptionAccess) (rotated int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	return b.streams.Rotate(ctx, storj.JoinPaths(b.Name, prefix), b.PathCipher, access.store)
 }
 
 // Close closes the Bucket session.
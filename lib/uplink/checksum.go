@@ -0,0 +1,48 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"hash"
+	"io"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// verifyDownload wraps download to verify the object's checksum when the
+// whole object is read. Ranges and objects without a checksum are returned
+// as they are.
+func verifyDownload(download io.ReadCloser, info storj.Object, offset, length int64) io.ReadCloser {
+	if len(info.Checksum) == 0 || offset != 0 || (length >= 0 && length < info.Size) {
+		return download
+	}
+	return &verifyingReader{
+		ReadCloser: download,
+		hash:       sha256.New(),
+		expected:   info.Checksum,
+	}
+}
+
+// verifyingReader hashes the data read and compares it to the expected
+// checksum at the end of the data
+type verifyingReader struct {
+	io.ReadCloser
+	hash     hash.Hash
+	expected []byte
+}
+
+// Read reads the data and returns an ErrIntegrity error instead of io.EOF
+// when the checksum doesn't match
+func (r *verifyingReader) Read(p []byte) (n int, err error) {
+	n, err = r.ReadCloser.Read(p)
+	_, _ = r.hash.Write(p[:n])
+	if err == io.EOF {
+		if actual := r.hash.Sum(nil); !bytes.Equal(actual, r.expected) {
+			return n, ErrIntegrity.New("checksum mismatch: expected %x, got %x", r.expected, actual)
+		}
+	}
+	return n, err
+}
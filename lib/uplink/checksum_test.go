@@ -0,0 +1,61 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestVerifyDownload(t *testing.T) {
+	data := testrand.BytesInt(1000)
+	checksum := sha256.Sum256(data)
+
+	info := storj.Object{
+		Stream: storj.Stream{
+			Size:     int64(len(data)),
+			Checksum: checksum[:],
+		},
+	}
+
+	for _, r := range []struct{ offset, length int64 }{
+		{0, -1},
+		{0, int64(len(data))},
+		{0, int64(len(data)) + 10},
+	} {
+		rc := verifyDownload(ioutil.NopCloser(bytes.NewReader(data)), info, r.offset, r.length)
+		got, err := ioutil.ReadAll(rc)
+		require.NoError(t, err)
+		assert.Equal(t, data, got)
+
+		corrupted := append([]byte{}, data...)
+		corrupted[500] ^= 1
+		rc = verifyDownload(ioutil.NopCloser(bytes.NewReader(corrupted)), info, r.offset, r.length)
+		_, err = ioutil.ReadAll(rc)
+		assert.True(t, ErrIntegrity.Has(err), "range %d+%d: %v", r.offset, r.length, err)
+	}
+
+	// ranges can't be verified
+	rc := verifyDownload(ioutil.NopCloser(bytes.NewReader(data[:10])), info, 0, 10)
+	_, err := ioutil.ReadAll(rc)
+	assert.NoError(t, err)
+
+	rc = verifyDownload(ioutil.NopCloser(bytes.NewReader(data[10:])), info, 10, -1)
+	_, err = ioutil.ReadAll(rc)
+	assert.NoError(t, err)
+
+	// objects uploaded without a checksum aren't verified
+	info.Checksum = nil
+	rc = verifyDownload(ioutil.NopCloser(bytes.NewReader(data[:10])), info, 0, -1)
+	_, err = ioutil.ReadAll(rc)
+	assert.NoError(t, err)
+}
@@ -13,4 +13,8 @@ var (
 
 	// Error is the toplevel class of errors for the uplink library.
 	Error = errs.Class("libuplink")
+
+	// ErrIntegrity is the class of errors returned when downloaded data
+	// doesn't match the checksum stored with the object.
+	ErrIntegrity = errs.Class("integrity")
 )
@@ -0,0 +1,145 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+func TestCompressedUpload(t *testing.T) {
+	access := uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+
+	testPlanetWithLibUplink(t, testConfig{},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			var bucketConfig uplink.BucketConfig
+			bucketConfig.EncryptionParameters = storj.EncryptionParameters{
+				CipherSuite: storj.EncAESGCM,
+				BlockSize:   4 * memory.KiB.Int32(),
+			}
+			bucketConfig.Volatile.RedundancyScheme = storj.RedundancyScheme{
+				Algorithm:      storj.ReedSolomon,
+				ShareSize:      1 * memory.KiB.Int32(),
+				RequiredShares: 2,
+				RepairShares:   3,
+				OptimalShares:  4,
+				TotalShares:    5,
+			}
+			// small segments, so that the compressed stream spans several of them
+			bucketConfig.Volatile.SegmentsSize = 256 * memory.KiB
+
+			_, err := proj.CreateBucket(ctx, "compressed", &bucketConfig)
+			require.NoError(t, err)
+
+			bucket, err := proj.OpenBucket(ctx, "compressed", access)
+			require.NoError(t, err)
+			defer ctx.Check(bucket.Close)
+
+			// random data doesn't compress, so the stream is still larger than a segment
+			var data []byte
+			data = append(data, testrand.Bytes(1*memory.MiB)...)
+			data = append(data, bytes.Repeat([]byte("compressible "), 200000)...)
+
+			opts := &uplink.UploadOptions{}
+			opts.Volatile.Compression = storj.CompressionGzip
+			require.NoError(t, bucket.UploadObject(ctx, "data.csv", bytes.NewReader(data), opts))
+
+			object, err := bucket.OpenObject(ctx, "data.csv")
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(data)), object.Meta.Size)
+
+			// checksums are computed before compression
+			sha256Sum, md5Sum := sha256.Sum256(data), md5.Sum(data)
+			assert.Equal(t, sha256Sum[:], object.Meta.Checksum)
+			assert.Equal(t, md5Sum[:], object.Meta.MD5Checksum)
+
+			list, err := bucket.ListObjects(ctx, &uplink.ListOptions{Direction: storj.After})
+			require.NoError(t, err)
+			require.Len(t, list.Items, 1)
+			assert.Equal(t, int64(len(data)), list.Items[0].Size)
+			assert.Equal(t, md5Sum[:], list.Items[0].MD5Checksum)
+
+			for _, r := range []struct{ offset, length int64 }{
+				{0, int64(len(data))},
+				{1, 100},
+				{256*memory.KiB.Int64() - 10, 20},
+				{memory.MiB.Int64() - 10, 20},
+				{2*memory.MiB.Int64() - 10, 300 * memory.KiB.Int64()},
+				{int64(len(data)) - 5, 5},
+			} {
+				rc, err := object.DownloadRange(ctx, r.offset, r.length)
+				require.NoError(t, err)
+				got, err := ioutil.ReadAll(rc)
+				require.NoError(t, err)
+				require.NoError(t, rc.Close())
+				assert.Equal(t, data[r.offset:r.offset+r.length], got, "range %d+%d", r.offset, r.length)
+			}
+
+			// uncompressed objects in the same bucket are unaffected
+			require.NoError(t, bucket.UploadObject(ctx, "plain", bytes.NewReader(data[:1000]), nil))
+			rc, err := bucket.Download(ctx, "plain")
+			require.NoError(t, err)
+			got, err := ioutil.ReadAll(rc)
+			require.NoError(t, err)
+			require.NoError(t, rc.Close())
+			assert.Equal(t, data[:1000], got)
+
+			// the cipher suite is stored as it is and the compression on its
+			// own, while the block sizes are kept with every segment
+			var ciphers []storj.CipherSuite
+			var compressions []storj.Compression
+			var segments int
+			err = planet.Satellites[0].Metainfo.Service.Iterate(ctx, "", "", true, false,
+				func(ctx context.Context, it storage.Iterator) error {
+					var item storage.ListItem
+					for it.Next(ctx, &item) {
+						pointer := &pb.Pointer{}
+						if err := proto.Unmarshal(item.Value, pointer); err != nil {
+							return err
+						}
+
+						if !strings.Contains(item.Key.String(), "/l/") {
+							// only the compressed object has more than one segment
+							segmentMeta := &pb.SegmentMeta{}
+							if err := proto.Unmarshal(pointer.Metadata, segmentMeta); err != nil {
+								return err
+							}
+							assert.NotEmpty(t, segmentMeta.EncryptedSegmentInfo)
+							segments++
+							continue
+						}
+
+						streamMeta := &pb.StreamMeta{}
+						if err := proto.Unmarshal(pointer.Metadata, streamMeta); err != nil {
+							return err
+						}
+						ciphers = append(ciphers, storj.CipherSuite(streamMeta.EncryptionType))
+						compressions = append(compressions, storj.Compression(streamMeta.CompressionType))
+					}
+					return nil
+				})
+			require.NoError(t, err)
+			assert.Equal(t, []storj.CipherSuite{storj.EncAESGCM, storj.EncAESGCM}, ciphers)
+			assert.ElementsMatch(t, []storj.Compression{storj.CompressionGzip, storj.CompressionNone}, compressions)
+			assert.True(t, segments > 1)
+		})
+}
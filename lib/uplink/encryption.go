@@ -4,10 +4,13 @@
 package uplink
 
 import (
+	"math"
+
 	"github.com/btcsuite/btcutil/base58"
 	"github.com/gogo/protobuf/proto"
 	"github.com/zeebo/errs"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/pkg/paths"
@@ -24,6 +27,7 @@ const (
 // encrypted and decrypted.
 type EncryptionAccess struct {
 	store *encryption.Store
+	kdf   *encryption.KDFParameters
 }
 
 // NewEncryptionAccess creates an encryption access context
@@ -53,12 +57,30 @@ func (s *EncryptionAccess) SetDefaultKey(defaultKey storj.Key) {
 	s.store.SetDefaultKey(&defaultKey)
 }
 
+// KDFParameters returns the parameters the default key was derived from a
+// passphrase with. Accesses that didn't record them return
+// encryption.DefaultKDFParameters, which every key was derived with before
+// the parameters were configurable.
+func (s *EncryptionAccess) KDFParameters() encryption.KDFParameters {
+	if s.kdf == nil {
+		return encryption.DefaultKDFParameters
+	}
+	return *s.kdf
+}
+
+// SetKDFParameters records the parameters the default key was derived from a
+// passphrase with, see (*Project).SaltedKeyFromPassphraseWithKDF.
+func (s *EncryptionAccess) SetKDFParameters(params encryption.KDFParameters) {
+	s.kdf = &params
+}
+
 // Import merges the other encryption access context into this one. In cases
 // of conflicting path decryption settings (including if both accesses have
 // a default key), the new settings are kept.
 func (s *EncryptionAccess) Import(other *EncryptionAccess) error {
 	if key := other.store.GetDefaultKey(); key != nil {
 		s.store.SetDefaultKey(key)
+		s.kdf = other.kdf
 	}
 	return other.store.Iterate(s.store.Add)
 }
@@ -145,13 +167,23 @@ func (s *EncryptionAccess) toProto() (*pb.EncryptionAccess, error) {
 	}
 
 	var defaultKey []byte
+	var defaultKeyKDF *pb.KDFParameters
 	if key := s.store.GetDefaultKey(); key != nil {
 		defaultKey = key[:]
+		if s.kdf != nil {
+			defaultKeyKDF = &pb.KDFParameters{
+				Version:     int32(s.kdf.Version),
+				Time:        s.kdf.Time,
+				Memory:      uint64(s.kdf.Memory),
+				Parallelism: uint32(s.kdf.Parallelism),
+			}
+		}
 	}
 
 	return &pb.EncryptionAccess{
-		DefaultKey:   defaultKey,
-		StoreEntries: storeEntries,
+		DefaultKey:    defaultKey,
+		StoreEntries:  storeEntries,
+		DefaultKeyKdf: defaultKeyKDF,
 	}, nil
 }
 
@@ -179,6 +211,22 @@ func parseEncryptionAccessFromProto(p *pb.EncryptionAccess) (*EncryptionAccess,
 		var defaultKey storj.Key
 		copy(defaultKey[:], p.DefaultKey)
 		access.SetDefaultKey(defaultKey)
+
+		if kdf := p.DefaultKeyKdf; kdf != nil {
+			if kdf.Parallelism > math.MaxUint8 {
+				return nil, errs.New("invalid key derivation parameters in encryption access")
+			}
+			params := encryption.KDFParameters{
+				Version:     encryption.KDFVersion(kdf.Version),
+				Time:        kdf.Time,
+				Memory:      memory.Size(kdf.Memory),
+				Parallelism: uint8(kdf.Parallelism),
+			}
+			if err := params.Validate(); err != nil {
+				return nil, errs.New("invalid key derivation parameters in encryption access: %v", err)
+			}
+			access.SetKDFParameters(params)
+		}
 	}
 
 	for _, entry := range p.StoreEntries {
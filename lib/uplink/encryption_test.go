@@ -0,0 +1,37 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestEncryptionAccessKDFParameters(t *testing.T) {
+	access := uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+
+	// accesses which didn't record their parameters use the defaults
+	serialized, err := access.Serialize()
+	require.NoError(t, err)
+	parsed, err := uplink.ParseEncryptionAccess(serialized)
+	require.NoError(t, err)
+	assert.Equal(t, encryption.DefaultKDFParameters, parsed.KDFParameters())
+
+	mobile, err := encryption.KDFProfile("mobile")
+	require.NoError(t, err)
+	access.SetKDFParameters(mobile)
+
+	serialized, err = access.Serialize()
+	require.NoError(t, err)
+	parsed, err = uplink.ParseEncryptionAccess(serialized)
+	require.NoError(t, err)
+	assert.Equal(t, mobile, parsed.KDFParameters())
+	assert.Equal(t, access.Store().GetDefaultKey(), parsed.Store().GetDefaultKey())
+}
@@ -44,8 +44,13 @@ type ObjectMeta struct {
 
 	// Size gives the size of the Object in bytes.
 	Size int64
-	// Checksum gives a checksum of the contents of the Object.
+	// Checksum gives the SHA-256 checksum of the contents of the Object,
+	// as computed during upload. It is empty for Objects uploaded by older
+	// clients.
 	Checksum []byte
+	// MD5Checksum gives the MD5 checksum of the contents of the Object,
+	// for compatibility with S3 ETags.
+	MD5Checksum []byte
 
 	// Volatile groups config values that are likely to change semantics
 	// or go away entirely between releases. Be careful when using them!
@@ -78,6 +83,9 @@ type Object struct {
 }
 
 // DownloadRange returns an Object's data. A length of -1 will mean (Object.Size - offset).
+//
+// When the whole Object is downloaded, its data is verified against the
+// checksum stored with it and reading fails with ErrIntegrity on mismatch.
 func (o *Object) DownloadRange(ctx context.Context, offset, length int64) (_ io.ReadCloser, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -86,7 +94,8 @@ func (o *Object) DownloadRange(ctx context.Context, offset, length int64) (_ io.
 		return nil, err
 	}
 
-	return stream.NewDownloadRange(ctx, segmentStream, o.streams, offset, length), nil
+	download := stream.NewDownloadRange(ctx, segmentStream, o.streams, offset, length)
+	return verifyDownload(download, segmentStream.Info(), offset, length), nil
 }
 
 // Close closes the Object.
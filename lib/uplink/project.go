@@ -214,6 +214,14 @@ func (p *Project) OpenBucket(ctx context.Context, bucketName string, access *Enc
 	}, nil
 }
 
+// RevokeAPIKey revokes the given API key and every key derived from it. The
+// project must have been opened with the revoked key itself or one of its
+// ancestors.
+func (p *Project) RevokeAPIKey(ctx context.Context, key APIKey) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return p.metainfo.RevokeAPIKey(ctx, key.serializeRaw())
+}
+
 func (p *Project) retrieveSalt(ctx context.Context) (salt []byte, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -226,12 +234,20 @@ func (p *Project) retrieveSalt(ctx context.Context) (salt []byte, err error) {
 
 // SaltedKeyFromPassphrase returns a key generated from the given passphrase using a stable, project-specific salt
 func (p *Project) SaltedKeyFromPassphrase(ctx context.Context, passphrase string) (_ *storj.Key, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return p.SaltedKeyFromPassphraseWithKDF(ctx, passphrase, encryption.DefaultKDFParameters)
+}
+
+// SaltedKeyFromPassphraseWithKDF returns a key generated from the given passphrase using a stable,
+// project-specific salt and the given key derivation parameters. The parameters should be recorded
+// with (*EncryptionAccess).SetKDFParameters, as the same key can only be generated with them.
+func (p *Project) SaltedKeyFromPassphraseWithKDF(ctx context.Context, passphrase string, params encryption.KDFParameters) (_ *storj.Key, err error) {
 	defer mon.Task()(&ctx)(&err)
 	salt, err := p.retrieveSalt(ctx)
 	if err != nil {
 		return nil, err
 	}
-	key, err := encryption.DeriveRootKey([]byte(passphrase), salt, "")
+	key, err := encryption.DeriveRootKeyWithParameters([]byte(passphrase), salt, "", params)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,93 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uplink_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestRotateEncryptionKey(t *testing.T) {
+	oldAccess := uplink.NewEncryptionAccessWithDefaultKey(storj.Key{0, 1, 2, 3, 4})
+	newAccess := uplink.NewEncryptionAccessWithDefaultKey(storj.Key{5, 6, 7, 8, 9})
+
+	testPlanetWithLibUplink(t, testConfig{},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, proj *uplink.Project) {
+			var bucketConfig uplink.BucketConfig
+			bucketConfig.EncryptionParameters = storj.EncryptionParameters{
+				CipherSuite: storj.EncAESGCM,
+				BlockSize:   4 * memory.KiB.Int32(),
+			}
+			bucketConfig.Volatile.RedundancyScheme = storj.RedundancyScheme{
+				Algorithm:      storj.ReedSolomon,
+				ShareSize:      1 * memory.KiB.Int32(),
+				RequiredShares: 2,
+				RepairShares:   3,
+				OptimalShares:  4,
+				TotalShares:    5,
+			}
+			bucketConfig.Volatile.SegmentsSize = 64 * memory.KiB
+
+			_, err := proj.CreateBucket(ctx, "rotated", &bucketConfig)
+			require.NoError(t, err)
+
+			objects := map[string][]byte{
+				"multi-segment": testrand.Bytes(150 * memory.KiB),
+				"inline":        testrand.Bytes(100),
+				"a/b/nested":    testrand.Bytes(10 * memory.KiB),
+				"a/c":           testrand.Bytes(1),
+			}
+
+			oldBucket, err := proj.OpenBucket(ctx, "rotated", oldAccess)
+			require.NoError(t, err)
+			defer ctx.Check(oldBucket.Close)
+
+			for path, data := range objects {
+				require.NoError(t, oldBucket.UploadObject(ctx, path, bytes.NewReader(data), nil))
+			}
+
+			// simulate an interrupted rotation
+			require.NoError(t, oldBucket.RekeyObject(ctx, "a/b/nested", newAccess))
+
+			rotated, err := oldBucket.RotateEncryptionKey(ctx, "", newAccess)
+			require.NoError(t, err)
+			assert.Equal(t, len(objects)-1, rotated)
+
+			newBucket, err := proj.OpenBucket(ctx, "rotated", newAccess)
+			require.NoError(t, err)
+			defer ctx.Check(newBucket.Close)
+
+			list, err := newBucket.ListObjects(ctx, &storj.ListOptions{Direction: storj.After, Recursive: true})
+			require.NoError(t, err)
+			assert.Len(t, list.Items, len(objects))
+
+			for path, data := range objects {
+				rc, err := newBucket.Download(ctx, path)
+				require.NoError(t, err, path)
+				got, err := ioutil.ReadAll(rc)
+				require.NoError(t, err, path)
+				require.NoError(t, rc.Close())
+				assert.Equal(t, data, got, path)
+
+				_, err = oldBucket.OpenObject(ctx, path)
+				assert.True(t, storj.ErrObjectNotFound.Has(err), path)
+			}
+
+			// nothing is left to rotate
+			rotated, err = oldBucket.RotateEncryptionKey(ctx, "", newAccess)
+			require.NoError(t, err)
+			assert.Equal(t, 0, rotated)
+		})
+}
@@ -5,10 +5,11 @@
 #include <stdlib.h>
 
 typedef enum CipherSuite {
-    STORJ_ENC_UNSPECIFIED = 0,
-    STORJ_ENC_NULL        = 1,
-    STORJ_ENC_AESGCM      = 2,
-    STORJ_ENC_SECRET_BOX  = 3
+    STORJ_ENC_UNSPECIFIED      = 0,
+    STORJ_ENC_NULL             = 1,
+    STORJ_ENC_AESGCM           = 2,
+    STORJ_ENC_SECRET_BOX       = 3,
+    STORJ_ENC_CHACHA20POLY1305 = 4
 } CipherSuite;
 
 typedef enum RedundancyAlgorithm {
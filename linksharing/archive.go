@@ -0,0 +1,62 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package linksharing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/archive"
+)
+
+// serveArchive streams all objects under the prefix as an archive
+func (handler *Handler) serveArchive(ctx context.Context, w http.ResponseWriter, r *http.Request, base *url.URL, b *cachedBucket, prefix string, locationOnly bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	format, err := archive.ParseFormat(r.URL.Query().Get("download"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return err
+	}
+
+	entries, err := archive.ListEntries(ctx, b.Bucket, prefix)
+	if err != nil {
+		handler.handleUplinkErr(w, "list objects", err)
+		return err
+	}
+	if len(entries) == 0 {
+		err = errs.New("prefix not found")
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return err
+	}
+
+	if locationOnly {
+		location := makeLocation(base, r.URL.Path) + "/?" + r.URL.RawQuery
+		http.Redirect(w, r, location, http.StatusFound)
+		return nil
+	}
+
+	name := path.Base(strings.TrimSuffix(prefix, "/"))
+	if prefix == "" {
+		name = b.Name
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name+format.Extension()))
+
+	// headers have been sent once the archive is written, so errors can only
+	// be logged and reported by closing the connection early
+	err = archive.Write(ctx, w, format, entries, handler.archiveConcurrency)
+	if err != nil {
+		handler.log.Error("unable to write archive", zap.String("prefix", prefix), zap.Error(err))
+	}
+	return err
+}
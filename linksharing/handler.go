@@ -7,10 +7,14 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"mime"
+	"net"
 	"net/http"
 	"net/url"
 	"path"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -25,6 +29,9 @@ var (
 	mon = monkit.Package()
 )
 
+// indexPage is the object served for prefixes in website mode
+const indexPage = "index.html"
+
 // HandlerConfig specifies the handler configuration
 type HandlerConfig struct {
 	// Uplink is the uplink used to talk to the storage network
@@ -33,13 +40,49 @@ type HandlerConfig struct {
 	// URLBase is the base URL of the link sharing handler. It is used
 	// to construct URLs returned to clients. It should be a fully formed URL.
 	URLBase string
+
+	// Website enables serving static websites, where requests for prefixes
+	// are served the index.html object under the prefix instead of a listing.
+	Website bool
+
+	// NotFoundPage is the path of the object within the bucket that is
+	// served when an object is not found in website mode.
+	NotFoundPage string
+
+	// Resolver, if set, maps custom domains to the bucket and prefix they
+	// are served from. Requests for hosts other than the one of URLBase are
+	// served from the mapped prefix, unless the host is not mapped.
+	Resolver HostResolver
+
+	// ProjectCacheSize is the number of unused projects kept open. Zero
+	// disables caching and opens a project for every request.
+	ProjectCacheSize int
+
+	// ProjectIdleTimeout is how long unused projects are kept open. Zero
+	// keeps them open until they are evicted by newer projects.
+	ProjectIdleTimeout time.Duration
+
+	// ObjectCacheTTL is how long opened objects are cached, so that repeated
+	// requests for the same object skip the metainfo round trips. Zero
+	// disables caching.
+	ObjectCacheTTL time.Duration
+
+	// ArchiveConcurrency is the number of objects downloaded concurrently
+	// when serving a prefix as a ZIP or TAR archive.
+	ArchiveConcurrency int
 }
 
 // Handler implements the link sharing HTTP handler
 type Handler struct {
-	log     *zap.Logger
-	uplink  *uplink.Uplink
-	urlBase *url.URL
+	log          *zap.Logger
+	uplink       *uplink.Uplink
+	urlBase      *url.URL
+	website      bool
+	notFoundPage string
+	resolver     HostResolver
+	projects     *projectCache
+
+	archiveConcurrency int
 }
 
 // NewHandler creates a new link sharing HTTP handler
@@ -54,12 +97,27 @@ func NewHandler(log *zap.Logger, config HandlerConfig) (*Handler, error) {
 	}
 
 	return &Handler{
-		log:     log,
-		uplink:  config.Uplink,
-		urlBase: urlBase,
+		log:          log,
+		uplink:       config.Uplink,
+		urlBase:      urlBase,
+		website:      config.Website,
+		notFoundPage: config.NotFoundPage,
+		resolver:     config.Resolver,
+		projects: newProjectCache(log, config.Uplink, projectCacheConfig{
+			Capacity:    config.ProjectCacheSize,
+			IdleTimeout: config.ProjectIdleTimeout,
+			ObjectTTL:   config.ObjectCacheTTL,
+		}),
+
+		archiveConcurrency: config.ArchiveConcurrency,
 	}, nil
 }
 
+// Close closes the cached projects
+func (handler *Handler) Close() error {
+	return handler.projects.Close()
+}
+
 // ServeHTTP handles link sharing requests
 func (handler *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// serveHTTP handles the request in full. the error that is returned can
@@ -83,20 +141,36 @@ func (handler *Handler) serveHTTP(w http.ResponseWriter, r *http.Request) (err e
 		return err
 	}
 
-	scope, bucket, unencPath, err := parseRequestPath(r.URL.Path)
+	mapping, err := handler.resolveHost(ctx, r.Host)
 	if err != nil {
-		err = fmt.Errorf("invalid request: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		handler.log.Error("unable to resolve host", zap.String("host", r.Host), zap.Error(err))
+		http.Error(w, "unable to resolve host", http.StatusBadGateway)
 		return err
 	}
 
-	p, err := handler.uplink.OpenProject(ctx, scope.SatelliteAddr, scope.APIKey)
+	var scope *uplink.Scope
+	var bucket, unencPath string
+	base := handler.urlBase
+	if mapping != nil {
+		scope, bucket = mapping.Scope, mapping.Bucket
+		unencPath = mapping.Prefix + strings.TrimPrefix(r.URL.Path, "/")
+		base = hostURL(r)
+	} else {
+		scope, bucket, unencPath, err = parseRequestPath(r.URL.Path)
+		if err != nil {
+			err = fmt.Errorf("invalid request: %v", err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return err
+		}
+	}
+
+	p, release, err := handler.projects.Open(ctx, scope)
 	if err != nil {
 		handler.handleUplinkErr(w, "open project", err)
 		return err
 	}
 	defer func() {
-		if err := p.Close(); err != nil {
+		if err := release(); err != nil {
 			handler.log.With(zap.Error(err)).Warn("unable to close project")
 		}
 	}()
@@ -106,33 +180,165 @@ func (handler *Handler) serveHTTP(w http.ResponseWriter, r *http.Request) (err e
 		handler.handleUplinkErr(w, "open bucket", err)
 		return err
 	}
-	defer func() {
-		if err := b.Close(); err != nil {
-			handler.log.With(zap.Error(err)).Warn("unable to close bucket")
-		}
-	}()
+
+	if unencPath == "" || strings.HasSuffix(unencPath, "/") {
+		return handler.servePrefix(ctx, w, r, base, b, unencPath, locationOnly)
+	}
 
 	o, err := b.OpenObject(ctx, unencPath)
 	if err != nil {
+		if !storj.ErrObjectNotFound.Has(err) {
+			handler.handleUplinkErr(w, "open object", err)
+			return err
+		}
+
+		// the path may refer to a prefix without the trailing slash
+		list, listErr := b.ListObjects(ctx, &uplink.ListOptions{
+			Prefix:    unencPath + "/",
+			Direction: storj.After,
+			Limit:     1,
+		})
+		if listErr == nil && len(list.Items) > 0 {
+			location := makeLocation(base, r.URL.Path) + "/"
+			http.Redirect(w, r, location, http.StatusMovedPermanently)
+			return nil
+		}
+
+		if handler.website {
+			return handler.serveNotFound(ctx, w, b)
+		}
 		handler.handleUplinkErr(w, "open object", err)
 		return err
 	}
-	defer func() {
-		if err := o.Close(); err != nil {
-			handler.log.With(zap.Error(err)).Warn("unable to close object")
-		}
-	}()
 
 	if locationOnly {
-		location := makeLocation(handler.urlBase, r.URL.Path)
+		location := makeLocation(base, r.URL.Path)
 		http.Redirect(w, r, location, http.StatusFound)
 		return nil
 	}
 
+	w.Header().Set("Content-Type", contentType(o.Meta.ContentType, unencPath))
 	ranger.ServeContent(ctx, w, r, unencPath, o.Meta.Modified, newObjectRanger(o))
 	return nil
 }
 
+// servePrefix serves the prefix as an archive when requested with ?download,
+// the index page of the prefix in website mode and the listing of the prefix
+// otherwise
+func (handler *Handler) servePrefix(ctx context.Context, w http.ResponseWriter, r *http.Request, base *url.URL, b *cachedBucket, prefix string, locationOnly bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if r.URL.Query().Get("download") != "" {
+		return handler.serveArchive(ctx, w, r, base, b, prefix, locationOnly)
+	}
+
+	if handler.website {
+		indexPath := prefix + indexPage
+		o, err := b.OpenObject(ctx, indexPath)
+		if err != nil {
+			if storj.ErrObjectNotFound.Has(err) {
+				return handler.serveNotFound(ctx, w, b)
+			}
+			handler.handleUplinkErr(w, "open object", err)
+			return err
+		}
+
+		if locationOnly {
+			location := makeLocation(base, r.URL.Path) + "/"
+			http.Redirect(w, r, location, http.StatusFound)
+			return nil
+		}
+
+		w.Header().Set("Content-Type", contentType(o.Meta.ContentType, indexPath))
+		ranger.ServeContent(ctx, w, r, indexPath, o.Meta.Modified, newObjectRanger(o))
+		return nil
+	}
+
+	items, err := listPrefix(ctx, b.Bucket, prefix)
+	if err != nil {
+		handler.handleUplinkErr(w, "list objects", err)
+		return err
+	}
+	if len(items) == 0 && prefix != "" {
+		err = errs.New("prefix not found")
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return err
+	}
+
+	if locationOnly {
+		location := makeLocation(base, r.URL.Path) + "/"
+		http.Redirect(w, r, location, http.StatusFound)
+		return nil
+	}
+
+	listing := &prefixListing{
+		Bucket: b.Name,
+		Prefix: prefix,
+		Items:  items,
+	}
+	if wantsJSON(r) {
+		return listing.writeJSON(w)
+	}
+	return listing.writeHTML(w)
+}
+
+// serveNotFound serves the configured not found page with a 404 status code
+func (handler *Handler) serveNotFound(ctx context.Context, w http.ResponseWriter, b *cachedBucket) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	notFound := errs.New("object not found")
+	if handler.notFoundPage == "" {
+		http.Error(w, notFound.Error(), http.StatusNotFound)
+		return notFound
+	}
+
+	o, err := b.OpenObject(ctx, handler.notFoundPage)
+	if err != nil {
+		if !storj.ErrObjectNotFound.Has(err) {
+			handler.log.Warn("unable to open not found page", zap.Error(err))
+		}
+		http.Error(w, notFound.Error(), http.StatusNotFound)
+		return notFound
+	}
+
+	rc, err := o.DownloadRange(ctx, 0, o.Meta.Size)
+	if err != nil {
+		handler.handleUplinkErr(w, "download not found page", err)
+		return err
+	}
+	defer func() { err = errs.Combine(err, rc.Close()) }()
+
+	w.Header().Set("Content-Type", contentType(o.Meta.ContentType, handler.notFoundPage))
+	w.Header().Set("Content-Length", strconv.FormatInt(o.Meta.Size, 10))
+	w.WriteHeader(http.StatusNotFound)
+	_, err = io.Copy(w, rc)
+	return errs.Combine(notFound, err)
+}
+
+// resolveHost returns the mapping of a custom domain, or nil if the request
+// should be served by scope and bucket in the request path
+func (handler *Handler) resolveHost(ctx context.Context, hostport string) (_ *HostMapping, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if handler.resolver == nil || hostport == "" {
+		return nil, nil
+	}
+
+	host := hostport
+	if h, _, err := net.SplitHostPort(hostport); err == nil {
+		host = h
+	}
+	if strings.EqualFold(host, handler.urlBase.Hostname()) {
+		return nil, nil
+	}
+
+	mapping, err := handler.resolver.Resolve(ctx, host)
+	if ErrHostNotFound.Has(err) {
+		return nil, nil
+	}
+	return mapping, err
+}
+
 func (handler *Handler) handleUplinkErr(w http.ResponseWriter, action string, err error) {
 	switch {
 	case storj.ErrBucketNotFound.Has(err):
@@ -216,3 +422,24 @@ func makeLocation(base *url.URL, reqPath string) string {
 	location.Path = path.Join(location.Path, reqPath)
 	return location.String()
 }
+
+// hostURL returns the base URL of a request for a custom domain
+func hostURL(r *http.Request) *url.URL {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return &url.URL{Scheme: scheme, Host: r.Host}
+}
+
+// contentType returns the content type stored with the object, falling back
+// to the one matching the extension of the path
+func contentType(stored string, unencPath string) string {
+	if stored != "" {
+		return stored
+	}
+	if byExt := mime.TypeByExtension(path.Ext(unencPath)); byExt != "" {
+		return byExt
+	}
+	return "application/octet-stream"
+}
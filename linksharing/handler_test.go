@@ -4,7 +4,13 @@
 package linksharing
 
 import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
 	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"path"
@@ -115,22 +121,20 @@ func TestHandlerRequests(t *testing.T) {
 		SatelliteCount:   2,
 		StorageNodeCount: 1,
 		UplinkCount:      1,
-	}, testHandlerRequests)
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		testHandlerRequests(t, ctx, planet)
+		testHandlerListings(t, ctx, planet)
+		testHandlerWebsite(t, ctx, planet)
+		testHandlerCustomDomains(t, ctx, planet)
+		testHandlerArchives(t, ctx, planet)
+	})
 }
 
 func testHandlerRequests(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
 	err := planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", "test/foo", []byte("FOO"))
 	require.NoError(t, err)
 
-	apiKey, err := uplink.ParseAPIKey(planet.Uplinks[0].APIKey[planet.Satellites[0].ID()])
-	require.NoError(t, err)
-
-	scope, err := (&uplink.Scope{
-		SatelliteAddr:    planet.Satellites[0].Addr(),
-		APIKey:           apiKey,
-		EncryptionAccess: uplink.NewEncryptionAccessWithDefaultKey(storj.Key{}),
-	}).Serialize()
-	require.NoError(t, err)
+	scope := serializedScope(t, planet)
 
 	testCases := []struct {
 		name   string
@@ -274,6 +278,268 @@ func testHandlerRequests(t *testing.T, ctx *testcontext.Context, planet *testpla
 	}
 }
 
+func testHandlerListings(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+	err := planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", "test/sub/baz.txt", []byte("BAZ"))
+	require.NoError(t, err)
+
+	scope := serializedScope(t, planet)
+
+	uplink := newUplink(ctx, t)
+	defer ctx.Check(uplink.Close)
+
+	handler, err := NewHandler(zaptest.NewLogger(t), HandlerConfig{
+		Uplink:  uplink,
+		URLBase: "http://localhost",
+	})
+	require.NoError(t, err)
+
+	t.Run("GET prefix listing", func(t *testing.T) {
+		w := serve(t, handler, "GET", path.Join(scope, "testbucket", "test")+"/", nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Body.String(), `<a href="foo">foo</a>`)
+		assert.Contains(t, w.Body.String(), `<a href="sub/">sub/</a>`)
+		assert.Contains(t, w.Body.String(), `<a href="../">../</a>`)
+	})
+
+	t.Run("GET bucket listing", func(t *testing.T) {
+		w := serve(t, handler, "GET", path.Join(scope, "testbucket")+"/", nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `<a href="test/">test/</a>`)
+		assert.NotContains(t, w.Body.String(), `<a href="../">`)
+	})
+
+	t.Run("GET JSON prefix listing", func(t *testing.T) {
+		w := serve(t, handler, "GET", path.Join(scope, "testbucket", "test", "sub")+"/", http.Header{
+			"Accept": []string{"application/json"},
+		})
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+		var listing prefixListing
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &listing))
+		assert.Equal(t, "testbucket", listing.Bucket)
+		assert.Equal(t, "test/sub/", listing.Prefix)
+		require.Len(t, listing.Items, 1)
+		assert.Equal(t, "baz.txt", listing.Items[0].Name)
+		assert.False(t, listing.Items[0].IsPrefix)
+		assert.Equal(t, int64(3), listing.Items[0].Size)
+	})
+
+	t.Run("GET prefix not found", func(t *testing.T) {
+		w := serve(t, handler, "GET", path.Join(scope, "testbucket", "missing")+"/", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "prefix not found\n", w.Body.String())
+	})
+
+	t.Run("GET prefix without trailing slash", func(t *testing.T) {
+		w := serve(t, handler, "GET", path.Join(scope, "testbucket", "test", "sub"), nil)
+		assert.Equal(t, http.StatusMovedPermanently, w.Code)
+		assert.Equal(t, "http://localhost/"+path.Join(scope, "testbucket", "test", "sub")+"/", w.Header().Get("Location"))
+	})
+
+	t.Run("GET content type from extension", func(t *testing.T) {
+		w := serve(t, handler, "GET", path.Join(scope, "testbucket", "test", "sub", "baz.txt"), nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "BAZ", w.Body.String())
+	})
+}
+
+func testHandlerWebsite(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+	for p, data := range map[string]string{
+		"site/index.html":      "<h1>index</h1>",
+		"site/docs/index.html": "<h1>docs</h1>",
+		"site/404.html":        "<h1>not found</h1>",
+	} {
+		err := planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "website", p, []byte(data))
+		require.NoError(t, err)
+	}
+
+	scope := serializedScope(t, planet)
+
+	uplink := newUplink(ctx, t)
+	defer ctx.Check(uplink.Close)
+
+	handler, err := NewHandler(zaptest.NewLogger(t), HandlerConfig{
+		Uplink:       uplink,
+		URLBase:      "http://localhost",
+		Website:      true,
+		NotFoundPage: "site/404.html",
+	})
+	require.NoError(t, err)
+
+	t.Run("GET index page", func(t *testing.T) {
+		w := serve(t, handler, "GET", path.Join(scope, "website", "site")+"/", nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "<h1>index</h1>", w.Body.String())
+	})
+
+	t.Run("GET nested index page", func(t *testing.T) {
+		w := serve(t, handler, "GET", path.Join(scope, "website", "site", "docs")+"/", nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "<h1>docs</h1>", w.Body.String())
+	})
+
+	t.Run("GET not found page", func(t *testing.T) {
+		w := serve(t, handler, "GET", path.Join(scope, "website", "site", "missing.html"), nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "<h1>not found</h1>", w.Body.String())
+	})
+
+	t.Run("GET prefix without index page", func(t *testing.T) {
+		w := serve(t, handler, "GET", path.Join(scope, "website")+"/", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "<h1>not found</h1>", w.Body.String())
+	})
+}
+
+func testHandlerCustomDomains(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+	scope, err := uplink.ParseScope(serializedScope(t, planet))
+	require.NoError(t, err)
+
+	uplink := newUplink(ctx, t)
+	defer ctx.Check(uplink.Close)
+
+	handler, err := NewHandler(zaptest.NewLogger(t), HandlerConfig{
+		Uplink:  uplink,
+		URLBase: "http://localhost",
+		Website: true,
+		Resolver: NewStaticResolver(map[string]*HostMapping{
+			"docs.example.com": {Scope: scope, Bucket: "website", Prefix: "site/docs/"},
+			"www.example.com":  {Scope: scope, Bucket: "website", Prefix: "site/"},
+		}),
+		ProjectCacheSize: 1,
+	})
+	require.NoError(t, err)
+	defer ctx.Check(handler.Close)
+
+	serveHost := func(host, urlPath string) *httptest.ResponseRecorder {
+		r, err := http.NewRequest("GET", "http://"+host+urlPath, nil)
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	w := serveHost("docs.example.com", "/")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "<h1>docs</h1>", w.Body.String())
+
+	w = serveHost("www.example.com:8080", "/docs/index.html")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "<h1>docs</h1>", w.Body.String())
+
+	w = serveHost("www.example.com", "/docs")
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+	assert.Equal(t, "http://www.example.com/docs/", w.Header().Get("Location"))
+
+	// hosts without a mapping are served by scope in the path
+	w = serveHost("localhost", "/"+path.Join(serializedScope(t, planet), "website", "site")+"/")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "<h1>index</h1>", w.Body.String())
+
+	w = serveHost("unknown.example.com", "/")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func testHandlerArchives(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+	scope := serializedScope(t, planet)
+
+	uplink := newUplink(ctx, t)
+	defer ctx.Check(uplink.Close)
+
+	handler, err := NewHandler(zaptest.NewLogger(t), HandlerConfig{
+		Uplink:             uplink,
+		URLBase:            "http://localhost",
+		ArchiveConcurrency: 2,
+	})
+	require.NoError(t, err)
+
+	expected := map[string]string{
+		"foo":         "FOO",
+		"sub/baz.txt": "BAZ",
+	}
+
+	t.Run("GET zip archive", func(t *testing.T) {
+		w := serve(t, handler, "GET", path.Join(scope, "testbucket", "test")+"/?download=zip", nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="test.zip"`, w.Header().Get("Content-Disposition"))
+
+		zr, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+		require.NoError(t, err)
+
+		files := map[string]string{}
+		for _, file := range zr.File {
+			rc, err := file.Open()
+			require.NoError(t, err)
+			data, err := ioutil.ReadAll(rc)
+			require.NoError(t, err)
+			require.NoError(t, rc.Close())
+			files[file.Name] = string(data)
+		}
+		assert.Equal(t, expected, files)
+	})
+
+	t.Run("GET tar archive", func(t *testing.T) {
+		w := serve(t, handler, "GET", path.Join(scope, "testbucket", "test")+"/?download=tar", nil)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/x-tar", w.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="test.tar"`, w.Header().Get("Content-Disposition"))
+
+		tr := tar.NewReader(w.Body)
+		files := map[string]string{}
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			data, err := ioutil.ReadAll(tr)
+			require.NoError(t, err)
+			files[header.Name] = string(data)
+		}
+		assert.Equal(t, expected, files)
+	})
+
+	t.Run("GET unsupported archive format", func(t *testing.T) {
+		w := serve(t, handler, "GET", path.Join(scope, "testbucket", "test")+"/?download=rar", nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("GET archive of missing prefix", func(t *testing.T) {
+		w := serve(t, handler, "GET", path.Join(scope, "testbucket", "missing")+"/?download=zip", nil)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func serializedScope(t *testing.T, planet *testplanet.Planet) string {
+	apiKey, err := uplink.ParseAPIKey(planet.Uplinks[0].APIKey[planet.Satellites[0].ID()])
+	require.NoError(t, err)
+
+	scope, err := (&uplink.Scope{
+		SatelliteAddr:    planet.Satellites[0].Addr(),
+		APIKey:           apiKey,
+		EncryptionAccess: uplink.NewEncryptionAccessWithDefaultKey(storj.Key{}),
+	}).Serialize()
+	require.NoError(t, err)
+	return scope
+}
+
+func serve(t *testing.T, handler *Handler, method, urlPath string, header http.Header) *httptest.ResponseRecorder {
+	r, err := http.NewRequest(method, "http://localhost/"+urlPath, nil)
+	require.NoError(t, err)
+	for h, v := range header {
+		r.Header[h] = v
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
 func newUplink(ctx context.Context, tb testing.TB) *uplink.Uplink {
 	cfg := new(uplink.Config)
 	cfg.Volatile.Log = zaptest.NewLogger(tb)
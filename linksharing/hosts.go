@@ -0,0 +1,254 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package linksharing
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/lib/uplink"
+)
+
+// ErrHostNotFound is returned when a host is not mapped to a scope
+var ErrHostNotFound = errs.Class("host not found")
+
+// HostMapping is the bucket and prefix a custom domain is served from
+type HostMapping struct {
+	// Scope is the scope used to access the bucket
+	Scope *uplink.Scope
+	// Bucket is the name of the bucket
+	Bucket string
+	// Prefix is prepended to request paths, it is either empty or ends with a slash
+	Prefix string
+}
+
+// HostResolver maps custom domains to the bucket and prefix they are served from
+type HostResolver interface {
+	// Resolve returns the mapping of the host, or ErrHostNotFound if there is none
+	Resolve(ctx context.Context, host string) (*HostMapping, error)
+}
+
+// parseRoot parses a root of the form bucket[/prefix] into a mapping
+func parseRoot(scope *uplink.Scope, root string) (*HostMapping, error) {
+	root = strings.TrimPrefix(root, "/")
+	segments := strings.SplitN(root, "/", 2)
+	if segments[0] == "" {
+		return nil, errs.New("missing bucket in root %q", root)
+	}
+
+	mapping := &HostMapping{
+		Scope:  scope,
+		Bucket: segments[0],
+	}
+	if len(segments) == 2 && segments[1] != "" {
+		mapping.Prefix = strings.TrimSuffix(segments[1], "/") + "/"
+	}
+	return mapping, nil
+}
+
+// StaticResolver resolves hosts from a fixed set of mappings, which is
+// useful for tests and setups without access to DNS
+type StaticResolver struct {
+	mappings map[string]*HostMapping
+}
+
+// NewStaticResolver creates a resolver for the given mappings keyed by host
+func NewStaticResolver(mappings map[string]*HostMapping) *StaticResolver {
+	normalized := make(map[string]*HostMapping, len(mappings))
+	for host, mapping := range mappings {
+		normalized[strings.ToLower(host)] = mapping
+	}
+	return &StaticResolver{mappings: normalized}
+}
+
+// LoadStaticResolver loads the mappings from a JSON file, which maps hosts to
+// objects with a "scope" field holding the serialized scope and a "root" field
+// of the form bucket[/prefix].
+func LoadStaticResolver(path string) (*StaticResolver, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	var entries map[string]struct {
+		Scope string `json:"scope"`
+		Root  string `json:"root"`
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, errs.New("invalid hosts file %q: %v", path, err)
+	}
+
+	mappings := make(map[string]*HostMapping, len(entries))
+	for host, entry := range entries {
+		scope, err := uplink.ParseScope(entry.Scope)
+		if err != nil {
+			return nil, errs.New("invalid scope for host %q: %v", host, err)
+		}
+		mapping, err := parseRoot(scope, entry.Root)
+		if err != nil {
+			return nil, errs.New("invalid root for host %q: %v", host, err)
+		}
+		mappings[host] = mapping
+	}
+
+	return NewStaticResolver(mappings), nil
+}
+
+// Resolve returns the mapping of the host
+func (resolver *StaticResolver) Resolve(ctx context.Context, host string) (_ *HostMapping, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	mapping, ok := resolver.mappings[strings.ToLower(host)]
+	if !ok {
+		return nil, ErrHostNotFound.New("%s", host)
+	}
+	return mapping, nil
+}
+
+const (
+	// dnsRecordPrefix is prepended to the host to get the name of its TXT records
+	dnsRecordPrefix = "_storj."
+	// dnsScopeKey is the TXT record holding the serialized scope
+	dnsScopeKey = "storj-scope="
+	// dnsRootKey is the TXT record holding the bucket and prefix
+	dnsRootKey = "storj-root="
+)
+
+// DNSResolver resolves hosts using the TXT records of _storj.<host>, which must
+// contain "storj-scope=<serialized scope>" and "storj-root=<bucket>[/<prefix>]".
+// Resolved mappings and missing hosts are cached for the configured TTL in a
+// cache holding at most the configured number of hosts, evicting the least
+// recently used ones.
+type DNSResolver struct {
+	lookupTXT func(ctx context.Context, name string) ([]string, error)
+	ttl       time.Duration
+	size      int
+
+	mu    sync.Mutex
+	cache map[string]*list.Element
+	// order contains the cached entries, the most recently used first
+	order *list.List
+}
+
+// dnsCacheEntry is a cached result of resolving a host
+type dnsCacheEntry struct {
+	host     string
+	mapping  *HostMapping
+	err      error
+	resolved time.Time
+}
+
+// NewDNSResolver creates a resolver using the system DNS resolver, which caches
+// up to size hosts. Zero size disables caching.
+func NewDNSResolver(ttl time.Duration, size int) *DNSResolver {
+	return &DNSResolver{
+		lookupTXT: net.DefaultResolver.LookupTXT,
+		ttl:       ttl,
+		size:      size,
+		cache:     make(map[string]*list.Element),
+		order:     list.New(),
+	}
+}
+
+// Resolve returns the mapping of the host
+func (resolver *DNSResolver) Resolve(ctx context.Context, host string) (_ *HostMapping, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	host = strings.ToLower(host)
+	now := time.Now()
+
+	if entry, ok := resolver.get(host, now); ok {
+		return entry.mapping, entry.err
+	}
+
+	mapping, err := resolver.lookup(ctx, host)
+	if err != nil && !ErrHostNotFound.Has(err) {
+		// don't cache temporary failures
+		return nil, err
+	}
+
+	resolver.put(&dnsCacheEntry{host: host, mapping: mapping, err: err, resolved: now})
+	return mapping, err
+}
+
+// get returns the cached entry of the host unless it has expired
+func (resolver *DNSResolver) get(host string, now time.Time) (*dnsCacheEntry, bool) {
+	resolver.mu.Lock()
+	defer resolver.mu.Unlock()
+
+	element, ok := resolver.cache[host]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*dnsCacheEntry)
+	if now.Sub(entry.resolved) >= resolver.ttl {
+		resolver.order.Remove(element)
+		delete(resolver.cache, host)
+		return nil, false
+	}
+
+	resolver.order.MoveToFront(element)
+	return entry, true
+}
+
+// put caches the entry and evicts the least recently used entries over the size
+func (resolver *DNSResolver) put(entry *dnsCacheEntry) {
+	resolver.mu.Lock()
+	defer resolver.mu.Unlock()
+
+	if resolver.size <= 0 {
+		return
+	}
+
+	if element, ok := resolver.cache[entry.host]; ok {
+		element.Value = entry
+		resolver.order.MoveToFront(element)
+		return
+	}
+
+	resolver.cache[entry.host] = resolver.order.PushFront(entry)
+	for resolver.order.Len() > resolver.size {
+		oldest := resolver.order.Back()
+		resolver.order.Remove(oldest)
+		delete(resolver.cache, oldest.Value.(*dnsCacheEntry).host)
+	}
+}
+
+func (resolver *DNSResolver) lookup(ctx context.Context, host string) (*HostMapping, error) {
+	records, err := resolver.lookupTXT(ctx, dnsRecordPrefix+host)
+	if err != nil {
+		if dnsErr, ok := err.(*net.DNSError); ok && !dnsErr.Temporary() {
+			return nil, ErrHostNotFound.New("%s", host)
+		}
+		return nil, errs.Wrap(err)
+	}
+
+	var serializedScope, root string
+	for _, record := range records {
+		switch {
+		case strings.HasPrefix(record, dnsScopeKey):
+			serializedScope = strings.TrimPrefix(record, dnsScopeKey)
+		case strings.HasPrefix(record, dnsRootKey):
+			root = strings.TrimPrefix(record, dnsRootKey)
+		}
+	}
+	if serializedScope == "" || root == "" {
+		return nil, ErrHostNotFound.New("%s: incomplete TXT records", host)
+	}
+
+	scope, err := uplink.ParseScope(serializedScope)
+	if err != nil {
+		return nil, errs.New("invalid scope for host %q: %v", host, err)
+	}
+	return parseRoot(scope, root)
+}
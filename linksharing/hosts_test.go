@@ -0,0 +1,132 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package linksharing
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestDNSResolver(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	scope := testScope(t)
+
+	lookups := 0
+	resolver := NewDNSResolver(time.Hour, 2)
+	resolver.lookupTXT = func(ctx context.Context, name string) ([]string, error) {
+		lookups++
+		switch name {
+		case "_storj.docs.example.com":
+			return []string{"v=spf1 -all", "storj-scope=" + scope, "storj-root=website/site/docs"}, nil
+		case "_storj.bucket.example.com":
+			return []string{"storj-scope=" + scope, "storj-root=website"}, nil
+		case "_storj.partial.example.com":
+			return []string{"storj-root=website"}, nil
+		case "_storj.broken.example.com":
+			return nil, &net.DNSError{Err: "server misbehaving", Name: name, IsTemporary: true}
+		}
+		return nil, &net.DNSError{Err: "no such host", Name: name}
+	}
+
+	mapping, err := resolver.Resolve(ctx, "Docs.Example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "website", mapping.Bucket)
+	assert.Equal(t, "site/docs/", mapping.Prefix)
+	assert.Equal(t, "127.0.0.1:7777", mapping.Scope.SatelliteAddr)
+
+	// resolved mappings are cached
+	_, err = resolver.Resolve(ctx, "docs.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, 1, lookups)
+
+	mapping, err = resolver.Resolve(ctx, "bucket.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "website", mapping.Bucket)
+	assert.Equal(t, "", mapping.Prefix)
+
+	for _, host := range []string{"partial.example.com", "missing.example.com"} {
+		_, err = resolver.Resolve(ctx, host)
+		assert.True(t, ErrHostNotFound.Has(err), host)
+	}
+
+	_, err = resolver.Resolve(ctx, "broken.example.com")
+	require.Error(t, err)
+	assert.False(t, ErrHostNotFound.Has(err))
+
+	// only the most recently used hosts are kept in the cache
+	lookups = 0
+	for _, host := range []string{"missing.example.com", "partial.example.com", "bucket.example.com"} {
+		_, _ = resolver.Resolve(ctx, host)
+	}
+	assert.Equal(t, 1, lookups)
+	assert.Equal(t, 2, resolver.order.Len())
+
+	for i := 0; i < 10; i++ {
+		_, _ = resolver.Resolve(ctx, fmt.Sprintf("random%d.example.com", i))
+	}
+	assert.Len(t, resolver.cache, 2)
+	assert.Equal(t, 2, resolver.order.Len())
+
+	_, err = resolver.Resolve(ctx, "docs.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, 12, lookups)
+}
+
+func TestLoadStaticResolver(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	hostsFile := ctx.File("hosts.json")
+	err := ioutil.WriteFile(hostsFile, []byte(fmt.Sprintf(`{
+		"www.example.com": {"scope": %q, "root": "website/site/"}
+	}`, testScope(t))), 0644)
+	require.NoError(t, err)
+
+	resolver, err := LoadStaticResolver(hostsFile)
+	require.NoError(t, err)
+
+	mapping, err := resolver.Resolve(ctx, "WWW.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "website", mapping.Bucket)
+	assert.Equal(t, "site/", mapping.Prefix)
+
+	_, err = resolver.Resolve(ctx, "example.com")
+	assert.True(t, ErrHostNotFound.Has(err))
+
+	err = ioutil.WriteFile(hostsFile, []byte(`{"www.example.com": {"scope": "bad", "root": "website"}}`), 0644)
+	require.NoError(t, err)
+	_, err = LoadStaticResolver(hostsFile)
+	assert.Error(t, err)
+}
+
+func testScope(t *testing.T) string {
+	secret, err := macaroon.NewSecret()
+	require.NoError(t, err)
+	key, err := macaroon.NewAPIKey(secret)
+	require.NoError(t, err)
+	apiKey, err := uplink.ParseAPIKey(key.Serialize())
+	require.NoError(t, err)
+
+	scope, err := (&uplink.Scope{
+		SatelliteAddr:    "127.0.0.1:7777",
+		APIKey:           apiKey,
+		EncryptionAccess: uplink.NewEncryptionAccessWithDefaultKey(storj.Key{}),
+	}).Serialize()
+	require.NoError(t, err)
+	return scope
+}
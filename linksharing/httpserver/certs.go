@@ -0,0 +1,74 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package httpserver
+
+import (
+	"crypto/tls"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/zeebo/errs"
+)
+
+// CertDirectory loads server certificates per host from a directory, where
+// the keypair of a host is stored as <host>.crt.pem and <host>.key.pem.
+// Loaded certificates are cached in memory.
+type CertDirectory struct {
+	dir      string
+	fallback *tls.Certificate
+
+	mu    sync.Mutex
+	certs map[string]*tls.Certificate
+}
+
+// NewCertDirectory creates a certificate directory. The fallback certificate,
+// if set, is used for hosts without a keypair in the directory.
+func NewCertDirectory(dir string, fallback *tls.Certificate) *CertDirectory {
+	return &CertDirectory{
+		dir:      dir,
+		fallback: fallback,
+		certs:    make(map[string]*tls.Certificate),
+	}
+}
+
+// GetCertificate returns the certificate for the server name of the hello,
+// it's meant to be used as tls.Config.GetCertificate.
+func (certs *CertDirectory) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	host := strings.ToLower(strings.TrimSuffix(hello.ServerName, "."))
+
+	// don't allow server names to escape the directory
+	if host == "" || strings.ContainsAny(host, `/\`) || strings.HasPrefix(host, ".") {
+		return certs.getFallback(hello.ServerName)
+	}
+
+	certs.mu.Lock()
+	defer certs.mu.Unlock()
+
+	if cert, ok := certs.certs[host]; ok {
+		return cert, nil
+	}
+
+	certFile := filepath.Join(certs.dir, host+".crt.pem")
+	keyFile := filepath.Join(certs.dir, host+".key.pem")
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return certs.getFallback(hello.ServerName)
+		}
+		return nil, errs.New("unable to load keypair for %q: %v", host, err)
+	}
+
+	certs.certs[host] = &cert
+	return &cert, nil
+}
+
+func (certs *CertDirectory) getFallback(serverName string) (*tls.Certificate, error) {
+	if certs.fallback == nil {
+		return nil, errs.New("no certificate for %q", serverName)
+	}
+	return certs.fallback, nil
+}
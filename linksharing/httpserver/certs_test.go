@@ -0,0 +1,51 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package httpserver
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/pkcrypto"
+)
+
+func TestCertDirectory(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	keyPEM, err := pkcrypto.PrivateKeyToPEM(testKey)
+	require.NoError(t, err)
+
+	require.NoError(t, ioutil.WriteFile(ctx.File("certs", "example.com.crt.pem"), pkcrypto.CertToPEM(testCert), 0644))
+	require.NoError(t, ioutil.WriteFile(ctx.File("certs", "example.com.key.pem"), keyPEM, 0600))
+
+	fallback := &tls.Certificate{}
+
+	certs := NewCertDirectory(ctx.Dir("certs"), nil)
+
+	cert, err := certs.GetCertificate(&tls.ClientHelloInfo{ServerName: "Example.com"})
+	require.NoError(t, err)
+	require.NotNil(t, cert)
+	assert.Equal(t, testCert.Raw, cert.Certificate[0])
+
+	// loaded certificates are cached
+	cached, err := certs.GetCertificate(&tls.ClientHelloInfo{ServerName: "example.com"})
+	require.NoError(t, err)
+	assert.True(t, cert == cached)
+
+	for _, serverName := range []string{"", "other.com", "../certs/example.com", ".example.com"} {
+		_, err = certs.GetCertificate(&tls.ClientHelloInfo{ServerName: serverName})
+		assert.Error(t, err, serverName)
+	}
+
+	certs = NewCertDirectory(ctx.Dir("certs"), fallback)
+	cert, err = certs.GetCertificate(&tls.ClientHelloInfo{ServerName: "other.com"})
+	require.NoError(t, err)
+	assert.True(t, cert == fallback)
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package linksharing
+
+import (
+	"context"
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+)
+
+// listingItem is a single entry of a prefix listing
+type listingItem struct {
+	Name        string     `json:"name"`
+	IsPrefix    bool       `json:"isPrefix"`
+	Size        int64      `json:"size,omitempty"`
+	ContentType string     `json:"contentType,omitempty"`
+	Modified    *time.Time `json:"modified,omitempty"`
+}
+
+// prefixListing is the listing of the objects and prefixes directly under a prefix
+type prefixListing struct {
+	Bucket string        `json:"bucket"`
+	Prefix string        `json:"prefix"`
+	Items  []listingItem `json:"items"`
+}
+
+// listPrefix lists the objects and prefixes directly under the prefix
+func listPrefix(ctx context.Context, b *uplink.Bucket, prefix string) (items []listingItem, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	opts := uplink.ListOptions{
+		Prefix:    prefix,
+		Direction: storj.After,
+	}
+	for {
+		list, err := b.ListObjects(ctx, &opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range list.Items {
+			item := listingItem{
+				Name:     strings.TrimSuffix(object.Path, "/"),
+				IsPrefix: object.IsPrefix,
+			}
+			if !object.IsPrefix {
+				item.Size = object.Size
+				item.ContentType = object.ContentType
+				modified := object.Modified
+				item.Modified = &modified
+			}
+			items = append(items, item)
+		}
+
+		if !list.More || len(list.Items) == 0 {
+			return items, nil
+		}
+		opts.Cursor = list.Items[len(list.Items)-1].Path
+	}
+}
+
+// wantsJSON returns true if the client asked for a JSON listing
+func wantsJSON(r *http.Request) bool {
+	if r.URL.Query().Get("format") == "json" {
+		return true
+	}
+	return strings.Contains(r.Header.Get("Accept"), "application/json")
+}
+
+func (listing *prefixListing) writeJSON(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	if listing.Items == nil {
+		listing.Items = []listingItem{}
+	}
+	return errs.Wrap(json.NewEncoder(w).Encode(listing))
+}
+
+func (listing *prefixListing) writeHTML(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	return errs.Wrap(listingTemplate.Execute(w, listing))
+}
+
+var listingTemplate = template.Must(template.New("listing").Funcs(template.FuncMap{
+	"href": func(item listingItem) string {
+		href := url.PathEscape(item.Name)
+		if item.IsPrefix {
+			href += "/"
+		}
+		return href
+	},
+	"modified": func(t *time.Time) string {
+		return t.UTC().Format("2006-01-02 15:04:05")
+	},
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Bucket}}/{{.Prefix}}</title>
+</head>
+<body>
+<h1>{{.Bucket}}/{{.Prefix}}</h1>
+<table>
+<tr><th>Name</th><th>Size</th><th>Modified</th></tr>
+{{- if .Prefix}}
+<tr><td><a href="../">../</a></td><td></td><td></td></tr>
+{{- end}}
+{{- range .Items}}
+{{- if .IsPrefix}}
+<tr><td><a href="{{href .}}">{{.Name}}/</a></td><td></td><td></td></tr>
+{{- else}}
+<tr><td><a href="{{href .}}">{{.Name}}</a></td><td>{{.Size}}</td><td>{{modified .Modified}}</td></tr>
+{{- end}}
+{{- end}}
+</table>
+</body>
+</html>
+`))
@@ -0,0 +1,332 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package linksharing
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/lib/uplink"
+)
+
+// maxCachedObjects is the maximum number of objects cached per bucket
+const maxCachedObjects = 1000
+
+// projectCacheConfig configures the project cache
+type projectCacheConfig struct {
+	// Capacity is the number of unused projects kept open, zero disables caching
+	Capacity int
+	// IdleTimeout is how long unused projects are kept open, zero keeps them
+	// open until they are evicted by newer projects
+	IdleTimeout time.Duration
+	// ObjectTTL is how long opened objects are cached, zero disables caching
+	ObjectTTL time.Duration
+}
+
+// projectCache keeps opened projects and buckets per scope, so that requests
+// for the same scope don't have to dial the satellite again.
+type projectCache struct {
+	log    *zap.Logger
+	uplink *uplink.Uplink
+	config projectCacheConfig
+
+	janitor *sync2.Cycle
+	group   errgroup.Group
+
+	mu       sync.Mutex
+	projects map[string]*cachedProject
+}
+
+// cachedProject is an opened project along with its users and opened buckets
+type cachedProject struct {
+	project   *uplink.Project
+	objectTTL time.Duration
+	refs      int
+	lastUsed  time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*cachedBucket
+}
+
+// cachedBucket is an opened bucket, which caches opened objects for a short time
+type cachedBucket struct {
+	*uplink.Bucket
+	ttl time.Duration
+
+	mu      sync.Mutex
+	objects map[string]cachedObject
+}
+
+// cachedObject is an opened object along with its expiration
+type cachedObject struct {
+	object  *uplink.Object
+	expires time.Time
+}
+
+// newProjectCache creates a project cache
+func newProjectCache(log *zap.Logger, up *uplink.Uplink, config projectCacheConfig) *projectCache {
+	cache := &projectCache{
+		log:      log,
+		uplink:   up,
+		config:   config,
+		projects: make(map[string]*cachedProject),
+	}
+
+	if config.Capacity > 0 && config.IdleTimeout > 0 {
+		cache.janitor = sync2.NewCycle(config.IdleTimeout / 2)
+		cache.janitor.Start(context.Background(), &cache.group, func(ctx context.Context) error {
+			cache.mu.Lock()
+			defer cache.mu.Unlock()
+			cache.evict()
+			return nil
+		})
+	}
+
+	return cache
+}
+
+// Open returns the project of the scope along with a function that must be
+// called once the project isn't used anymore
+func (cache *projectCache) Open(ctx context.Context, scope *uplink.Scope) (_ *cachedProject, release func() error, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if cache.config.Capacity <= 0 {
+		p, err := cache.uplink.OpenProject(ctx, scope.SatelliteAddr, scope.APIKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		cached := newCachedProject(p, cache.config.ObjectTTL)
+		return cached, cached.close, nil
+	}
+
+	key := scope.SatelliteAddr + "/" + scope.APIKey.Serialize()
+
+	cache.mu.Lock()
+	cached, ok := cache.projects[key]
+	if ok {
+		cached.refs++
+		cache.mu.Unlock()
+		mon.Meter("project_cache_hit").Mark(1)
+		return cached, cache.releaser(cached), nil
+	}
+	cache.mu.Unlock()
+	mon.Meter("project_cache_miss").Mark(1)
+
+	// dial the satellite without holding the lock
+	p, err := cache.uplink.OpenProject(ctx, scope.SatelliteAddr, scope.APIKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cached, ok := cache.projects[key]; ok {
+		// another request opened the project in the meantime
+		if err := p.Close(); err != nil {
+			cache.log.With(zap.Error(err)).Warn("unable to close project")
+		}
+		cached.refs++
+		return cached, cache.releaser(cached), nil
+	}
+
+	cached = newCachedProject(p, cache.config.ObjectTTL)
+	cached.refs = 1
+	cache.projects[key] = cached
+	cache.evict()
+
+	return cached, cache.releaser(cached), nil
+}
+
+// releaser returns a function releasing a reference to the cached project
+func (cache *projectCache) releaser(cached *cachedProject) func() error {
+	var once sync.Once
+	return func() error {
+		once.Do(func() {
+			cache.mu.Lock()
+			defer cache.mu.Unlock()
+
+			cached.refs--
+			cached.lastUsed = time.Now()
+			cache.evict()
+		})
+		return nil
+	}
+}
+
+// evict closes idle projects and the least recently used unreferenced
+// projects while there are more cached projects than the capacity, mu must
+// be held
+func (cache *projectCache) evict() {
+	if cache.config.IdleTimeout > 0 {
+		idleSince := time.Now().Add(-cache.config.IdleTimeout)
+		for key, cached := range cache.projects {
+			if cached.refs == 0 && cached.lastUsed.Before(idleSince) {
+				cache.remove(key, cached)
+			}
+		}
+	}
+
+	for len(cache.projects) > cache.config.Capacity {
+		var oldestKey string
+		var oldest *cachedProject
+		for key, cached := range cache.projects {
+			if cached.refs > 0 {
+				continue
+			}
+			if oldest == nil || cached.lastUsed.Before(oldest.lastUsed) {
+				oldestKey, oldest = key, cached
+			}
+		}
+		if oldest == nil {
+			// all projects are in use
+			break
+		}
+		cache.remove(oldestKey, oldest)
+	}
+
+	mon.IntVal("cached_projects").Observe(int64(len(cache.projects)))
+}
+
+// remove removes the project from the cache and closes it, mu must be held
+func (cache *projectCache) remove(key string, cached *cachedProject) {
+	delete(cache.projects, key)
+	if err := cached.close(); err != nil {
+		cache.log.With(zap.Error(err)).Warn("unable to close project")
+	}
+}
+
+// Close stops evicting idle projects and closes all cached projects
+func (cache *projectCache) Close() error {
+	if cache.janitor != nil {
+		cache.janitor.Close()
+	}
+	err := cache.group.Wait()
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	var group errs.Group
+	group.Add(err)
+	for key, cached := range cache.projects {
+		group.Add(cached.close())
+		delete(cache.projects, key)
+	}
+	return group.Err()
+}
+
+// newCachedProject wraps an opened project
+func newCachedProject(p *uplink.Project, objectTTL time.Duration) *cachedProject {
+	return &cachedProject{
+		project:   p,
+		objectTTL: objectTTL,
+		buckets:   make(map[string]*cachedBucket),
+	}
+}
+
+// OpenBucket returns the bucket opened with the encryption access, the bucket
+// is owned by the project and must not be closed
+func (cached *cachedProject) OpenBucket(ctx context.Context, name string, access *uplink.EncryptionAccess) (_ *cachedBucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	serializedAccess, err := access.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	key := name + "/" + serializedAccess
+
+	cached.mu.Lock()
+	b, ok := cached.buckets[key]
+	cached.mu.Unlock()
+	if ok {
+		mon.Meter("bucket_cache_hit").Mark(1)
+		return b, nil
+	}
+	mon.Meter("bucket_cache_miss").Mark(1)
+
+	bucket, err := cached.project.OpenBucket(ctx, name, access)
+	if err != nil {
+		return nil, err
+	}
+
+	cached.mu.Lock()
+	defer cached.mu.Unlock()
+
+	if b, ok := cached.buckets[key]; ok {
+		// another request opened the bucket in the meantime
+		return b, errs.Wrap(bucket.Close())
+	}
+
+	b = &cachedBucket{
+		Bucket:  bucket,
+		ttl:     cached.objectTTL,
+		objects: make(map[string]cachedObject),
+	}
+	cached.buckets[key] = b
+	return b, nil
+}
+
+// close closes the opened buckets and the project
+func (cached *cachedProject) close() error {
+	cached.mu.Lock()
+	defer cached.mu.Unlock()
+
+	var group errs.Group
+	for key, b := range cached.buckets {
+		group.Add(b.Close())
+		delete(cached.buckets, key)
+	}
+	group.Add(cached.project.Close())
+	return group.Err()
+}
+
+// OpenObject returns the object at the path, objects opened within the TTL
+// are returned from the cache so that repeated requests for the same object
+// skip the metainfo round trips
+func (b *cachedBucket) OpenObject(ctx context.Context, path string) (_ *uplink.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if b.ttl <= 0 {
+		return b.Bucket.OpenObject(ctx, path)
+	}
+
+	now := time.Now()
+
+	b.mu.Lock()
+	cached, ok := b.objects[path]
+	b.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		mon.Meter("object_cache_hit").Mark(1)
+		return cached.object, nil
+	}
+	mon.Meter("object_cache_miss").Mark(1)
+
+	o, err := b.Bucket.OpenObject(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.objects) >= maxCachedObjects {
+		for p, cached := range b.objects {
+			if !now.Before(cached.expires) {
+				delete(b.objects, p)
+			}
+		}
+		if len(b.objects) >= maxCachedObjects {
+			b.objects = make(map[string]cachedObject)
+		}
+	}
+	b.objects[path] = cachedObject{object: o, expires: now.Add(b.ttl)}
+
+	return o, nil
+}
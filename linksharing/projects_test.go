@@ -0,0 +1,84 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package linksharing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/lib/uplink"
+)
+
+func TestProjectCache(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 1,
+		UplinkCount:      1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		err := planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", "foo", []byte("FOO"))
+		require.NoError(t, err)
+
+		scope, err := uplink.ParseScope(serializedScope(t, planet))
+		require.NoError(t, err)
+
+		up := newUplink(ctx, t)
+		defer ctx.Check(up.Close)
+
+		cache := newProjectCache(zaptest.NewLogger(t), up, projectCacheConfig{
+			Capacity:    1,
+			IdleTimeout: 100 * time.Millisecond,
+			ObjectTTL:   time.Hour,
+		})
+		defer ctx.Check(cache.Close)
+
+		first, release, err := cache.Open(ctx, scope)
+		require.NoError(t, err)
+
+		second, releaseSecond, err := cache.Open(ctx, scope)
+		require.NoError(t, err)
+		assert.True(t, first == second, "projects should be cached")
+
+		b, err := first.OpenBucket(ctx, "testbucket", scope.EncryptionAccess)
+		require.NoError(t, err)
+		cachedBucket, err := second.OpenBucket(ctx, "testbucket", scope.EncryptionAccess)
+		require.NoError(t, err)
+		assert.True(t, b == cachedBucket, "buckets should be cached")
+
+		o, err := b.OpenObject(ctx, "foo")
+		require.NoError(t, err)
+		cachedObject, err := b.OpenObject(ctx, "foo")
+		require.NoError(t, err)
+		assert.True(t, o == cachedObject, "objects should be cached")
+
+		_, err = b.OpenObject(ctx, "missing")
+		require.Error(t, err)
+
+		require.NoError(t, release())
+		require.NoError(t, releaseSecond())
+
+		// idle projects are closed by the janitor
+		deadline := time.Now().Add(10 * time.Second)
+		for {
+			cache.mu.Lock()
+			cached := len(cache.projects)
+			cache.mu.Unlock()
+			if cached == 0 {
+				break
+			}
+			require.True(t, time.Now().Before(deadline), "idle project was not evicted")
+			time.Sleep(50 * time.Millisecond)
+		}
+
+		third, release, err := cache.Open(ctx, scope)
+		require.NoError(t, err)
+		defer ctx.Check(release)
+		assert.False(t, first == third, "evicted projects should be opened again")
+	})
+}
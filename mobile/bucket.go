@@ -18,11 +18,13 @@ const (
 	// CipherSuiteEncNull indicates use of the NULL cipher; that is, no encryption is
 	// done. The ciphertext is equal to the plaintext.
 	CipherSuiteEncNull = byte(storj.EncNull)
-	// CipherSuiteEncAESGCM indicates use of AES128-GCM encryption.
+	// CipherSuiteEncAESGCM indicates use of AES-256-GCM encryption.
 	CipherSuiteEncAESGCM = byte(storj.EncAESGCM)
 	// CipherSuiteEncSecretBox indicates use of XSalsa20-Poly1305 encryption, as provided
 	// by the NaCl cryptography library under the name "Secretbox".
 	CipherSuiteEncSecretBox = byte(storj.EncSecretBox)
+	// CipherSuiteEncChaCha20Poly1305 indicates use of ChaCha20-Poly1305 encryption.
+	CipherSuiteEncChaCha20Poly1305 = byte(storj.EncChaCha20Poly1305)
 
 	// DirectionForward lists forwards from cursor, including cursor
 	DirectionForward = int(storj.Forward)
@@ -5,6 +5,7 @@ package mobile
 
 import (
 	libuplink "storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/paths"
 	"storj.io/storj/pkg/storj"
 )
@@ -44,6 +45,17 @@ func (e *EncryptionAccess) SetDefaultKey(keyData []byte) error {
 	return nil
 }
 
+// SetKDFProfile records the key derivation profile the default key was
+// derived from a passphrase with, see Project.SaltedKeyFromPassphraseWithKDFProfile.
+func (e *EncryptionAccess) SetKDFProfile(profile string) error {
+	params, err := encryption.ParseKDFParameters(profile)
+	if err != nil {
+		return safeError(err)
+	}
+	e.lib.SetKDFParameters(params)
+	return nil
+}
+
 // Serialize returns a base58-serialized encryption access for use with later
 // parsing.
 func (e *EncryptionAccess) Serialize() (b58data string, err error) {
@@ -8,6 +8,7 @@ import (
 
 	"storj.io/storj/internal/memory"
 	libuplink "storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/storj"
 )
 
@@ -185,3 +186,20 @@ func (project *Project) SaltedKeyFromPassphrase(passphrase string) (keyData []by
 	}
 	return key[:], nil
 }
+
+// SaltedKeyFromPassphraseWithKDFProfile returns a key generated from the given passphrase using
+// a stable, project-specific salt and the named key derivation profile, e.g. "mobile".
+func (project *Project) SaltedKeyFromPassphraseWithKDFProfile(passphrase, profile string) (keyData []byte, err error) {
+	scope := project.scope.child()
+
+	params, err := encryption.ParseKDFParameters(profile)
+	if err != nil {
+		return nil, safeError(err)
+	}
+
+	key, err := project.lib.SaltedKeyFromPassphraseWithKDF(scope.ctx, passphrase, params)
+	if err != nil {
+		return nil, safeError(err)
+	}
+	return key[:], nil
+}
@@ -0,0 +1,156 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package encryption
+
+import (
+	"crypto/cipher"
+
+	"golang.org/x/crypto/chacha20poly1305"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// ChaCha20Poly1305NonceSize is the size of a ChaCha20-Poly1305 nonce
+const ChaCha20Poly1305NonceSize = chacha20poly1305.NonceSize
+
+// ChaCha20Poly1305Nonce represents the nonce used by the ChaCha20-Poly1305
+// protocol
+type ChaCha20Poly1305Nonce [ChaCha20Poly1305NonceSize]byte
+
+// ToChaCha20Poly1305Nonce returns the nonce as a ChaCha20-Poly1305 nonce
+func ToChaCha20Poly1305Nonce(nonce *storj.Nonce) *ChaCha20Poly1305Nonce {
+	chacha := new(ChaCha20Poly1305Nonce)
+	copy((*chacha)[:], nonce[:ChaCha20Poly1305NonceSize])
+	return chacha
+}
+
+type chachaEncrypter struct {
+	blockSize     int
+	key           *storj.Key
+	startingNonce *ChaCha20Poly1305Nonce
+	overhead      int
+	aead          cipher.AEAD
+}
+
+// NewChaCha20Poly1305Encrypter returns a Transformer that encrypts the data
+// passing through with key. See the comments for NewAESGCMEncrypter about
+// startingNonce.
+//
+// ChaCha20-Poly1305 is considerably faster than AES-GCM on hardware without
+// AES instructions.
+func NewChaCha20Poly1305Encrypter(key *storj.Key, startingNonce *ChaCha20Poly1305Nonce, encryptedBlockSize int) (Transformer, error) {
+	aead, err := chacha20poly1305.New(key[:])
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if encryptedBlockSize <= aead.Overhead() {
+		return nil, ErrInvalidConfig.New("encrypted block size %d too small", encryptedBlockSize)
+	}
+	return &chachaEncrypter{
+		blockSize:     encryptedBlockSize - aead.Overhead(),
+		key:           key,
+		startingNonce: startingNonce,
+		overhead:      aead.Overhead(),
+		aead:          aead,
+	}, nil
+}
+
+func (s *chachaEncrypter) InBlockSize() int {
+	return s.blockSize
+}
+
+func (s *chachaEncrypter) OutBlockSize() int {
+	return s.blockSize + s.overhead
+}
+
+func calcChaChaNonce(startingNonce *ChaCha20Poly1305Nonce, blockNum int64) (rv ChaCha20Poly1305Nonce, err error) {
+	if copy(rv[:], (*startingNonce)[:]) != len(rv) {
+		return rv, Error.New("didn't copy memory?!")
+	}
+	_, err = incrementBytes(rv[:], blockNum)
+	return rv, err
+}
+
+func (s *chachaEncrypter) Transform(out, in []byte, blockNum int64) ([]byte, error) {
+	nonce, err := calcChaChaNonce(s.startingNonce, blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.aead.Seal(out, nonce[:], in, nil), nil
+}
+
+type chachaDecrypter struct {
+	blockSize     int
+	key           *storj.Key
+	startingNonce *ChaCha20Poly1305Nonce
+	overhead      int
+	aead          cipher.AEAD
+}
+
+// NewChaCha20Poly1305Decrypter returns a Transformer that decrypts the data
+// passing through with key. See the comments for NewAESGCMEncrypter about
+// startingNonce.
+func NewChaCha20Poly1305Decrypter(key *storj.Key, startingNonce *ChaCha20Poly1305Nonce, encryptedBlockSize int) (Transformer, error) {
+	aead, err := chacha20poly1305.New(key[:])
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if encryptedBlockSize <= aead.Overhead() {
+		return nil, ErrInvalidConfig.New("encrypted block size %d too small", encryptedBlockSize)
+	}
+	return &chachaDecrypter{
+		blockSize:     encryptedBlockSize - aead.Overhead(),
+		key:           key,
+		startingNonce: startingNonce,
+		overhead:      aead.Overhead(),
+		aead:          aead,
+	}, nil
+}
+
+func (s *chachaDecrypter) InBlockSize() int {
+	return s.blockSize + s.overhead
+}
+
+func (s *chachaDecrypter) OutBlockSize() int {
+	return s.blockSize
+}
+
+func (s *chachaDecrypter) Transform(out, in []byte, blockNum int64) ([]byte, error) {
+	nonce, err := calcChaChaNonce(s.startingNonce, blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	plainData, err := s.aead.Open(out, nonce[:], in, nil)
+	if err != nil {
+		return nil, ErrDecryptFailed.Wrap(err)
+	}
+	return plainData, nil
+}
+
+// EncryptChaCha20Poly1305 encrypts byte data with a key and nonce. The cipher data is returned
+func EncryptChaCha20Poly1305(data []byte, key *storj.Key, nonce *ChaCha20Poly1305Nonce) (cipherData []byte, err error) {
+	aead, err := chacha20poly1305.New(key[:])
+	if err != nil {
+		return []byte{}, Error.Wrap(err)
+	}
+	return aead.Seal(nil, nonce[:], data, nil), nil
+}
+
+// DecryptChaCha20Poly1305 decrypts byte data with a key and nonce. The plain data is returned
+func DecryptChaCha20Poly1305(cipherData []byte, key *storj.Key, nonce *ChaCha20Poly1305Nonce) (data []byte, err error) {
+	if len(cipherData) == 0 {
+		return []byte{}, Error.New("empty cipher data")
+	}
+	aead, err := chacha20poly1305.New(key[:])
+	if err != nil {
+		return []byte{}, Error.Wrap(err)
+	}
+	plainData, err := aead.Open(nil, nonce[:], cipherData, nil)
+	if err != nil {
+		return []byte{}, ErrDecryptFailed.Wrap(err)
+	}
+	return plainData, nil
+}
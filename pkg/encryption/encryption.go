@@ -46,6 +46,8 @@ func Encrypt(data []byte, cipher storj.CipherSuite, key *storj.Key, nonce *storj
 		return EncryptAESGCM(data, key, ToAESGCMNonce(nonce))
 	case storj.EncSecretBox:
 		return EncryptSecretBox(data, key, nonce)
+	case storj.EncChaCha20Poly1305:
+		return EncryptChaCha20Poly1305(data, key, ToChaCha20Poly1305Nonce(nonce))
 	default:
 		return nil, ErrInvalidConfig.New("encryption type %d is not supported", cipher)
 	}
@@ -65,6 +67,8 @@ func Decrypt(cipherData []byte, cipher storj.CipherSuite, key *storj.Key, nonce
 		return DecryptAESGCM(cipherData, key, ToAESGCMNonce(nonce))
 	case storj.EncSecretBox:
 		return DecryptSecretBox(cipherData, key, nonce)
+	case storj.EncChaCha20Poly1305:
+		return DecryptChaCha20Poly1305(cipherData, key, ToChaCha20Poly1305Nonce(nonce))
 	default:
 		return nil, ErrInvalidConfig.New("encryption type %d is not supported", cipher)
 	}
@@ -79,6 +83,8 @@ func NewEncrypter(cipher storj.CipherSuite, key *storj.Key, startingNonce *storj
 		return NewAESGCMEncrypter(key, ToAESGCMNonce(startingNonce), encryptedBlockSize)
 	case storj.EncSecretBox:
 		return NewSecretboxEncrypter(key, startingNonce, encryptedBlockSize)
+	case storj.EncChaCha20Poly1305:
+		return NewChaCha20Poly1305Encrypter(key, ToChaCha20Poly1305Nonce(startingNonce), encryptedBlockSize)
 	default:
 		return nil, ErrInvalidConfig.New("encryption type %d is not supported", cipher)
 	}
@@ -93,6 +99,8 @@ func NewDecrypter(cipher storj.CipherSuite, key *storj.Key, startingNonce *storj
 		return NewAESGCMDecrypter(key, ToAESGCMNonce(startingNonce), encryptedBlockSize)
 	case storj.EncSecretBox:
 		return NewSecretboxDecrypter(key, startingNonce, encryptedBlockSize)
+	case storj.EncChaCha20Poly1305:
+		return NewChaCha20Poly1305Decrypter(key, ToChaCha20Poly1305Nonce(startingNonce), encryptedBlockSize)
 	default:
 		return nil, ErrInvalidConfig.New("encryption type %d is not supported", cipher)
 	}
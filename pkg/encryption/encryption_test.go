@@ -63,6 +63,7 @@ func forAllCiphers(test func(cipher storj.CipherSuite)) {
 		storj.EncNull,
 		storj.EncAESGCM,
 		storj.EncSecretBox,
+		storj.EncChaCha20Poly1305,
 	} {
 		test(cipher)
 	}
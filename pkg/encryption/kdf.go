@@ -0,0 +1,155 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package encryption
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"storj.io/storj/internal/memory"
+)
+
+// KDFVersion identifies the key derivation function used to derive root keys
+// from passwords.
+type KDFVersion int32
+
+const (
+	// KDFArgon2id derives root keys with Argon2id (version 1.3) from a salt
+	// mixed with the password and the path, see DeriveRootKeyWithParameters.
+	KDFArgon2id KDFVersion = 1
+)
+
+// KDFParameters are the parameters used to derive a root key from a password.
+type KDFParameters struct {
+	// Version is the key derivation function.
+	Version KDFVersion
+	// Time is the number of passes over the memory.
+	Time uint32
+	// Memory is the amount of memory used.
+	Memory memory.Size
+	// Parallelism is the number of threads used. Zero uses all of the cores,
+	// which makes the derived key depend on the machine.
+	Parallelism uint8
+}
+
+// DefaultKDFParameters are the parameters DeriveRootKey always used: a time
+// of 1, 64MB of ram, and all of the cores. Keys derived before the parameters
+// were configurable were derived with them.
+var DefaultKDFParameters = KDFParameters{
+	Version:     KDFArgon2id,
+	Time:        1,
+	Memory:      64 * memory.MiB,
+	Parallelism: 0,
+}
+
+// kdfProfiles are the named KDF parameters.
+var kdfProfiles = map[string]KDFParameters{
+	"default": DefaultKDFParameters,
+	// mobile is cheap enough for phones and other memory-constrained clients.
+	"mobile": {
+		Version:     KDFArgon2id,
+		Time:        3,
+		Memory:      16 * memory.MiB,
+		Parallelism: 2,
+	},
+	// archive is meant for high-value data, where deriving the key taking
+	// a few seconds doesn't matter.
+	"archive": {
+		Version:     KDFArgon2id,
+		Time:        4,
+		Memory:      1 * memory.GiB,
+		Parallelism: 4,
+	},
+}
+
+// KDFProfileNames returns the names of the KDF profiles in sorted order.
+func KDFProfileNames() []string {
+	names := make([]string, 0, len(kdfProfiles))
+	for name := range kdfProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// KDFProfile returns the KDF parameters of the named profile.
+func KDFProfile(name string) (KDFParameters, error) {
+	params, ok := kdfProfiles[name]
+	if !ok {
+		return KDFParameters{}, ErrInvalidConfig.New("unknown KDF profile %q, expected one of %s", name, strings.Join(KDFProfileNames(), ", "))
+	}
+	return params, nil
+}
+
+// ParseKDFParameters parses either the name of a profile or parameters
+// formatted by KDFParameters.String.
+func ParseKDFParameters(s string) (KDFParameters, error) {
+	if !strings.HasPrefix(s, "argon2id:") {
+		return KDFProfile(s)
+	}
+
+	params := KDFParameters{Version: KDFArgon2id}
+	for _, field := range strings.Split(strings.TrimPrefix(s, "argon2id:"), ",") {
+		parts := strings.SplitN(field, "=", 2)
+		if len(parts) != 2 {
+			return KDFParameters{}, ErrInvalidConfig.New("invalid KDF parameter %q", field)
+		}
+
+		value, err := strconv.ParseUint(parts[1], 10, 32)
+		if err != nil {
+			return KDFParameters{}, ErrInvalidConfig.New("invalid KDF parameter %q: %v", field, err)
+		}
+
+		switch parts[0] {
+		case "m":
+			params.Memory = memory.Size(value) * memory.KiB
+		case "t":
+			params.Time = uint32(value)
+		case "p":
+			if value > math.MaxUint8 {
+				return KDFParameters{}, ErrInvalidConfig.New("invalid KDF parameter %q: too many threads", field)
+			}
+			params.Parallelism = uint8(value)
+		default:
+			return KDFParameters{}, ErrInvalidConfig.New("unknown KDF parameter %q", field)
+		}
+	}
+
+	return params, params.Validate()
+}
+
+// String formats the parameters like the Argon2 reference encoding, with the
+// memory in KiB. For example, DefaultKDFParameters is "argon2id:m=65536,t=1,p=0".
+func (params KDFParameters) String() string {
+	return fmt.Sprintf("argon2id:m=%d,t=%d,p=%d", params.Memory/memory.KiB, params.Time, params.Parallelism)
+}
+
+// Validate returns an error if the parameters can't be used to derive a key.
+func (params KDFParameters) Validate() error {
+	if params.Version != KDFArgon2id {
+		return ErrInvalidConfig.New("KDF version %d is not supported", params.Version)
+	}
+	if params.Time < 1 {
+		return ErrInvalidConfig.New("KDF time must be at least 1")
+	}
+	if params.Memory%memory.KiB != 0 || params.Memory/memory.KiB > math.MaxUint32 {
+		return ErrInvalidConfig.New("invalid KDF memory %v", params.Memory)
+	}
+	if minimum := 8 * memory.KiB * memory.Size(params.threads()); params.Memory < minimum {
+		return ErrInvalidConfig.New("KDF memory must be at least %v for %d threads", minimum, params.threads())
+	}
+	return nil
+}
+
+// threads returns the number of threads the key derivation uses.
+func (params KDFParameters) threads() uint8 {
+	if params.Parallelism == 0 {
+		return uint8(runtime.GOMAXPROCS(-1))
+	}
+	return params.Parallelism
+}
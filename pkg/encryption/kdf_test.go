@@ -0,0 +1,84 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package encryption
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+)
+
+func TestKDFProfiles(t *testing.T) {
+	for _, name := range KDFProfileNames() {
+		params, err := KDFProfile(name)
+		require.NoError(t, err, name)
+		assert.NoError(t, params.Validate(), name)
+
+		parsed, err := ParseKDFParameters(params.String())
+		require.NoError(t, err, name)
+		assert.Equal(t, params, parsed, name)
+	}
+
+	params, err := ParseKDFParameters("default")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultKDFParameters, params)
+
+	_, err = KDFProfile("unknown")
+	assert.True(t, ErrInvalidConfig.Has(err))
+}
+
+func TestParseKDFParameters(t *testing.T) {
+	params, err := ParseKDFParameters("argon2id:m=32768,t=3,p=2")
+	require.NoError(t, err)
+	assert.Equal(t, KDFParameters{
+		Version:     KDFArgon2id,
+		Time:        3,
+		Memory:      32 * memory.MiB,
+		Parallelism: 2,
+	}, params)
+
+	for _, invalid := range []string{
+		"argon2id:m=32768,t=0,p=2",
+		"argon2id:m=8,t=1,p=2",
+		"argon2id:m=32768,t=1,p=256",
+		"argon2id:m=32768,t=1,x=1",
+		"argon2id:m=32768;t=1",
+		"argon2i:m=32768,t=1,p=1",
+	} {
+		_, err := ParseKDFParameters(invalid)
+		assert.True(t, ErrInvalidConfig.Has(err), invalid)
+	}
+}
+
+func TestDeriveRootKeyWithParameters(t *testing.T) {
+	params := KDFParameters{
+		Version:     KDFArgon2id,
+		Time:        1,
+		Memory:      1 * memory.MiB,
+		Parallelism: 1,
+	}
+
+	key1, err := DeriveRootKeyWithParameters([]byte("password"), []byte("salt"), "", params)
+	require.NoError(t, err)
+	key2, err := DeriveRootKeyWithParameters([]byte("password"), []byte("salt"), "", params)
+	require.NoError(t, err)
+	assert.Equal(t, key1, key2)
+
+	params.Time = 2
+	key3, err := DeriveRootKeyWithParameters([]byte("password"), []byte("salt"), "", params)
+	require.NoError(t, err)
+	assert.NotEqual(t, key1, key3)
+
+	defaultKey, err := DeriveRootKey([]byte("password"), []byte("salt"), "")
+	require.NoError(t, err)
+	key4, err := DeriveRootKeyWithParameters([]byte("password"), []byte("salt"), "", DefaultKDFParameters)
+	require.NoError(t, err)
+	assert.Equal(t, defaultKey, key4)
+
+	_, err = DeriveRootKeyWithParameters([]byte("password"), []byte("salt"), "", KDFParameters{})
+	assert.True(t, ErrInvalidConfig.Has(err))
+}
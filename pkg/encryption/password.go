@@ -6,7 +6,6 @@ package encryption
 import (
 	"crypto/hmac"
 	"crypto/sha256"
-	"runtime"
 
 	"github.com/zeebo/errs"
 	"golang.org/x/crypto/argon2"
@@ -25,7 +24,21 @@ func sha256hmac(key, data []byte) ([]byte, error) {
 
 // DeriveRootKey derives a root key for some path using the salt for the bucket and
 // a password from the user. See the password key derivation design doc.
+//
+// DeriveRootKey uses DefaultKDFParameters, see DeriveRootKeyWithParameters.
 func DeriveRootKey(password, salt []byte, path storj.Path) (*storj.Key, error) {
+	return DeriveRootKeyWithParameters(password, salt, path, DefaultKDFParameters)
+}
+
+// DeriveRootKeyWithParameters derives a root key for some path using the salt
+// for the bucket and a password from the user, running the key derivation
+// function with the given parameters. The same parameters must be used to
+// derive the same key again.
+func DeriveRootKeyWithParameters(password, salt []byte, path storj.Path, params KDFParameters) (*storj.Key, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
 	mixedSalt, err := sha256hmac(password, salt)
 	if err != nil {
 		return nil, err
@@ -36,8 +49,7 @@ func DeriveRootKey(password, salt []byte, path storj.Path) (*storj.Key, error) {
 		return nil, err
 	}
 
-	// use a time of 1, 64MB of ram, and all of the cores.
-	keyData := argon2.IDKey(password, pathSalt, 1, uint32(64*memory.MiB/memory.KiB), uint8(runtime.GOMAXPROCS(-1)), 32)
+	keyData := argon2.IDKey(password, pathSalt, params.Time, uint32(params.Memory/memory.KiB), params.threads(), 32)
 	if len(keyData) != len(storj.Key{}) {
 		return nil, errs.New("invalid output from argon2id")
 	}
@@ -265,8 +265,11 @@ func encryptPathComponent(comp string, cipher storj.CipherSuite, key *storj.Key)
 	}
 
 	nonceSize := storj.NonceSize
-	if cipher == storj.EncAESGCM {
+	switch cipher {
+	case storj.EncAESGCM:
 		nonceSize = AESGCMNonceSize
+	case storj.EncChaCha20Poly1305:
+		nonceSize = ChaCha20Poly1305NonceSize
 	}
 
 	// keep the nonce together with the cipher text
@@ -285,8 +288,11 @@ func decryptPathComponent(comp string, cipher storj.CipherSuite, key *storj.Key)
 	}
 
 	nonceSize := storj.NonceSize
-	if cipher == storj.EncAESGCM {
+	switch cipher {
+	case storj.EncAESGCM:
 		nonceSize = AESGCMNonceSize
+	case storj.EncChaCha20Poly1305:
+		nonceSize = ChaCha20Poly1305NonceSize
 	}
 	if len(data) < nonceSize || nonceSize < 0 {
 		return "", errs.New("component did not contain enough nonce bytes")
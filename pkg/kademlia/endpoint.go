@@ -25,22 +25,29 @@ type SatelliteIDVerifier interface {
 	VerifySatelliteID(ctx context.Context, id storj.NodeID) error
 }
 
+// SatelliteCapacities returns the capacity of the node available to a satellite
+type SatelliteCapacities interface {
+	SatelliteCapacity(satellite storj.NodeID) pb.NodeCapacity
+}
+
 // Endpoint implements the kademlia Endpoints
 type Endpoint struct {
 	log          *zap.Logger
 	service      *Kademlia
 	routingTable *RoutingTable
 	trust        SatelliteIDVerifier
+	capacities   SatelliteCapacities
 	connected    int32
 }
 
 // NewEndpoint returns a new kademlia endpoint
-func NewEndpoint(log *zap.Logger, service *Kademlia, routingTable *RoutingTable, trust SatelliteIDVerifier) *Endpoint {
+func NewEndpoint(log *zap.Logger, service *Kademlia, routingTable *RoutingTable, trust SatelliteIDVerifier, capacities SatelliteCapacities) *Endpoint {
 	return &Endpoint{
 		log:          log,
 		service:      service,
 		routingTable: routingTable,
 		trust:        trust,
+		capacities:   capacities,
 	}
 }
 
@@ -103,6 +110,7 @@ func (endpoint *Endpoint) Ping(ctx context.Context, req *pb.PingRequest) (_ *pb.
 func (endpoint *Endpoint) RequestInfo(ctx context.Context, req *pb.InfoRequest) (_ *pb.InfoResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 	self := endpoint.service.Local()
+	capacity := self.Capacity
 
 	if self.Type == pb.NodeType_STORAGE {
 		if endpoint.trust == nil {
@@ -118,12 +126,17 @@ func (endpoint *Endpoint) RequestInfo(ctx context.Context, req *pb.InfoRequest)
 		if err != nil {
 			return nil, status.Errorf(codes.PermissionDenied, "untrusted peer %v", peer.ID)
 		}
+
+		// satellites only see the capacity that is available to them
+		if endpoint.capacities != nil {
+			capacity = endpoint.capacities.SatelliteCapacity(peer.ID)
+		}
 	}
 
 	return &pb.InfoResponse{
 		Type:     self.Type,
 		Operator: &self.Operator,
-		Capacity: &self.Capacity,
+		Capacity: &capacity,
 		Version:  &self.Version,
 	}, nil
 }
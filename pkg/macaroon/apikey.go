@@ -6,6 +6,7 @@ package macaroon
 import (
 	"bytes"
 	"context"
+	"net"
 	"time"
 
 	"github.com/btcsuite/btcutil/base58"
@@ -46,6 +47,8 @@ const (
 	ActionDelete ActionType = 4
 	// ActionProjectInfo requests project-level information
 	ActionProjectInfo ActionType = 5
+	// ActionRevoke requests revocation of a derived API key
+	ActionRevoke ActionType = 6
 )
 
 // Action specifies the specific operation being performed that the Macaroon will validate
@@ -54,6 +57,16 @@ type Action struct {
 	Bucket        []byte
 	EncryptedPath []byte
 	Time          time.Time
+
+	// ClientIP is the address the request came from
+	ClientIP net.IP
+	// ObjectSize and SegmentCount are the encrypted size and the number of
+	// segments of the object being written, as far as they are known
+	// at the time
+	ObjectSize   int64
+	SegmentCount int64
+	// Overwrite is set when the write replaces an existing object
+	Overwrite bool
 }
 
 // APIKey implements a Macaroon-backed Storj-v3 API key.
@@ -97,7 +110,8 @@ func NewAPIKey(secret []byte) (*APIKey, error) {
 
 // Check makes sure that the key authorizes the provided action given the root
 // project secret and any possible revocations, returning an error if the action
-// is not authorized. 'revoked' is a list of revoked heads.
+// is not authorized. 'revoked' is a list of revoked heads or tails, where a
+// revoked tail also revokes every key derived from it.
 func (a *APIKey) Check(ctx context.Context, secret []byte, action Action, revoked [][]byte) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !a.mac.Validate(secret) {
@@ -121,16 +135,35 @@ func (a *APIKey) Check(ctx context.Context, secret []byte, action Action, revoke
 		}
 	}
 
+	if len(revoked) == 0 {
+		return nil
+	}
+
 	head := a.mac.Head()
+	tails := a.mac.Tails(secret)
 	for _, revokedID := range revoked {
 		if bytes.Equal(revokedID, head) {
 			return ErrRevoked.New("macaroon head revoked")
 		}
+		for _, tail := range tails {
+			if bytes.Equal(revokedID, tail) {
+				return ErrRevoked.New("macaroon tail revoked")
+			}
+		}
 	}
 
 	return nil
 }
 
+// Tails returns the tails of this macaroon and all of its ancestors, starting
+// with the unrestricted root key, given the root project secret.
+func (a *APIKey) Tails(secret []byte) ([][]byte, error) {
+	if !a.mac.Validate(secret) {
+		return nil, ErrInvalid.New("macaroon unauthorized")
+	}
+	return a.mac.Tails(secret), nil
+}
+
 // AllowedBuckets stores information about which buckets are
 // allowed to be accessed, where `Buckets` stores names of buckets that are
 // allowed and `All` is a bool that indicates if all buckets are allowed or not
@@ -167,6 +200,39 @@ func (a *APIKey) GetAllowedBuckets(ctx context.Context, action Action) (allowed
 	return allowed, err
 }
 
+// DisallowsOverwrites returns whether any caveat of the key disallows
+// overwriting existing objects.
+func (a *APIKey) DisallowsOverwrites() (bool, error) {
+	for _, cavbuf := range a.mac.Caveats() {
+		var cav Caveat
+		err := proto.Unmarshal(cavbuf, &cav)
+		if err != nil {
+			return false, ErrFormat.New("invalid caveat format: %v", err)
+		}
+		if cav.DisallowOverwrites {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// MaxObjectSize returns the smallest maximum object size set by the caveats
+// of the key, or 0 when the object size is not limited.
+func (a *APIKey) MaxObjectSize() (int64, error) {
+	var maxSize int64
+	for _, cavbuf := range a.mac.Caveats() {
+		var cav Caveat
+		err := proto.Unmarshal(cavbuf, &cav)
+		if err != nil {
+			return 0, ErrFormat.New("invalid caveat format: %v", err)
+		}
+		if cav.MaxObjectSize > 0 && (maxSize == 0 || cav.MaxObjectSize < maxSize) {
+			maxSize = cav.MaxObjectSize
+		}
+	}
+	return maxSize, nil
+}
+
 // Restrict generates a new APIKey with the provided Caveat attached.
 func (a *APIKey) Restrict(caveat Caveat) (*APIKey, error) {
 	buf, err := proto.Marshal(&caveat)
@@ -212,6 +278,10 @@ func (c *Caveat) Allows(action Action) bool {
 		return false
 	}
 
+	if len(c.AllowedIpRanges) > 0 && !c.allowsIP(action.ClientIP) {
+		return false
+	}
+
 	// we want to always allow reads for bucket metadata, perhaps filtered by the
 	// buckets in the allowed paths.
 	if action.Op == ActionRead && len(action.EncryptedPath) == 0 {
@@ -241,6 +311,15 @@ func (c *Caveat) Allows(action Action) bool {
 		if c.DisallowWrites {
 			return false
 		}
+		if c.DisallowOverwrites && action.Overwrite {
+			return false
+		}
+		if c.MaxObjectSize > 0 && action.ObjectSize > c.MaxObjectSize {
+			return false
+		}
+		if c.MaxSegments > 0 && action.SegmentCount > c.MaxSegments {
+			return false
+		}
 	case ActionList:
 		if c.DisallowLists {
 			return false
@@ -249,13 +328,13 @@ func (c *Caveat) Allows(action Action) bool {
 		if c.DisallowDeletes {
 			return false
 		}
-	case ActionProjectInfo:
+	case ActionProjectInfo, ActionRevoke:
 		// allow
 	default:
 		return false
 	}
 
-	if len(c.AllowedPaths) > 0 && action.Op != ActionProjectInfo {
+	if len(c.AllowedPaths) > 0 && action.Op != ActionProjectInfo && action.Op != ActionRevoke {
 		found := false
 		for _, path := range c.AllowedPaths {
 			if bytes.Equal(action.Bucket, path.Bucket) &&
@@ -271,3 +350,20 @@ func (c *Caveat) Allows(action Action) bool {
 
 	return true
 }
+
+// allowsIP returns true if ip is within one of the caveat's allowed ranges.
+func (c *Caveat) allowsIP(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, value := range c.AllowedIpRanges {
+		ipRange, err := ParseIPRange(value)
+		if err != nil {
+			continue
+		}
+		if ipRange.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
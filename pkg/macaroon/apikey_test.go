@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net"
 	"testing"
 	"time"
 
@@ -87,6 +88,40 @@ func TestRevocation(t *testing.T) {
 	require.True(t, ErrRevoked.Has(restricted.Check(ctx, secret, action, [][]byte{restricted.Head()})))
 }
 
+func TestTailRevocation(t *testing.T) {
+	secret, err := NewSecret()
+	require.NoError(t, err)
+	key, err := NewAPIKey(secret)
+	require.NoError(t, err)
+
+	restricted, err := key.Restrict(Caveat{DisallowReads: true})
+	require.NoError(t, err)
+	derived, err := restricted.Restrict(Caveat{DisallowDeletes: true})
+	require.NoError(t, err)
+
+	action := Action{
+		Op:   ActionWrite,
+		Time: time.Now(),
+	}
+
+	revoked := [][]byte{restricted.Tail()}
+
+	// revoking a tail revokes the key and everything derived from it,
+	// but leaves its ancestors intact
+	require.NoError(t, key.Check(ctx, secret, action, revoked))
+	require.True(t, ErrRevoked.Has(restricted.Check(ctx, secret, action, revoked)))
+	require.True(t, ErrRevoked.Has(derived.Check(ctx, secret, action, revoked)))
+
+	tails, err := derived.Tails(secret)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{key.Tail(), restricted.Tail(), derived.Tail()}, tails)
+
+	otherSecret, err := NewSecret()
+	require.NoError(t, err)
+	_, err = derived.Tails(otherSecret)
+	require.True(t, ErrInvalid.Has(err))
+}
+
 func TestExpiration(t *testing.T) {
 	secret, err := NewSecret()
 	require.NoError(t, err)
@@ -140,3 +175,89 @@ func TestExpiration(t *testing.T) {
 		}
 	}
 }
+
+func TestUploadRestrictions(t *testing.T) {
+	secret, err := NewSecret()
+	require.NoError(t, err)
+	key, err := NewAPIKey(secret)
+	require.NoError(t, err)
+
+	restricted, err := key.Restrict(Caveat{
+		DisallowOverwrites: true,
+		AllowedIpRanges:    []string{"10.0.0.0/8", "192.168.1.1", "fd00::/8"},
+		MaxObjectSize:      1000,
+		MaxSegments:        2,
+	})
+	require.NoError(t, err)
+
+	disallows, err := key.DisallowsOverwrites()
+	require.NoError(t, err)
+	require.False(t, disallows)
+
+	disallows, err = restricted.DisallowsOverwrites()
+	require.NoError(t, err)
+	require.True(t, disallows)
+
+	maxSize, err := key.MaxObjectSize()
+	require.NoError(t, err)
+	require.EqualValues(t, 0, maxSize)
+
+	maxSize, err = restricted.MaxObjectSize()
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, maxSize)
+
+	// the smallest limit of all caveats applies
+	loosened, err := restricted.Restrict(Caveat{MaxObjectSize: 2000})
+	require.NoError(t, err)
+	maxSize, err = loosened.MaxObjectSize()
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, maxSize)
+
+	now := time.Now()
+	for i, test := range []struct {
+		action  Action
+		allowed bool
+	}{
+		{Action{Op: ActionWrite, Time: now, ClientIP: net.ParseIP("10.1.2.3")}, true},
+		{Action{Op: ActionWrite, Time: now, ClientIP: net.ParseIP("192.168.1.1")}, true},
+		{Action{Op: ActionWrite, Time: now, ClientIP: net.ParseIP("fd00::1")}, true},
+		{Action{Op: ActionWrite, Time: now, ClientIP: net.ParseIP("192.168.1.2")}, false},
+		{Action{Op: ActionRead, Time: now, ClientIP: net.ParseIP("11.0.0.1")}, false},
+		{Action{Op: ActionWrite, Time: now}, false},
+
+		{Action{Op: ActionWrite, Time: now, ClientIP: net.ParseIP("10.0.0.1"), ObjectSize: 1000, SegmentCount: 2}, true},
+		{Action{Op: ActionWrite, Time: now, ClientIP: net.ParseIP("10.0.0.1"), ObjectSize: 1001}, false},
+		{Action{Op: ActionWrite, Time: now, ClientIP: net.ParseIP("10.0.0.1"), SegmentCount: 3}, false},
+		{Action{Op: ActionWrite, Time: now, ClientIP: net.ParseIP("10.0.0.1"), Overwrite: true}, false},
+		{Action{Op: ActionRead, Time: now, ClientIP: net.ParseIP("10.0.0.1"), ObjectSize: 1001, Overwrite: true}, true},
+	} {
+		require.NoError(t, key.Check(ctx, secret, test.action, nil), fmt.Sprintf("test #%d", i+1))
+
+		err := restricted.Check(ctx, secret, test.action, nil)
+		if test.allowed {
+			require.NoError(t, err, fmt.Sprintf("test #%d", i+1))
+		} else {
+			require.True(t, ErrUnauthorized.Has(err), fmt.Sprintf("test #%d", i+1))
+		}
+	}
+}
+
+func TestParseIPRange(t *testing.T) {
+	ipRange, err := ParseIPRange("1.2.3.4")
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3.4/32", ipRange.String())
+
+	ipRange, err = ParseIPRange("1.2.3.0/24")
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3.0/24", ipRange.String())
+
+	ipRange, err = ParseIPRange("::1")
+	require.NoError(t, err)
+	require.Equal(t, "::1/128", ipRange.String())
+
+	_, err = ParseIPRange("1.2.3.0/33")
+	require.Error(t, err)
+
+	_, err = ParseIPRange("localhost")
+	require.Error(t, err)
+}
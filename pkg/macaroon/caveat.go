@@ -5,6 +5,7 @@ package macaroon
 
 import (
 	"crypto/rand"
+	"net"
 )
 
 // NewCaveat returns a Caveat with a random generated nonce.
@@ -13,3 +14,20 @@ func NewCaveat() (Caveat, error) {
 	_, err := rand.Read(buf[:])
 	return Caveat{Nonce: buf[:]}, err
 }
+
+// ParseIPRange parses an allowed client address range, given either in CIDR
+// notation or as a single IP address.
+func ParseIPRange(value string) (*net.IPNet, error) {
+	if ip := net.ParseIP(value); ip != nil {
+		bits := 8 * net.IPv4len
+		if ip.To4() == nil {
+			bits = 8 * net.IPv6len
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	_, ipRange, err := net.ParseCIDR(value)
+	if err != nil {
+		return nil, Error.New("invalid ip range %q", value)
+	}
+	return ipRange, nil
+}
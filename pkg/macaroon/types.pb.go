@@ -26,17 +26,26 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type Caveat struct {
 	// if any of these three are set, disallow that type of access
-	DisallowReads   bool           `protobuf:"varint,1,opt,name=disallow_reads,json=disallowReads,proto3" json:"disallow_reads,omitempty"`
-	DisallowWrites  bool           `protobuf:"varint,2,opt,name=disallow_writes,json=disallowWrites,proto3" json:"disallow_writes,omitempty"`
-	DisallowLists   bool           `protobuf:"varint,3,opt,name=disallow_lists,json=disallowLists,proto3" json:"disallow_lists,omitempty"`
-	DisallowDeletes bool           `protobuf:"varint,4,opt,name=disallow_deletes,json=disallowDeletes,proto3" json:"disallow_deletes,omitempty"`
-	AllowedPaths    []*Caveat_Path `protobuf:"bytes,10,rep,name=allowed_paths,json=allowedPaths,proto3" json:"allowed_paths,omitempty"`
+	DisallowReads   bool `protobuf:"varint,1,opt,name=disallow_reads,json=disallowReads,proto3" json:"disallow_reads,omitempty"`
+	DisallowWrites  bool `protobuf:"varint,2,opt,name=disallow_writes,json=disallowWrites,proto3" json:"disallow_writes,omitempty"`
+	DisallowLists   bool `protobuf:"varint,3,opt,name=disallow_lists,json=disallowLists,proto3" json:"disallow_lists,omitempty"`
+	DisallowDeletes bool `protobuf:"varint,4,opt,name=disallow_deletes,json=disallowDeletes,proto3" json:"disallow_deletes,omitempty"`
+	// if set, disallow writes that replace an already existing object
+	DisallowOverwrites bool           `protobuf:"varint,5,opt,name=disallow_overwrites,json=disallowOverwrites,proto3" json:"disallow_overwrites,omitempty"`
+	AllowedPaths       []*Caveat_Path `protobuf:"bytes,10,rep,name=allowed_paths,json=allowedPaths,proto3" json:"allowed_paths,omitempty"`
 	// if set, the validity time window
 	NotAfter  *time.Time `protobuf:"bytes,20,opt,name=not_after,json=notAfter,proto3,stdtime" json:"not_after,omitempty"`
 	NotBefore *time.Time `protobuf:"bytes,21,opt,name=not_before,json=notBefore,proto3,stdtime" json:"not_before,omitempty"`
 	// nonce is set to some random bytes so that you can make arbitrarily
 	// many restricted macaroons with the same (or no) restrictions.
-	Nonce                []byte   `protobuf:"bytes,30,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Nonce []byte `protobuf:"bytes,30,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// If any entries exist, require the request to come from a client address
+	// in at least one of these CIDR ranges.
+	AllowedIpRanges []string `protobuf:"bytes,40,rep,name=allowed_ip_ranges,json=allowedIpRanges,proto3" json:"allowed_ip_ranges,omitempty"`
+	// if set, the maximum size in bytes and number of segments of an uploaded
+	// object
+	MaxObjectSize        int64    `protobuf:"varint,41,opt,name=max_object_size,json=maxObjectSize,proto3" json:"max_object_size,omitempty"`
+	MaxSegments          int64    `protobuf:"varint,42,opt,name=max_segments,json=maxSegments,proto3" json:"max_segments,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -94,6 +103,13 @@ func (m *Caveat) GetDisallowDeletes() bool {
 	return false
 }
 
+func (m *Caveat) GetDisallowOverwrites() bool {
+	if m != nil {
+		return m.DisallowOverwrites
+	}
+	return false
+}
+
 func (m *Caveat) GetAllowedPaths() []*Caveat_Path {
 	if m != nil {
 		return m.AllowedPaths
@@ -122,6 +138,27 @@ func (m *Caveat) GetNonce() []byte {
 	return nil
 }
 
+func (m *Caveat) GetAllowedIpRanges() []string {
+	if m != nil {
+		return m.AllowedIpRanges
+	}
+	return nil
+}
+
+func (m *Caveat) GetMaxObjectSize() int64 {
+	if m != nil {
+		return m.MaxObjectSize
+	}
+	return 0
+}
+
+func (m *Caveat) GetMaxSegments() int64 {
+	if m != nil {
+		return m.MaxSegments
+	}
+	return 0
+}
+
 // If any entries exist, require all access to happen in at least
 // one of them.
 type Caveat_Path struct {
@@ -178,27 +215,32 @@ func init() {
 func init() { proto.RegisterFile("types.proto", fileDescriptor_d938547f84707355) }
 
 var fileDescriptor_d938547f84707355 = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x50, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x25, 0xaf, 0x7d, 0xa5, 0xef, 0x36, 0x7d, 0xef, 0x31, 0xb6, 0x12, 0xb2, 0xb0, 0x41, 0x10,
-	0xe3, 0x66, 0x0a, 0x75, 0x27, 0x88, 0x58, 0x5d, 0xba, 0x28, 0x83, 0xe0, 0x32, 0x4c, 0x92, 0x9b,
-	0x34, 0x98, 0x66, 0xc2, 0xcc, 0xd4, 0xda, 0xbf, 0xf0, 0xd3, 0xfc, 0x03, 0x7f, 0x45, 0x66, 0xd2,
-	0x04, 0xba, 0x73, 0x79, 0xce, 0x3d, 0xe7, 0xdc, 0x7b, 0x0f, 0x8c, 0xf4, 0xbe, 0x46, 0x45, 0x6b,
-	0x29, 0xb4, 0x20, 0xc3, 0x0d, 0x4f, 0xb8, 0x14, 0xa2, 0xf2, 0x21, 0x17, 0xb9, 0x68, 0x58, 0x7f,
-	0x96, 0x0b, 0x91, 0x97, 0x38, 0xb7, 0x28, 0xde, 0x66, 0x73, 0x5d, 0x6c, 0x50, 0x69, 0xbe, 0xa9,
-	0x1b, 0xc1, 0xf9, 0x67, 0x0f, 0x06, 0x0f, 0xfc, 0x0d, 0xb9, 0x26, 0x17, 0xf0, 0x37, 0x2d, 0x14,
-	0x2f, 0x4b, 0xb1, 0x8b, 0x24, 0xf2, 0x54, 0x79, 0x4e, 0xe0, 0x84, 0x43, 0x36, 0x6e, 0x59, 0x66,
-	0x48, 0x72, 0x09, 0xff, 0x3a, 0xd9, 0x4e, 0x16, 0x1a, 0x95, 0xf7, 0xcb, 0xea, 0x3a, 0xf7, 0x8b,
-	0x65, 0x8f, 0xf2, 0xca, 0x42, 0x69, 0xe5, 0xf5, 0x8e, 0xf3, 0x9e, 0x0c, 0x49, 0xae, 0xe0, 0x7f,
-	0x27, 0x4b, 0xb1, 0x44, 0x13, 0xd8, 0xb7, 0xc2, 0x6e, 0xcf, 0x63, 0x43, 0x93, 0x1b, 0x18, 0x5b,
-	0x8c, 0x69, 0x54, 0x73, 0xbd, 0x56, 0x1e, 0x04, 0xbd, 0x70, 0xb4, 0x98, 0xd2, 0xf6, 0x77, 0xda,
-	0xbc, 0x42, 0x57, 0x5c, 0xaf, 0x99, 0x7b, 0xd0, 0x1a, 0xa0, 0xc8, 0x2d, 0xfc, 0xa9, 0x84, 0x8e,
-	0x78, 0xa6, 0x51, 0x7a, 0x93, 0xc0, 0x09, 0x47, 0x0b, 0x9f, 0x36, 0xed, 0xd0, 0xb6, 0x1d, 0xfa,
-	0xdc, 0xb6, 0xb3, 0xec, 0x7f, 0x7c, 0xcd, 0x1c, 0x36, 0xac, 0x84, 0xbe, 0x37, 0x0e, 0x72, 0x07,
-	0x60, 0xec, 0x31, 0x66, 0x42, 0xa2, 0x37, 0xfd, 0xa1, 0xdf, 0xac, 0x5c, 0x5a, 0x0b, 0x99, 0xc0,
-	0xef, 0x4a, 0x54, 0x09, 0x7a, 0x67, 0x81, 0x13, 0xba, 0xac, 0x01, 0x3e, 0x83, 0xbe, 0x39, 0x8f,
-	0x9c, 0xc2, 0x20, 0xde, 0x26, 0xaf, 0xa8, 0x6d, 0xe7, 0x2e, 0x3b, 0x20, 0xb2, 0x80, 0x29, 0x56,
-	0x89, 0xdc, 0xd7, 0xfa, 0xf0, 0x73, 0x54, 0x4b, 0xcc, 0x8a, 0x77, 0x5b, 0xb9, 0xcb, 0x4e, 0xba,
-	0xa1, 0x49, 0x59, 0xd9, 0x51, 0x3c, 0xb0, 0xe7, 0x5c, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xca,
-	0x7b, 0x7d, 0xfc, 0x1f, 0x02, 0x00, 0x00,
+	// 432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0x87, 0x65, 0x92, 0x46, 0xe9, 0xc4, 0x21, 0xb0, 0x6d, 0xd0, 0x2a, 0x07, 0x6a, 0x90, 0x00,
+	0xb7, 0x07, 0x47, 0x0a, 0x37, 0x24, 0x84, 0x28, 0x5c, 0x90, 0x90, 0x5a, 0x6d, 0x91, 0x38, 0x5a,
+	0x6b, 0x67, 0xe2, 0x1a, 0x6c, 0xaf, 0xb5, 0x3b, 0x6d, 0xdd, 0x3e, 0x05, 0x6f, 0xc8, 0x6b, 0x70,
+	0x44, 0xbb, 0xfe, 0x23, 0xe5, 0xd6, 0xe3, 0x7c, 0xf3, 0xcd, 0x8c, 0xf6, 0xa7, 0x85, 0x19, 0xdd,
+	0xd7, 0x68, 0xa2, 0x5a, 0x2b, 0x52, 0x6c, 0x5a, 0xca, 0x54, 0x6a, 0xa5, 0xaa, 0x15, 0x64, 0x2a,
+	0x53, 0x2d, 0x5d, 0x9d, 0x64, 0x4a, 0x65, 0x05, 0xae, 0x5d, 0x95, 0xdc, 0xec, 0xd6, 0x94, 0x97,
+	0x68, 0x48, 0x96, 0x75, 0x2b, 0xbc, 0xfe, 0x37, 0x86, 0xc9, 0x17, 0x79, 0x8b, 0x92, 0xd8, 0x1b,
+	0x78, 0xba, 0xcd, 0x8d, 0x2c, 0x0a, 0x75, 0x17, 0x6b, 0x94, 0x5b, 0xc3, 0xbd, 0xc0, 0x0b, 0xa7,
+	0x62, 0xde, 0x53, 0x61, 0x21, 0x7b, 0x07, 0x8b, 0x41, 0xbb, 0xd3, 0x39, 0xa1, 0xe1, 0x4f, 0x9c,
+	0x37, 0x4c, 0xff, 0x74, 0x74, 0x6f, 0x5f, 0x91, 0x1b, 0x32, 0x7c, 0xb4, 0xbf, 0xef, 0xbb, 0x85,
+	0xec, 0x14, 0x9e, 0x0d, 0xda, 0x16, 0x0b, 0xb4, 0x0b, 0xc7, 0x4e, 0x1c, 0xee, 0x7c, 0x6d, 0x31,
+	0x5b, 0xc3, 0xd1, 0xa0, 0xaa, 0x5b, 0xd4, 0xdd, 0xf9, 0x03, 0x67, 0xb3, 0xbe, 0x75, 0x31, 0x74,
+	0xd8, 0x07, 0x98, 0x3b, 0x84, 0xdb, 0xb8, 0x96, 0x74, 0x6d, 0x38, 0x04, 0xa3, 0x70, 0xb6, 0x59,
+	0x46, 0x7d, 0x58, 0x51, 0xfb, 0xf6, 0xe8, 0x52, 0xd2, 0xb5, 0xf0, 0x3b, 0xd7, 0x16, 0x86, 0x7d,
+	0x84, 0xc3, 0x4a, 0x51, 0x2c, 0x77, 0x84, 0x9a, 0x1f, 0x07, 0x5e, 0x38, 0xdb, 0xac, 0xa2, 0x36,
+	0xce, 0xa8, 0x8f, 0x33, 0xfa, 0xd1, 0xc7, 0x79, 0x3e, 0xfe, 0xf3, 0xf7, 0xc4, 0x13, 0xd3, 0x4a,
+	0xd1, 0x67, 0x3b, 0xc1, 0x3e, 0x01, 0xd8, 0xf1, 0x04, 0x77, 0x4a, 0x23, 0x5f, 0x3e, 0x72, 0xde,
+	0x9e, 0x3c, 0x77, 0x23, 0xec, 0x18, 0x0e, 0x2a, 0x55, 0xa5, 0xc8, 0x5f, 0x06, 0x5e, 0xe8, 0x8b,
+	0xb6, 0x60, 0x67, 0xf0, 0xbc, 0x7f, 0x51, 0x5e, 0xc7, 0x5a, 0x56, 0x19, 0x1a, 0x1e, 0x06, 0xa3,
+	0xf0, 0x50, 0x2c, 0xba, 0xc6, 0xb7, 0x5a, 0x38, 0xcc, 0xde, 0xc2, 0xa2, 0x94, 0x4d, 0xac, 0x92,
+	0x5f, 0x98, 0x52, 0x6c, 0xf2, 0x07, 0xe4, 0xa7, 0x81, 0x17, 0x8e, 0xc4, 0xbc, 0x94, 0xcd, 0x85,
+	0xa3, 0x57, 0xf9, 0x03, 0xb2, 0x57, 0xe0, 0x5b, 0xcf, 0x60, 0x56, 0x62, 0x45, 0x86, 0x9f, 0x39,
+	0x69, 0x56, 0xca, 0xe6, 0xaa, 0x43, 0x2b, 0x01, 0x63, 0x9b, 0x0a, 0x7b, 0x01, 0x93, 0xe4, 0x26,
+	0xfd, 0x8d, 0xe4, 0xfe, 0x86, 0x2f, 0xba, 0x8a, 0x6d, 0x60, 0x89, 0x55, 0xaa, 0xef, 0x6b, 0xea,
+	0xa2, 0x8e, 0x6b, 0x8d, 0xbb, 0xbc, 0x71, 0x5f, 0xc3, 0x17, 0x47, 0x43, 0xd3, 0x6e, 0xb9, 0x74,
+	0xad, 0x64, 0xe2, 0x52, 0x78, 0xff, 0x7f, 0x00, 0x27, 0xcc, 0x35, 0xb8, 0xc7, 0x02, 0x00, 0x00,
 }
@@ -187,7 +187,7 @@ func (layer *gatewayLayer) GetObjectInfo(ctx context.Context, bucketName, object
 		Bucket:      object.Meta.Bucket,
 		ModTime:     object.Meta.Modified,
 		Size:        object.Meta.Size,
-		ETag:        hex.EncodeToString(object.Meta.Checksum),
+		ETag:        hex.EncodeToString(object.Meta.MD5Checksum),
 		ContentType: object.Meta.ContentType,
 		UserDefined: object.Meta.Metadata,
 	}, err
@@ -267,7 +267,7 @@ func (layer *gatewayLayer) ListObjects(ctx context.Context, bucketName, prefix,
 				Bucket:      item.Bucket.Name,
 				ModTime:     item.Modified,
 				Size:        item.Size,
-				ETag:        hex.EncodeToString(item.Checksum),
+				ETag:        hex.EncodeToString(item.MD5Checksum),
 				ContentType: item.ContentType,
 				UserDefined: item.Metadata,
 			})
@@ -341,7 +341,7 @@ func (layer *gatewayLayer) ListObjectsV2(ctx context.Context, bucketName, prefix
 				Bucket:      item.Bucket.Name,
 				ModTime:     item.Modified,
 				Size:        item.Size,
-				ETag:        hex.EncodeToString(item.Checksum),
+				ETag:        hex.EncodeToString(item.MD5Checksum),
 				ContentType: item.ContentType,
 				UserDefined: item.Metadata,
 			})
@@ -450,7 +450,7 @@ func (layer *gatewayLayer) putObject(ctx context.Context, bucketName, objectPath
 		Bucket:      object.Meta.Bucket,
 		ModTime:     object.Meta.Modified,
 		Size:        object.Meta.Size,
-		ETag:        hex.EncodeToString(object.Meta.Checksum),
+		ETag:        hex.EncodeToString(object.Meta.MD5Checksum),
 		ContentType: object.Meta.ContentType,
 		UserDefined: object.Meta.Metadata,
 	}, nil
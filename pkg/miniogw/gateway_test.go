@@ -201,7 +201,7 @@ func TestPutObject(t *testing.T) {
 			assert.False(t, info.IsDir)
 			assert.True(t, time.Since(info.ModTime) < 1*time.Minute)
 			assert.Equal(t, data.Size(), info.Size)
-			// assert.Equal(t, data.SHA256HexString(), info.ETag) TODO: when we start calculating checksums
+			assert.Equal(t, data.MD5HexString(), info.ETag)
 			assert.Equal(t, serMetaInfo.ContentType, info.ContentType)
 			assert.Equal(t, serMetaInfo.UserDefined, info.UserDefined)
 		}
@@ -214,7 +214,8 @@ func TestPutObject(t *testing.T) {
 			assert.False(t, obj.IsPrefix)
 			assert.Equal(t, info.ModTime, obj.Modified)
 			assert.Equal(t, info.Size, obj.Size)
-			assert.Equal(t, info.ETag, hex.EncodeToString(obj.Checksum))
+			assert.Equal(t, info.ETag, hex.EncodeToString(obj.MD5Checksum))
+			assert.Equal(t, data.SHA256(), obj.Checksum)
 			assert.Equal(t, info.ContentType, obj.ContentType)
 			assert.Equal(t, info.UserDefined, obj.Metadata)
 		}
@@ -259,7 +260,7 @@ func TestGetObjectInfo(t *testing.T) {
 			assert.False(t, info.IsDir)
 			assert.Equal(t, obj.Modified, info.ModTime)
 			assert.Equal(t, obj.Size, info.Size)
-			assert.Equal(t, hex.EncodeToString(obj.Checksum), info.ETag)
+			assert.Equal(t, hex.EncodeToString(obj.MD5Checksum), info.ETag)
 			assert.Equal(t, createInfo.ContentType, info.ContentType)
 			assert.Equal(t, createInfo.Metadata, info.UserDefined)
 		}
@@ -379,7 +380,7 @@ func TestCopyObject(t *testing.T) {
 			assert.False(t, info.IsDir)
 			assert.True(t, info.ModTime.Sub(obj.Modified) < 1*time.Minute)
 			assert.Equal(t, obj.Size, info.Size)
-			assert.Equal(t, hex.EncodeToString(obj.Checksum), info.ETag)
+			assert.Equal(t, hex.EncodeToString(obj.MD5Checksum), info.ETag)
 			assert.Equal(t, createInfo.ContentType, info.ContentType)
 			assert.Equal(t, createInfo.Metadata, info.UserDefined)
 		}
@@ -392,7 +393,7 @@ func TestCopyObject(t *testing.T) {
 			assert.False(t, obj.IsPrefix)
 			assert.Equal(t, info.ModTime, obj.Modified)
 			assert.Equal(t, info.Size, obj.Size)
-			assert.Equal(t, info.ETag, hex.EncodeToString(obj.Checksum))
+			assert.Equal(t, info.ETag, hex.EncodeToString(obj.MD5Checksum))
 			assert.Equal(t, info.ContentType, obj.ContentType)
 			assert.Equal(t, info.UserDefined, obj.Metadata)
 		}
@@ -627,7 +628,7 @@ func testListObjects(t *testing.T, listObjects func(context.Context, minio.Objec
 					assert.False(t, objectInfo.IsDir, errTag)
 					assert.Equal(t, obj.Modified, objectInfo.ModTime, errTag)
 					assert.Equal(t, obj.Size, objectInfo.Size, errTag)
-					assert.Equal(t, hex.EncodeToString(obj.Checksum), objectInfo.ETag, errTag)
+					assert.Equal(t, hex.EncodeToString(obj.MD5Checksum), objectInfo.ETag, errTag)
 					assert.Equal(t, obj.ContentType, objectInfo.ContentType, errTag)
 					assert.Equal(t, obj.Metadata, objectInfo.UserDefined, errTag)
 				}
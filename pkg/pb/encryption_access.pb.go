@@ -24,6 +24,7 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type EncryptionAccess struct {
 	DefaultKey           []byte                         `protobuf:"bytes,1,opt,name=default_key,json=defaultKey,proto3" json:"default_key,omitempty"`
 	StoreEntries         []*EncryptionAccess_StoreEntry `protobuf:"bytes,2,rep,name=store_entries,json=storeEntries,proto3" json:"store_entries,omitempty"`
+	DefaultKeyKdf        *KDFParameters                 `protobuf:"bytes,3,opt,name=default_key_kdf,json=defaultKeyKdf,proto3" json:"default_key_kdf,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
 	XXX_unrecognized     []byte                         `json:"-"`
 	XXX_sizecache        int32                          `json:"-"`
@@ -67,6 +68,13 @@ func (m *EncryptionAccess) GetStoreEntries() []*EncryptionAccess_StoreEntry {
 	return nil
 }
 
+func (m *EncryptionAccess) GetDefaultKeyKdf() *KDFParameters {
+	if m != nil {
+		return m.DefaultKeyKdf
+	}
+	return nil
+}
+
 type EncryptionAccess_StoreEntry struct {
 	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
 	UnencryptedPath      []byte   `protobuf:"bytes,2,opt,name=unencrypted_path,json=unencryptedPath,proto3" json:"unencrypted_path,omitempty"`
@@ -129,28 +137,96 @@ func (m *EncryptionAccess_StoreEntry) GetKey() []byte {
 	return nil
 }
 
+type KDFParameters struct {
+	Version              int32    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Time                 uint32   `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Memory               uint64   `protobuf:"varint,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	Parallelism          uint32   `protobuf:"varint,4,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KDFParameters) Reset()         { *m = KDFParameters{} }
+func (m *KDFParameters) String() string { return proto.CompactTextString(m) }
+func (*KDFParameters) ProtoMessage()    {}
+func (*KDFParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_464b1a18bff4a17b, []int{1}
+}
+func (m *KDFParameters) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KDFParameters.Unmarshal(m, b)
+}
+func (m *KDFParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KDFParameters.Marshal(b, m, deterministic)
+}
+func (m *KDFParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KDFParameters.Merge(m, src)
+}
+func (m *KDFParameters) XXX_Size() int {
+	return xxx_messageInfo_KDFParameters.Size(m)
+}
+func (m *KDFParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_KDFParameters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KDFParameters proto.InternalMessageInfo
+
+func (m *KDFParameters) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *KDFParameters) GetTime() uint32 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *KDFParameters) GetMemory() uint64 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *KDFParameters) GetParallelism() uint32 {
+	if m != nil {
+		return m.Parallelism
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EncryptionAccess)(nil), "encryption_access.EncryptionAccess")
 	proto.RegisterType((*EncryptionAccess_StoreEntry)(nil), "encryption_access.EncryptionAccess.StoreEntry")
+	proto.RegisterType((*KDFParameters)(nil), "encryption_access.KDFParameters")
 }
 
 func init() { proto.RegisterFile("encryption_access.proto", fileDescriptor_464b1a18bff4a17b) }
 
 var fileDescriptor_464b1a18bff4a17b = []byte{
-	// 228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0xcd, 0x4b, 0x2e,
-	0xaa, 0x2c, 0x28, 0xc9, 0xcc, 0xcf, 0x8b, 0x4f, 0x4c, 0x4e, 0x4e, 0x2d, 0x2e, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x12, 0xc4, 0x90, 0x90, 0xe2, 0x4a, 0xcf, 0x4f, 0xcf, 0x87, 0x48, 0x2b,
-	0x4d, 0x66, 0xe2, 0x12, 0x70, 0x85, 0xab, 0x70, 0x04, 0x2b, 0x10, 0x92, 0xe7, 0xe2, 0x4e, 0x49,
-	0x4d, 0x4b, 0x2c, 0xcd, 0x29, 0x89, 0xcf, 0x4e, 0xad, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x09,
-	0xe2, 0x82, 0x0a, 0x79, 0xa7, 0x56, 0x0a, 0x05, 0x73, 0xf1, 0x16, 0x97, 0xe4, 0x17, 0xa5, 0xc6,
-	0xa7, 0xe6, 0x95, 0x14, 0x65, 0xa6, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0xe9, 0xe9,
-	0x61, 0xba, 0x02, 0xdd, 0x70, 0xbd, 0x60, 0x90, 0x46, 0xd7, 0xbc, 0x92, 0xa2, 0xca, 0x20, 0x9e,
-	0x62, 0x18, 0x3b, 0x33, 0xb5, 0x58, 0xaa, 0x83, 0x91, 0x8b, 0x0b, 0x21, 0x29, 0x24, 0xc6, 0xc5,
-	0x96, 0x54, 0x9a, 0x9c, 0x9d, 0x5a, 0x02, 0xb5, 0x1f, 0xca, 0x13, 0xd2, 0xe4, 0x12, 0x28, 0xcd,
-	0x83, 0xda, 0x93, 0x9a, 0x12, 0x5f, 0x90, 0x58, 0x92, 0x21, 0xc1, 0x04, 0x56, 0xc1, 0x8f, 0x24,
-	0x1e, 0x90, 0x58, 0x92, 0x21, 0xa4, 0xca, 0xc5, 0x87, 0xa6, 0x90, 0x19, 0xac, 0x90, 0x17, 0x55,
-	0x99, 0x00, 0x17, 0x33, 0xc8, 0x9b, 0x2c, 0x60, 0x39, 0x10, 0xd3, 0x89, 0x25, 0x8a, 0xa9, 0x20,
-	0x29, 0x89, 0x0d, 0x1c, 0x44, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf2, 0x73, 0xee, 0x7d,
-	0x5c, 0x01, 0x00, 0x00,
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xbb, 0x4e, 0xc3, 0x30,
+	0x14, 0x86, 0x95, 0x0b, 0x45, 0x3a, 0x69, 0x68, 0xf1, 0x00, 0x51, 0x17, 0xa2, 0x4a, 0x48, 0x65,
+	0xc9, 0x50, 0x9e, 0x00, 0x44, 0x11, 0x52, 0x97, 0xca, 0xdd, 0x58, 0x22, 0x37, 0x39, 0x6d, 0xad,
+	0x26, 0x76, 0x64, 0xbb, 0xa0, 0xbc, 0x01, 0x2f, 0xc9, 0xbb, 0xa0, 0x98, 0x94, 0xde, 0xd8, 0xce,
+	0xe5, 0xf7, 0xff, 0x7f, 0xb6, 0xe1, 0x16, 0x45, 0xa6, 0xea, 0xca, 0x70, 0x29, 0x52, 0x96, 0x65,
+	0xa8, 0x75, 0x52, 0x29, 0x69, 0x24, 0xb9, 0x3e, 0x5b, 0x0c, 0x60, 0x25, 0x57, 0xf2, 0x77, 0x3d,
+	0xfc, 0x76, 0xa1, 0x3f, 0xf9, 0x53, 0x3c, 0x59, 0x01, 0xb9, 0x83, 0x20, 0xc7, 0x25, 0xdb, 0x16,
+	0x26, 0xdd, 0x60, 0x1d, 0x39, 0xb1, 0x33, 0xea, 0x52, 0x68, 0x47, 0x53, 0xac, 0xc9, 0x1c, 0x42,
+	0x6d, 0xa4, 0xc2, 0x14, 0x85, 0x51, 0x1c, 0x75, 0xe4, 0xc6, 0xde, 0x28, 0x18, 0x27, 0xc9, 0x39,
+	0xc5, 0xa9, 0x79, 0x32, 0x6f, 0x0e, 0x4e, 0x84, 0x51, 0x35, 0xed, 0xea, 0x5d, 0xcd, 0x51, 0x93,
+	0x37, 0xe8, 0x1d, 0xa4, 0xa6, 0x9b, 0x7c, 0x19, 0x79, 0xb1, 0x33, 0x0a, 0xc6, 0xf1, 0x3f, 0xb6,
+	0xd3, 0x97, 0xd7, 0x19, 0x53, 0xac, 0x44, 0x83, 0x4a, 0xd3, 0x70, 0xcf, 0x36, 0xcd, 0x97, 0x83,
+	0x2f, 0x07, 0x60, 0x1f, 0x43, 0x6e, 0xa0, 0xb3, 0xd8, 0x66, 0x1b, 0x34, 0xed, 0x4d, 0xda, 0x8e,
+	0x3c, 0x40, 0x7f, 0x2b, 0x5a, 0x6b, 0xcc, 0xd3, 0x8a, 0x99, 0x75, 0xe4, 0x5a, 0x45, 0xef, 0x60,
+	0x3e, 0x63, 0x66, 0x4d, 0xee, 0xe1, 0xea, 0x44, 0xe8, 0x59, 0x61, 0x78, 0x2c, 0xeb, 0x83, 0xd7,
+	0x3c, 0x98, 0x6f, 0x77, 0x4d, 0x39, 0xfc, 0x84, 0xf0, 0x08, 0x95, 0x44, 0x70, 0xf9, 0x81, 0x4a,
+	0x73, 0x29, 0x2c, 0xcd, 0x05, 0xdd, 0xb5, 0x84, 0x80, 0x6f, 0x78, 0x89, 0x16, 0x21, 0xa4, 0xb6,
+	0x6e, 0xd0, 0x4b, 0x2c, 0xa5, 0xaa, 0x6d, 0x9e, 0x4f, 0xdb, 0x8e, 0xc4, 0x10, 0x54, 0x4c, 0xb1,
+	0xa2, 0xc0, 0x82, 0xeb, 0xd2, 0x06, 0x86, 0xf4, 0x70, 0xf4, 0xec, 0xbf, 0xbb, 0xd5, 0x62, 0xd1,
+	0xb1, 0xbf, 0xfc, 0xf8, 0x33, 0x00, 0xa3, 0xad, 0xe1, 0x9e, 0x1f, 0x02, 0x00, 0x00,
 }
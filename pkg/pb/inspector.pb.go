@@ -1476,6 +1476,248 @@ func (m *ObjectHealthResponse) GetRedundancy() *RedundancyScheme {
 	return nil
 }
 
+type EarningsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EarningsRequest) Reset()         { *m = EarningsRequest{} }
+func (m *EarningsRequest) String() string { return proto.CompactTextString(m) }
+func (*EarningsRequest) ProtoMessage()    {}
+func (*EarningsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{31}
+}
+func (m *EarningsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EarningsRequest.Unmarshal(m, b)
+}
+func (m *EarningsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EarningsRequest.Marshal(b, m, deterministic)
+}
+func (m *EarningsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EarningsRequest.Merge(m, src)
+}
+func (m *EarningsRequest) XXX_Size() int {
+	return xxx_messageInfo_EarningsRequest.Size(m)
+}
+func (m *EarningsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EarningsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EarningsRequest proto.InternalMessageInfo
+
+// amounts are in cents
+type EarningsResponse struct {
+	Months               []*MonthlyEarnings `protobuf:"bytes,1,rep,name=months,proto3" json:"months,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *EarningsResponse) Reset()         { *m = EarningsResponse{} }
+func (m *EarningsResponse) String() string { return proto.CompactTextString(m) }
+func (*EarningsResponse) ProtoMessage()    {}
+func (*EarningsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{32}
+}
+func (m *EarningsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EarningsResponse.Unmarshal(m, b)
+}
+func (m *EarningsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EarningsResponse.Marshal(b, m, deterministic)
+}
+func (m *EarningsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EarningsResponse.Merge(m, src)
+}
+func (m *EarningsResponse) XXX_Size() int {
+	return xxx_messageInfo_EarningsResponse.Size(m)
+}
+func (m *EarningsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EarningsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EarningsResponse proto.InternalMessageInfo
+
+func (m *EarningsResponse) GetMonths() []*MonthlyEarnings {
+	if m != nil {
+		return m.Months
+	}
+	return nil
+}
+
+type MonthlyEarnings struct {
+	Month                time.Time            `protobuf:"bytes,1,opt,name=month,proto3,stdtime" json:"month"`
+	Satellites           []*SatelliteEarnings `protobuf:"bytes,2,rep,name=satellites,proto3" json:"satellites,omitempty"`
+	Gross                int64                `protobuf:"varint,3,opt,name=gross,proto3" json:"gross,omitempty"`
+	Held                 int64                `protobuf:"varint,4,opt,name=held,proto3" json:"held,omitempty"`
+	Net                  int64                `protobuf:"varint,5,opt,name=net,proto3" json:"net,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *MonthlyEarnings) Reset()         { *m = MonthlyEarnings{} }
+func (m *MonthlyEarnings) String() string { return proto.CompactTextString(m) }
+func (*MonthlyEarnings) ProtoMessage()    {}
+func (*MonthlyEarnings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{33}
+}
+func (m *MonthlyEarnings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MonthlyEarnings.Unmarshal(m, b)
+}
+func (m *MonthlyEarnings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MonthlyEarnings.Marshal(b, m, deterministic)
+}
+func (m *MonthlyEarnings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonthlyEarnings.Merge(m, src)
+}
+func (m *MonthlyEarnings) XXX_Size() int {
+	return xxx_messageInfo_MonthlyEarnings.Size(m)
+}
+func (m *MonthlyEarnings) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonthlyEarnings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonthlyEarnings proto.InternalMessageInfo
+
+func (m *MonthlyEarnings) GetMonth() time.Time {
+	if m != nil {
+		return m.Month
+	}
+	return time.Time{}
+}
+
+func (m *MonthlyEarnings) GetSatellites() []*SatelliteEarnings {
+	if m != nil {
+		return m.Satellites
+	}
+	return nil
+}
+
+func (m *MonthlyEarnings) GetGross() int64 {
+	if m != nil {
+		return m.Gross
+	}
+	return 0
+}
+
+func (m *MonthlyEarnings) GetHeld() int64 {
+	if m != nil {
+		return m.Held
+	}
+	return 0
+}
+
+func (m *MonthlyEarnings) GetNet() int64 {
+	if m != nil {
+		return m.Net
+	}
+	return 0
+}
+
+type SatelliteEarnings struct {
+	SatelliteId          NodeID   `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	NodeAge              int64    `protobuf:"varint,2,opt,name=node_age,json=nodeAge,proto3" json:"node_age,omitempty"`
+	Egress               int64    `protobuf:"varint,3,opt,name=egress,proto3" json:"egress,omitempty"`
+	RepairEgress         int64    `protobuf:"varint,4,opt,name=repair_egress,json=repairEgress,proto3" json:"repair_egress,omitempty"`
+	AuditEgress          int64    `protobuf:"varint,5,opt,name=audit_egress,json=auditEgress,proto3" json:"audit_egress,omitempty"`
+	DiskByteHours        float64  `protobuf:"fixed64,6,opt,name=disk_byte_hours,json=diskByteHours,proto3" json:"disk_byte_hours,omitempty"`
+	Gross                int64    `protobuf:"varint,7,opt,name=gross,proto3" json:"gross,omitempty"`
+	HeldPercent          int64    `protobuf:"varint,8,opt,name=held_percent,json=heldPercent,proto3" json:"held_percent,omitempty"`
+	Held                 int64    `protobuf:"varint,9,opt,name=held,proto3" json:"held,omitempty"`
+	Net                  int64    `protobuf:"varint,10,opt,name=net,proto3" json:"net,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SatelliteEarnings) Reset()         { *m = SatelliteEarnings{} }
+func (m *SatelliteEarnings) String() string { return proto.CompactTextString(m) }
+func (*SatelliteEarnings) ProtoMessage()    {}
+func (*SatelliteEarnings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a07d9034b2dd9d26, []int{34}
+}
+func (m *SatelliteEarnings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SatelliteEarnings.Unmarshal(m, b)
+}
+func (m *SatelliteEarnings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SatelliteEarnings.Marshal(b, m, deterministic)
+}
+func (m *SatelliteEarnings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SatelliteEarnings.Merge(m, src)
+}
+func (m *SatelliteEarnings) XXX_Size() int {
+	return xxx_messageInfo_SatelliteEarnings.Size(m)
+}
+func (m *SatelliteEarnings) XXX_DiscardUnknown() {
+	xxx_messageInfo_SatelliteEarnings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SatelliteEarnings proto.InternalMessageInfo
+
+func (m *SatelliteEarnings) GetNodeAge() int64 {
+	if m != nil {
+		return m.NodeAge
+	}
+	return 0
+}
+
+func (m *SatelliteEarnings) GetEgress() int64 {
+	if m != nil {
+		return m.Egress
+	}
+	return 0
+}
+
+func (m *SatelliteEarnings) GetRepairEgress() int64 {
+	if m != nil {
+		return m.RepairEgress
+	}
+	return 0
+}
+
+func (m *SatelliteEarnings) GetAuditEgress() int64 {
+	if m != nil {
+		return m.AuditEgress
+	}
+	return 0
+}
+
+func (m *SatelliteEarnings) GetDiskByteHours() float64 {
+	if m != nil {
+		return m.DiskByteHours
+	}
+	return 0
+}
+
+func (m *SatelliteEarnings) GetGross() int64 {
+	if m != nil {
+		return m.Gross
+	}
+	return 0
+}
+
+func (m *SatelliteEarnings) GetHeldPercent() int64 {
+	if m != nil {
+		return m.HeldPercent
+	}
+	return 0
+}
+
+func (m *SatelliteEarnings) GetHeld() int64 {
+	if m != nil {
+		return m.Held
+	}
+	return 0
+}
+
+func (m *SatelliteEarnings) GetNet() int64 {
+	if m != nil {
+		return m.Net
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ListIrreparableSegmentsRequest)(nil), "inspector.ListIrreparableSegmentsRequest")
 	proto.RegisterType((*IrreparableSegment)(nil), "inspector.IrreparableSegment")
@@ -1509,118 +1751,136 @@ func init() {
 	proto.RegisterType((*SegmentHealthResponse)(nil), "inspector.SegmentHealthResponse")
 	proto.RegisterType((*ObjectHealthRequest)(nil), "inspector.ObjectHealthRequest")
 	proto.RegisterType((*ObjectHealthResponse)(nil), "inspector.ObjectHealthResponse")
+	proto.RegisterType((*EarningsRequest)(nil), "inspector.EarningsRequest")
+	proto.RegisterType((*EarningsResponse)(nil), "inspector.EarningsResponse")
+	proto.RegisterType((*MonthlyEarnings)(nil), "inspector.MonthlyEarnings")
+	proto.RegisterType((*SatelliteEarnings)(nil), "inspector.SatelliteEarnings")
 }
 
 func init() { proto.RegisterFile("inspector.proto", fileDescriptor_a07d9034b2dd9d26) }
 
 var fileDescriptor_a07d9034b2dd9d26 = []byte{
-	// 1682 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x49, 0x73, 0x1b, 0xc5,
-	0x17, 0xf7, 0x68, 0xb3, 0xf4, 0x24, 0x6b, 0x69, 0x3b, 0x89, 0xfe, 0x4a, 0x62, 0xf9, 0x3f, 0x2c,
-	0x71, 0x62, 0x90, 0x83, 0x12, 0x0e, 0x29, 0x8a, 0x83, 0x65, 0x67, 0x51, 0x25, 0x24, 0xce, 0x38,
-	0x70, 0xa0, 0x52, 0x4c, 0xb5, 0x34, 0x6d, 0x69, 0xb0, 0x34, 0x3d, 0x99, 0x69, 0x85, 0xe8, 0x0b,
-	0x50, 0x70, 0x22, 0x17, 0x0e, 0x9c, 0x29, 0xbe, 0x01, 0x27, 0xae, 0x5c, 0xf8, 0x0c, 0x1c, 0xc2,
-	0x0d, 0xee, 0xdc, 0xb8, 0x51, 0xbd, 0xcc, 0xa6, 0x05, 0x9b, 0x02, 0x6e, 0x9a, 0xf7, 0x7e, 0xef,
-	0xf5, 0xaf, 0x5f, 0x2f, 0xef, 0xd7, 0x82, 0x8a, 0xed, 0xf8, 0x2e, 0xe9, 0x33, 0xea, 0xb5, 0x5c,
-	0x8f, 0x32, 0x8a, 0x0a, 0xa1, 0xa1, 0x01, 0x03, 0x3a, 0xa0, 0xd2, 0xdc, 0x00, 0x87, 0x5a, 0x44,
-	0xfd, 0xae, 0xb8, 0xd4, 0x76, 0x18, 0xf1, 0xac, 0x9e, 0x32, 0x6c, 0x0e, 0x28, 0x1d, 0x8c, 0xc8,
-	0xae, 0xf8, 0xea, 0x4d, 0x8e, 0x77, 0xad, 0x89, 0x87, 0x99, 0x4d, 0x1d, 0xe5, 0x6f, 0xce, 0xfa,
-	0x99, 0x3d, 0x26, 0x3e, 0xc3, 0x63, 0x57, 0x02, 0xf4, 0x13, 0xd8, 0x7c, 0x60, 0xfb, 0xac, 0xeb,
-	0x79, 0xc4, 0xc5, 0x1e, 0xee, 0x8d, 0xc8, 0x11, 0x19, 0x8c, 0x89, 0xc3, 0x7c, 0x83, 0x3c, 0x9b,
-	0x10, 0x9f, 0xa1, 0x0d, 0xc8, 0x8e, 0xec, 0xb1, 0xcd, 0xea, 0xda, 0x96, 0xb6, 0x9d, 0x35, 0xe4,
-	0x07, 0xba, 0x01, 0xe7, 0x47, 0xd8, 0x67, 0xa6, 0x4f, 0x88, 0x63, 0xfa, 0x32, 0xc4, 0x74, 0x31,
-	0x1b, 0xd6, 0x53, 0x5b, 0xda, 0x76, 0xc9, 0x58, 0xe7, 0xde, 0x23, 0x42, 0x1c, 0x95, 0xee, 0x10,
-	0xb3, 0xa1, 0xfe, 0xab, 0x06, 0x68, 0x7e, 0x24, 0x84, 0x20, 0x23, 0x22, 0x35, 0x11, 0x29, 0x7e,
-	0xa3, 0x5b, 0x50, 0x0e, 0xb2, 0x5a, 0x84, 0x61, 0x7b, 0x24, 0xf2, 0x16, 0xdb, 0xa8, 0x15, 0x95,
-	0xe0, 0x50, 0xfe, 0x32, 0xd6, 0x14, 0xf2, 0x40, 0x00, 0x51, 0x13, 0x8a, 0x23, 0xea, 0x33, 0xd3,
-	0xb5, 0x49, 0x9f, 0xf8, 0xf5, 0xb4, 0xa0, 0x0d, 0xdc, 0x74, 0x28, 0x2c, 0xa8, 0x05, 0x82, 0x9d,
-	0xc9, 0x89, 0xd8, 0x9e, 0x89, 0x19, 0x23, 0x63, 0x97, 0xd5, 0x33, 0x5b, 0xda, 0x76, 0xda, 0xa8,
-	0x71, 0x97, 0x21, 0x3c, 0x7b, 0xd2, 0x81, 0xae, 0xc3, 0x46, 0x12, 0x6a, 0xf6, 0xe9, 0xc4, 0x61,
-	0xf5, 0xac, 0x08, 0x40, 0x5e, 0x1c, 0xbc, 0xcf, 0x3d, 0xfa, 0x53, 0x68, 0x2e, 0xad, 0xaa, 0xef,
-	0x52, 0xc7, 0x27, 0xe8, 0x16, 0xe4, 0x15, 0x6d, 0xbf, 0xae, 0x6d, 0xa5, 0xb7, 0x8b, 0xed, 0xcb,
-	0xad, 0x68, 0x47, 0xcc, 0x47, 0x1a, 0x21, 0x5c, 0xbf, 0x06, 0x48, 0x0c, 0xf3, 0x90, 0x5a, 0x24,
-	0x4a, 0xb8, 0x01, 0x59, 0x49, 0x4b, 0x13, 0xb4, 0xe4, 0x87, 0xbe, 0x0e, 0xb5, 0x38, 0x56, 0x2c,
-	0xa9, 0x7e, 0x1e, 0x36, 0xee, 0x12, 0xd6, 0x99, 0xf4, 0x4f, 0x08, 0xe3, 0x3c, 0x03, 0xfb, 0xef,
-	0x1a, 0x9c, 0x9b, 0x71, 0xa8, 0xe4, 0x7b, 0xb0, 0xda, 0x13, 0xd6, 0x80, 0xec, 0x95, 0x18, 0xd9,
-	0x85, 0x21, 0x2d, 0x69, 0x32, 0x82, 0xb8, 0xc6, 0xd7, 0x1a, 0xe4, 0xa4, 0x0d, 0xed, 0x40, 0x41,
-	0x5a, 0x4d, 0xdb, 0x92, 0xab, 0xde, 0x29, 0xff, 0xf4, 0xaa, 0xb9, 0xf2, 0xf3, 0xab, 0x66, 0x8e,
-	0x13, 0xed, 0x1e, 0x18, 0x79, 0x09, 0xe8, 0x5a, 0x68, 0x17, 0xd6, 0x3c, 0x3a, 0x61, 0xb6, 0x33,
-	0x30, 0xf9, 0x49, 0xf0, 0xeb, 0x29, 0x41, 0x00, 0x5a, 0xe2, 0x5c, 0x70, 0xb8, 0x51, 0x52, 0x00,
-	0x31, 0x49, 0xf4, 0x36, 0x94, 0xfa, 0xb8, 0x3f, 0x24, 0x96, 0xc2, 0xa7, 0xe7, 0xf0, 0x45, 0xe9,
-	0x17, 0x70, 0x5e, 0xa1, 0x70, 0x02, 0x61, 0x85, 0xee, 0x01, 0x8a, 0x1b, 0xa3, 0x12, 0x33, 0xca,
-	0xf0, 0x28, 0x28, 0xb1, 0xf8, 0x40, 0x97, 0x20, 0x6d, 0x5b, 0x92, 0x56, 0xa9, 0x03, 0xb1, 0x39,
-	0x70, 0xb3, 0xde, 0x86, 0x6a, 0x98, 0x29, 0x38, 0x52, 0x9b, 0x90, 0x5a, 0x3a, 0xf1, 0x94, 0x6d,
-	0xe9, 0x1f, 0xc6, 0x28, 0x85, 0x83, 0x9f, 0x12, 0x84, 0xb6, 0x20, 0xbb, 0xac, 0x3e, 0xd2, 0xa1,
-	0xb7, 0x00, 0xa2, 0x75, 0x8a, 0xf0, 0xda, 0x32, 0xfc, 0x7d, 0xa8, 0x1c, 0xaa, 0xaa, 0x9e, 0x91,
-	0x39, 0xaa, 0xc3, 0x2a, 0xb6, 0x2c, 0x8f, 0xf8, 0xbe, 0x38, 0xaf, 0x05, 0x23, 0xf8, 0xd4, 0x75,
-	0xa8, 0x46, 0xc9, 0xd4, 0x94, 0xca, 0x90, 0xa2, 0x27, 0x22, 0x5b, 0xde, 0x48, 0xd1, 0x13, 0xfd,
-	0x7d, 0xa8, 0x3d, 0xa0, 0xf4, 0x64, 0xe2, 0xc6, 0x87, 0x2c, 0x87, 0x43, 0x16, 0x4e, 0x19, 0xe2,
-	0x29, 0xa0, 0x78, 0x78, 0x58, 0xb7, 0x0c, 0x9f, 0x8e, 0xc8, 0x90, 0x9c, 0xa6, 0xb0, 0xa3, 0x37,
-	0x21, 0x33, 0x26, 0x0c, 0x87, 0xf7, 0x4b, 0xe8, 0xff, 0x80, 0x30, 0x6c, 0x61, 0x86, 0x0d, 0xe1,
-	0xd7, 0x3f, 0x81, 0x8a, 0x98, 0xa8, 0x73, 0x4c, 0xcf, 0x5a, 0x8d, 0x9d, 0x24, 0xd5, 0x62, 0xbb,
-	0x16, 0x65, 0xdf, 0x93, 0x8e, 0x88, 0xfd, 0x8f, 0x1a, 0x54, 0xa3, 0x01, 0x14, 0x79, 0x1d, 0x32,
-	0x6c, 0xea, 0x4a, 0xf2, 0xe5, 0x76, 0x39, 0x0a, 0x7f, 0x32, 0x75, 0x89, 0x21, 0x7c, 0xa8, 0x05,
-	0x79, 0xea, 0x12, 0x0f, 0x33, 0xea, 0xcd, 0x4f, 0xe2, 0x91, 0xf2, 0x18, 0x21, 0x86, 0xe3, 0xfb,
-	0xd8, 0xc5, 0x7d, 0x9b, 0x4d, 0xc5, 0xe5, 0x98, 0xc0, 0xef, 0x2b, 0x8f, 0x11, 0x62, 0xf8, 0x2c,
-	0x9e, 0x13, 0xcf, 0xb7, 0xa9, 0x23, 0xae, 0xc8, 0xc4, 0x2c, 0x3e, 0x92, 0x0e, 0x23, 0x40, 0xe8,
-	0x63, 0xa8, 0xdc, 0xb1, 0x1d, 0xeb, 0x21, 0xc1, 0xde, 0x59, 0xab, 0xf4, 0x3a, 0x64, 0x7d, 0x86,
-	0x3d, 0x26, 0x3b, 0xc7, 0x1c, 0x44, 0x3a, 0xa3, 0x36, 0x94, 0x96, 0x67, 0x4f, 0x7c, 0xe8, 0x37,
-	0xa1, 0x1a, 0x0d, 0xa7, 0x6a, 0x76, 0xfa, 0x41, 0x40, 0x50, 0x3d, 0x98, 0x8c, 0xdd, 0xc4, 0x9d,
-	0xf8, 0x2e, 0xd4, 0x62, 0xb6, 0xd9, 0x54, 0x4b, 0xcf, 0x48, 0x19, 0x4a, 0x47, 0x0c, 0x47, 0x17,
-	0xc7, 0x1f, 0x1a, 0xac, 0x73, 0xc3, 0xd1, 0x64, 0x3c, 0xc6, 0xde, 0x34, 0xcc, 0x74, 0x19, 0x60,
-	0xe2, 0x13, 0xcb, 0xf4, 0x5d, 0xdc, 0x27, 0xea, 0xfe, 0x28, 0x70, 0xcb, 0x11, 0x37, 0xa0, 0x2b,
-	0x50, 0xc1, 0xcf, 0xb1, 0x3d, 0xe2, 0x17, 0xbe, 0xc2, 0xa4, 0x04, 0xa6, 0x1c, 0x9a, 0x25, 0xf0,
-	0xff, 0x50, 0x12, 0x79, 0x6c, 0x67, 0x20, 0xf6, 0x95, 0xac, 0x46, 0x91, 0xdb, 0xba, 0xd2, 0xc4,
-	0xfb, 0x9f, 0x80, 0x10, 0x89, 0x90, 0x6d, 0x4d, 0x8c, 0x7e, 0x5b, 0x02, 0xde, 0x80, 0xb2, 0x00,
-	0xf4, 0xb0, 0x63, 0x7d, 0x66, 0x5b, 0x6c, 0xa8, 0x3a, 0xd9, 0x1a, 0xb7, 0x76, 0x02, 0x23, 0xda,
-	0x85, 0xf5, 0x88, 0x53, 0x84, 0xcd, 0xc9, 0xae, 0x17, 0xba, 0xc2, 0x00, 0x51, 0x56, 0xec, 0x0f,
-	0x7b, 0x14, 0x7b, 0x56, 0x50, 0x8f, 0x97, 0x19, 0xa8, 0xc5, 0x8c, 0xaa, 0x1a, 0x57, 0x60, 0x95,
-	0x97, 0x6f, 0xf9, 0xf5, 0x9f, 0xe3, 0xee, 0xae, 0x85, 0xae, 0x42, 0x55, 0x00, 0xfb, 0xd4, 0x71,
-	0x48, 0x9f, 0x0b, 0x1b, 0x5f, 0x15, 0xa6, 0xc2, 0xed, 0xfb, 0x91, 0x19, 0xed, 0x40, 0xad, 0x47,
-	0x29, 0xf3, 0x99, 0x87, 0x5d, 0x33, 0x38, 0x76, 0x69, 0x71, 0x43, 0x54, 0x43, 0x87, 0x3a, 0x75,
-	0x3c, 0xaf, 0xd0, 0x0e, 0x0e, 0x1e, 0x85, 0xd8, 0x8c, 0xc0, 0x56, 0x02, 0x7b, 0x0c, 0x4a, 0x5e,
-	0xcc, 0x40, 0xb3, 0x12, 0x1a, 0xd8, 0x03, 0xe8, 0x0e, 0xd4, 0xac, 0x60, 0xae, 0x21, 0x36, 0x27,
-	0x29, 0x84, 0x8e, 0x00, 0x7c, 0x53, 0x6c, 0x7b, 0xe6, 0xd7, 0x57, 0xc5, 0xa1, 0xda, 0x8c, 0x35,
-	0xd4, 0x05, 0x1b, 0xc8, 0x90, 0x60, 0xf4, 0x0e, 0xe4, 0x26, 0x2e, 0x17, 0x71, 0xf5, 0xbc, 0x08,
-	0xfb, 0x5f, 0x4b, 0x2a, 0xbc, 0x56, 0xa0, 0xf0, 0x5a, 0x07, 0x4a, 0x01, 0x1a, 0x0a, 0x88, 0x6e,
-	0x43, 0x51, 0xc8, 0x1d, 0xd7, 0x76, 0x06, 0xc4, 0xaa, 0x17, 0x44, 0x5c, 0x63, 0x2e, 0xee, 0x49,
-	0xa0, 0x0c, 0x3b, 0x79, 0xbe, 0x18, 0x2f, 0x7f, 0x69, 0x6a, 0x06, 0xf0, 0xc0, 0x43, 0x11, 0x87,
-	0xee, 0x42, 0x49, 0xa4, 0x79, 0x36, 0x21, 0x9e, 0x4d, 0xac, 0x3a, 0xfc, 0x8d, 0x3c, 0x82, 0xc0,
-	0x63, 0x19, 0xa8, 0x7f, 0xa3, 0xc1, 0x86, 0x12, 0x35, 0xf7, 0x08, 0x1e, 0xb1, 0x61, 0x70, 0x51,
-	0x9c, 0x87, 0x9c, 0xec, 0xfa, 0x4a, 0x09, 0xaa, 0x2f, 0xbe, 0x5f, 0x89, 0xd3, 0xf7, 0xa6, 0x2e,
-	0x23, 0x56, 0x5c, 0x63, 0xae, 0x85, 0x56, 0xae, 0x2e, 0xd1, 0x6b, 0x10, 0x08, 0x41, 0xd3, 0x76,
-	0x2c, 0xf2, 0x42, 0x9d, 0x8d, 0x92, 0x32, 0x76, 0xb9, 0x8d, 0x9f, 0x43, 0xd7, 0xa3, 0x9f, 0x92,
-	0xbe, 0xd0, 0x1e, 0x19, 0x91, 0xa7, 0xa0, 0x2c, 0x5d, 0x4b, 0xff, 0x5e, 0x83, 0xb5, 0x04, 0x37,
-	0xb4, 0x03, 0xc5, 0xa1, 0xf8, 0x35, 0x35, 0x79, 0x97, 0xd7, 0xe6, 0xba, 0x3c, 0x28, 0x77, 0xd7,
-	0xf2, 0xb9, 0x56, 0x99, 0x38, 0x71, 0xf8, 0xbc, 0x28, 0x28, 0x85, 0x00, 0x1e, 0xb0, 0x03, 0x45,
-	0x7a, 0x7c, 0x3c, 0xb2, 0x1d, 0x22, 0xe0, 0xe9, 0xf9, 0xec, 0xca, 0xcd, 0xc1, 0x75, 0x58, 0x55,
-	0x73, 0x51, 0xc4, 0x83, 0x4f, 0xfd, 0x73, 0x0d, 0xce, 0xcd, 0x94, 0x54, 0x9d, 0xb4, 0xeb, 0x90,
-	0x93, 0xc3, 0xa9, 0xfe, 0x57, 0x8f, 0x6f, 0xb3, 0x44, 0x84, 0xc2, 0xa1, 0xf7, 0x00, 0x3c, 0x62,
-	0x4d, 0x1c, 0x0b, 0x3b, 0xfd, 0xa9, 0x6a, 0x28, 0x17, 0x63, 0xaa, 0xdb, 0x08, 0x9d, 0x47, 0xfd,
-	0x21, 0x19, 0x13, 0x23, 0x06, 0xd7, 0x7f, 0xd3, 0x60, 0xfd, 0x51, 0x8f, 0x17, 0x33, 0xb9, 0xb4,
-	0xf3, 0x4b, 0xa8, 0x2d, 0x5a, 0xc2, 0x68, 0x07, 0xa4, 0x12, 0x3b, 0x20, 0xb9, 0x6a, 0xe9, 0x99,
-	0x55, 0xe3, 0x82, 0x5e, 0x34, 0x09, 0x13, 0x1f, 0x33, 0xe2, 0x99, 0xf1, 0x22, 0xa5, 0x8d, 0x9a,
-	0x70, 0xed, 0x71, 0x4f, 0xf0, 0xe0, 0x78, 0x0b, 0x10, 0x71, 0x2c, 0xb3, 0x47, 0x8e, 0xa9, 0x47,
-	0x42, 0xb8, 0xbc, 0x04, 0xab, 0xc4, 0xb1, 0x3a, 0xc2, 0x11, 0xa0, 0xc3, 0xce, 0x93, 0x8b, 0x3d,
-	0x80, 0xf4, 0x2f, 0x35, 0xd8, 0x48, 0xce, 0x54, 0x55, 0xfc, 0xe6, 0x9c, 0xb0, 0x5f, 0x5e, 0xf3,
-	0x10, 0xf9, 0x8f, 0xaa, 0xde, 0xfe, 0x2a, 0x03, 0xa5, 0xfb, 0xd8, 0xea, 0x06, 0xa3, 0xa0, 0x2e,
-	0x40, 0xa4, 0xfa, 0xd1, 0xa5, 0xd8, 0xf8, 0x73, 0x8f, 0x81, 0xc6, 0xe5, 0x25, 0x5e, 0x35, 0x9d,
-	0x7d, 0xc8, 0x07, 0xba, 0x0d, 0x35, 0x62, 0xd0, 0x19, 0x65, 0xd8, 0xb8, 0xb8, 0xd0, 0xa7, 0x92,
-	0x74, 0x01, 0x22, 0x65, 0x96, 0xe0, 0x33, 0xa7, 0xf7, 0x12, 0x7c, 0x16, 0xc8, 0xb9, 0x7d, 0xc8,
-	0x07, 0x2a, 0x29, 0xc1, 0x67, 0x46, 0x9b, 0x25, 0xf8, 0xcc, 0xc9, 0xaa, 0x7d, 0xc8, 0x07, 0xb2,
-	0x21, 0x91, 0x64, 0x46, 0xba, 0x24, 0x92, 0xcc, 0xe9, 0x8c, 0x3b, 0x50, 0x08, 0x15, 0x03, 0x8a,
-	0x23, 0x67, 0xb5, 0x45, 0xe3, 0xd2, 0x62, 0xa7, 0xca, 0x63, 0xc0, 0x5a, 0xe2, 0x05, 0x85, 0x9a,
-	0xcb, 0xdf, 0x56, 0x32, 0xdf, 0xd6, 0x69, 0x8f, 0xaf, 0xf6, 0x77, 0x1a, 0x54, 0x1f, 0x3d, 0x27,
-	0xde, 0x08, 0x4f, 0xff, 0x93, 0x5d, 0xf1, 0x2f, 0xcd, 0xbd, 0xfd, 0xad, 0x06, 0xeb, 0xe2, 0x55,
-	0x7e, 0xc4, 0xa8, 0x47, 0x22, 0xaa, 0x1d, 0xc8, 0x0a, 0x59, 0x85, 0x2e, 0xcc, 0xb4, 0xc5, 0x30,
-	0xef, 0x29, 0xfd, 0x52, 0x5f, 0x41, 0xf7, 0xa0, 0x10, 0x2a, 0x8f, 0x24, 0xc7, 0x19, 0x91, 0x92,
-	0xe4, 0x38, 0x2b, 0x56, 0xf4, 0x95, 0xf6, 0x17, 0x1a, 0x6c, 0xc4, 0x5e, 0xe4, 0x11, 0x4d, 0x17,
-	0x2e, 0x2c, 0x79, 0xe7, 0xa3, 0xab, 0xf1, 0x6d, 0xfc, 0x97, 0xff, 0xb0, 0x34, 0xae, 0x9d, 0x05,
-	0xaa, 0x0a, 0xf6, 0x83, 0x06, 0x15, 0x79, 0x79, 0x44, 0x2c, 0x1e, 0x43, 0x29, 0x7e, 0x13, 0xa1,
-	0x78, 0x69, 0x16, 0x5c, 0xc6, 0x8d, 0xe6, 0x52, 0x7f, 0x58, 0xbb, 0x27, 0xb3, 0x6d, 0xb0, 0xb9,
-	0xf4, 0x0e, 0x5b, 0xb0, 0x27, 0x17, 0xb6, 0x22, 0x7d, 0xa5, 0x93, 0xf9, 0x38, 0xe5, 0xf6, 0x7a,
-	0x39, 0x21, 0x15, 0x6e, 0xfc, 0x19, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xfc, 0x21, 0xfe, 0x01, 0x13,
-	0x00, 0x00,
+	// 1915 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcb, 0x92, 0x1b, 0x49,
+	0x15, 0x75, 0xe9, 0xd5, 0xd2, 0x95, 0x5a, 0x8f, 0xec, 0x1e, 0x4f, 0x8d, 0xda, 0xb6, 0xda, 0x05,
+	0x8c, 0x3d, 0xd3, 0x20, 0x1b, 0x8d, 0x59, 0x4c, 0x00, 0x8b, 0x56, 0xdb, 0x1e, 0x2b, 0xe6, 0xe1,
+	0x9e, 0x6a, 0xc3, 0x82, 0x98, 0xa0, 0x22, 0xa5, 0xcc, 0x96, 0x8a, 0x96, 0x2a, 0x6b, 0xaa, 0x52,
+	0xc6, 0xfa, 0x01, 0x02, 0x56, 0xcc, 0x86, 0x05, 0x1f, 0xc0, 0x1f, 0xb0, 0x20, 0x88, 0x60, 0xc5,
+	0x86, 0x6f, 0x60, 0x61, 0x76, 0xc0, 0x9a, 0x1d, 0x3b, 0x22, 0x1f, 0x95, 0x55, 0xa5, 0x07, 0x6d,
+	0x02, 0xd8, 0x55, 0x9e, 0x73, 0xf2, 0xe6, 0xcd, 0x9b, 0x8f, 0x7b, 0xb3, 0xa0, 0xe5, 0x07, 0x71,
+	0x48, 0x27, 0x9c, 0x45, 0xfd, 0x30, 0x62, 0x9c, 0xa1, 0x9a, 0x01, 0xba, 0x30, 0x65, 0x53, 0xa6,
+	0xe0, 0x2e, 0x04, 0x8c, 0x50, 0xfd, 0xdd, 0x0a, 0x99, 0x1f, 0x70, 0x1a, 0x91, 0xb1, 0x06, 0xee,
+	0x4c, 0x19, 0x9b, 0xce, 0xe9, 0x03, 0xd9, 0x1a, 0x2f, 0x2f, 0x1f, 0x90, 0x65, 0x84, 0xb9, 0xcf,
+	0x02, 0xcd, 0xf7, 0xd6, 0x79, 0xee, 0x2f, 0x68, 0xcc, 0xf1, 0x22, 0x54, 0x02, 0xe7, 0x0a, 0xee,
+	0x7c, 0xe2, 0xc7, 0x7c, 0x14, 0x45, 0x34, 0xc4, 0x11, 0x1e, 0xcf, 0xe9, 0x05, 0x9d, 0x2e, 0x68,
+	0xc0, 0x63, 0x97, 0x7e, 0xb9, 0xa4, 0x31, 0x47, 0x87, 0x50, 0x9e, 0xfb, 0x0b, 0x9f, 0xdb, 0xd6,
+	0xb1, 0x75, 0xbf, 0xec, 0xaa, 0x06, 0xfa, 0x00, 0x6e, 0xce, 0x71, 0xcc, 0xbd, 0x98, 0xd2, 0xc0,
+	0x8b, 0x55, 0x17, 0x2f, 0xc4, 0x7c, 0x66, 0x17, 0x8e, 0xad, 0xfb, 0x0d, 0xf7, 0x40, 0xb0, 0x17,
+	0x94, 0x06, 0xda, 0xdc, 0x39, 0xe6, 0x33, 0xe7, 0xaf, 0x16, 0xa0, 0xcd, 0x91, 0x10, 0x82, 0x92,
+	0xec, 0x69, 0xc9, 0x9e, 0xf2, 0x1b, 0x7d, 0x08, 0xcd, 0xc4, 0x2a, 0xa1, 0x1c, 0xfb, 0x73, 0x69,
+	0xb7, 0x3e, 0x40, 0xfd, 0x34, 0x04, 0xe7, 0xea, 0xcb, 0xdd, 0xd7, 0xca, 0xc7, 0x52, 0x88, 0x7a,
+	0x50, 0x9f, 0xb3, 0x98, 0x7b, 0xa1, 0x4f, 0x27, 0x34, 0xb6, 0x8b, 0xd2, 0x6d, 0x10, 0xd0, 0xb9,
+	0x44, 0x50, 0x1f, 0xa4, 0x77, 0x9e, 0x70, 0xc4, 0x8f, 0x3c, 0xcc, 0x39, 0x5d, 0x84, 0xdc, 0x2e,
+	0x1d, 0x5b, 0xf7, 0x8b, 0x6e, 0x47, 0x50, 0xae, 0x64, 0x4e, 0x15, 0x81, 0x1e, 0xc2, 0x61, 0x5e,
+	0xea, 0x4d, 0xd8, 0x32, 0xe0, 0x76, 0x59, 0x76, 0x40, 0x51, 0x56, 0x7c, 0x26, 0x18, 0xe7, 0x0b,
+	0xe8, 0xed, 0x8c, 0x6a, 0x1c, 0xb2, 0x20, 0xa6, 0xe8, 0x43, 0xa8, 0x6a, 0xb7, 0x63, 0xdb, 0x3a,
+	0x2e, 0xde, 0xaf, 0x0f, 0x6e, 0xf7, 0xd3, 0x1d, 0xb1, 0xd9, 0xd3, 0x35, 0x72, 0xe7, 0x7d, 0x40,
+	0x72, 0x98, 0xcf, 0x18, 0xa1, 0xa9, 0xc1, 0x43, 0x28, 0x2b, 0xb7, 0x2c, 0xe9, 0x96, 0x6a, 0x38,
+	0x07, 0xd0, 0xc9, 0x6a, 0xe5, 0x92, 0x3a, 0x37, 0xe1, 0xf0, 0x23, 0xca, 0x87, 0xcb, 0xc9, 0x15,
+	0xe5, 0xc2, 0xcf, 0x04, 0xff, 0x87, 0x05, 0x6f, 0xad, 0x11, 0xda, 0xf8, 0x29, 0xec, 0x8d, 0x25,
+	0x9a, 0x38, 0x7b, 0x2f, 0xe3, 0xec, 0xd6, 0x2e, 0x7d, 0x05, 0xb9, 0x49, 0xbf, 0xee, 0xaf, 0x2c,
+	0xa8, 0x28, 0x0c, 0x9d, 0x40, 0x4d, 0xa1, 0x9e, 0x4f, 0xd4, 0xaa, 0x0f, 0x9b, 0x7f, 0x7a, 0xdd,
+	0xbb, 0xf1, 0xe7, 0xd7, 0xbd, 0x8a, 0x70, 0x74, 0xf4, 0xd8, 0xad, 0x2a, 0xc1, 0x88, 0xa0, 0x07,
+	0xb0, 0x1f, 0xb1, 0x25, 0xf7, 0x83, 0xa9, 0x27, 0x4e, 0x42, 0x6c, 0x17, 0xa4, 0x03, 0xd0, 0x17,
+	0xad, 0xbe, 0x90, 0xbb, 0x0d, 0x2d, 0x10, 0x8d, 0x18, 0x7d, 0x0b, 0x1a, 0x13, 0x3c, 0x99, 0x51,
+	0xa2, 0xf5, 0xc5, 0x0d, 0x7d, 0x5d, 0xf1, 0x52, 0x2e, 0x22, 0x64, 0x26, 0x60, 0x22, 0xf4, 0x0c,
+	0x50, 0x16, 0x4c, 0x43, 0xcc, 0x19, 0xc7, 0xf3, 0x24, 0xc4, 0xb2, 0x81, 0x6e, 0x41, 0xd1, 0x27,
+	0xca, 0xad, 0xc6, 0x10, 0x32, 0x73, 0x10, 0xb0, 0x33, 0x80, 0xb6, 0xb1, 0x94, 0x1c, 0xa9, 0x3b,
+	0x50, 0xd8, 0x39, 0xf1, 0x82, 0x4f, 0x9c, 0x1f, 0x64, 0x5c, 0x32, 0x83, 0x5f, 0xd3, 0x09, 0x1d,
+	0x43, 0x79, 0x57, 0x7c, 0x14, 0xe1, 0xf4, 0x01, 0xd2, 0x75, 0x4a, 0xf5, 0xd6, 0x2e, 0xfd, 0xc7,
+	0xd0, 0x3a, 0xd7, 0x51, 0x7d, 0x43, 0xcf, 0x91, 0x0d, 0x7b, 0x98, 0x90, 0x88, 0xc6, 0xb1, 0x3c,
+	0xaf, 0x35, 0x37, 0x69, 0x3a, 0x0e, 0xb4, 0x53, 0x63, 0x7a, 0x4a, 0x4d, 0x28, 0xb0, 0x2b, 0x69,
+	0xad, 0xea, 0x16, 0xd8, 0x95, 0xf3, 0x7d, 0xe8, 0x7c, 0xc2, 0xd8, 0xd5, 0x32, 0xcc, 0x0e, 0xd9,
+	0x34, 0x43, 0xd6, 0xae, 0x19, 0xe2, 0x0b, 0x40, 0xd9, 0xee, 0x26, 0x6e, 0x25, 0x31, 0x1d, 0x69,
+	0x21, 0x3f, 0x4d, 0x89, 0xa3, 0x77, 0xa1, 0xb4, 0xa0, 0x1c, 0x9b, 0xfb, 0xc5, 0xf0, 0x9f, 0x52,
+	0x8e, 0x09, 0xe6, 0xd8, 0x95, 0xbc, 0xf3, 0x63, 0x68, 0xc9, 0x89, 0x06, 0x97, 0xec, 0x4d, 0xa3,
+	0x71, 0x92, 0x77, 0xb5, 0x3e, 0xe8, 0xa4, 0xd6, 0x4f, 0x15, 0x91, 0x7a, 0xff, 0x47, 0x0b, 0xda,
+	0xe9, 0x00, 0xda, 0x79, 0x07, 0x4a, 0x7c, 0x15, 0x2a, 0xe7, 0x9b, 0x83, 0x66, 0xda, 0xfd, 0xc5,
+	0x2a, 0xa4, 0xae, 0xe4, 0x50, 0x1f, 0xaa, 0x2c, 0xa4, 0x11, 0xe6, 0x2c, 0xda, 0x9c, 0xc4, 0x73,
+	0xcd, 0xb8, 0x46, 0x23, 0xf4, 0x13, 0x1c, 0xe2, 0x89, 0xcf, 0x57, 0x76, 0x71, 0x5d, 0x7f, 0xa6,
+	0x19, 0xd7, 0x68, 0xc4, 0x2c, 0x5e, 0xd2, 0x28, 0xf6, 0x59, 0x60, 0x97, 0xd6, 0x67, 0xf1, 0x43,
+	0x45, 0xb8, 0x89, 0xc2, 0x59, 0x40, 0xeb, 0xa9, 0x1f, 0x90, 0xcf, 0x28, 0x8e, 0xde, 0x34, 0x4a,
+	0x5f, 0x87, 0x72, 0xcc, 0x71, 0xc4, 0xed, 0xc2, 0x56, 0x89, 0x22, 0xd3, 0x34, 0x54, 0x54, 0x67,
+	0x4f, 0x36, 0x9c, 0x47, 0xd0, 0x4e, 0x87, 0xd3, 0x31, 0xbb, 0xfe, 0x20, 0x20, 0x68, 0x3f, 0x5e,
+	0x2e, 0xc2, 0xdc, 0x9d, 0xf8, 0x1d, 0xe8, 0x64, 0xb0, 0x75, 0x53, 0x3b, 0xcf, 0x48, 0x13, 0x1a,
+	0x17, 0x1c, 0xa7, 0x17, 0xc7, 0x3f, 0x2d, 0x38, 0x10, 0xc0, 0xc5, 0x72, 0xb1, 0xc0, 0xd1, 0xca,
+	0x58, 0xba, 0x0d, 0xb0, 0x8c, 0x29, 0xf1, 0xe2, 0x10, 0x4f, 0xa8, 0xbe, 0x3f, 0x6a, 0x02, 0xb9,
+	0x10, 0x00, 0xba, 0x07, 0x2d, 0xfc, 0x12, 0xfb, 0x73, 0x71, 0xe1, 0x6b, 0x4d, 0x41, 0x6a, 0x9a,
+	0x06, 0x56, 0xc2, 0xbb, 0xd0, 0x90, 0x76, 0xfc, 0x60, 0x2a, 0xf7, 0x95, 0x8a, 0x46, 0x5d, 0x60,
+	0x23, 0x05, 0x89, 0xfc, 0x27, 0x25, 0x54, 0x29, 0x54, 0x5a, 0x93, 0xa3, 0x3f, 0x51, 0x82, 0x6f,
+	0x40, 0x53, 0x0a, 0xc6, 0x38, 0x20, 0x3f, 0xf5, 0x09, 0x9f, 0xe9, 0x4c, 0xb6, 0x2f, 0xd0, 0x61,
+	0x02, 0xa2, 0x07, 0x70, 0x90, 0xfa, 0x94, 0x6a, 0x2b, 0x52, 0x8b, 0x0c, 0x65, 0x3a, 0xc8, 0xb0,
+	0xe2, 0x78, 0x36, 0x66, 0x38, 0x22, 0x49, 0x3c, 0xbe, 0x2a, 0x41, 0x27, 0x03, 0xea, 0x68, 0xdc,
+	0x83, 0x3d, 0x11, 0xbe, 0xdd, 0xd7, 0x7f, 0x45, 0xd0, 0x23, 0x82, 0xde, 0x83, 0xb6, 0x14, 0x4e,
+	0x58, 0x10, 0xd0, 0x89, 0x28, 0x6c, 0x62, 0x1d, 0x98, 0x96, 0xc0, 0xcf, 0x52, 0x18, 0x9d, 0x40,
+	0x67, 0xcc, 0x18, 0x8f, 0x79, 0x84, 0x43, 0x2f, 0x39, 0x76, 0x45, 0x79, 0x43, 0xb4, 0x0d, 0xa1,
+	0x4f, 0x9d, 0xb0, 0x2b, 0x6b, 0x87, 0x00, 0xcf, 0x8d, 0xb6, 0x24, 0xb5, 0xad, 0x04, 0xcf, 0x48,
+	0xe9, 0xab, 0x35, 0x69, 0x59, 0x49, 0xe9, 0xab, 0xbc, 0xf4, 0x04, 0x3a, 0x24, 0x99, 0xab, 0xd1,
+	0x56, 0x94, 0x0b, 0x86, 0x48, 0xc4, 0x8f, 0xe4, 0xb6, 0xe7, 0xb1, 0xbd, 0x27, 0x0f, 0xd5, 0x9d,
+	0x4c, 0x42, 0xdd, 0xb2, 0x81, 0x5c, 0x25, 0x46, 0xdf, 0x86, 0xca, 0x32, 0x14, 0x45, 0x9c, 0x5d,
+	0x95, 0xdd, 0xde, 0xe9, 0xab, 0x0a, 0xaf, 0x9f, 0x54, 0x78, 0xfd, 0xc7, 0xba, 0x02, 0x74, 0xb5,
+	0x10, 0x3d, 0x81, 0xba, 0x2c, 0x77, 0x42, 0x3f, 0x98, 0x52, 0x62, 0xd7, 0x64, 0xbf, 0xee, 0x46,
+	0xbf, 0x17, 0x49, 0x65, 0x38, 0xac, 0x8a, 0xc5, 0xf8, 0xea, 0x2f, 0x3d, 0xcb, 0x05, 0xd1, 0xf1,
+	0x5c, 0xf6, 0x43, 0x1f, 0x41, 0x43, 0x9a, 0xf9, 0x72, 0x49, 0x23, 0x9f, 0x12, 0x1b, 0xfe, 0x03,
+	0x3b, 0xd2, 0x81, 0xcf, 0x55, 0x47, 0xe7, 0xd7, 0x16, 0x1c, 0xea, 0xa2, 0xe6, 0x19, 0xc5, 0x73,
+	0x3e, 0x4b, 0x2e, 0x8a, 0x9b, 0x50, 0x51, 0x59, 0x5f, 0x57, 0x82, 0xba, 0x25, 0xf6, 0x2b, 0x0d,
+	0x26, 0xd1, 0x2a, 0xe4, 0x94, 0x64, 0x6b, 0xcc, 0x7d, 0x83, 0x8a, 0xea, 0x12, 0x7d, 0x0d, 0x92,
+	0x42, 0xd0, 0xf3, 0x03, 0x42, 0x5f, 0xe9, 0xb3, 0xd1, 0xd0, 0xe0, 0x48, 0x60, 0xe2, 0x1c, 0x86,
+	0x11, 0xfb, 0x09, 0x9d, 0xc8, 0xda, 0xa3, 0x24, 0xed, 0xd4, 0x34, 0x32, 0x22, 0xce, 0x6f, 0x2d,
+	0xd8, 0xcf, 0xf9, 0x86, 0x4e, 0xa0, 0x3e, 0x93, 0x5f, 0x2b, 0xcf, 0x27, 0xea, 0x22, 0xc8, 0x67,
+	0x79, 0xd0, 0xf4, 0x88, 0xc4, 0xa2, 0x56, 0x59, 0x06, 0x59, 0xf9, 0x66, 0x51, 0xd0, 0x58, 0x06,
+	0x99, 0x0e, 0x27, 0x50, 0x67, 0x97, 0x97, 0x73, 0x3f, 0xa0, 0x52, 0x5e, 0xdc, 0xb4, 0xae, 0x69,
+	0x21, 0xb6, 0x61, 0x4f, 0xcf, 0x45, 0x3b, 0x9e, 0x34, 0x9d, 0x9f, 0x59, 0xf0, 0xd6, 0x5a, 0x48,
+	0xf5, 0x49, 0x7b, 0x08, 0x15, 0x35, 0x9c, 0xce, 0x7f, 0x76, 0x76, 0x9b, 0xe5, 0x7a, 0x68, 0x1d,
+	0xfa, 0x2e, 0x40, 0x44, 0xc9, 0x32, 0x20, 0x38, 0x98, 0xac, 0x74, 0x42, 0x39, 0xca, 0x54, 0xdd,
+	0xae, 0x21, 0x2f, 0x26, 0x33, 0xba, 0xa0, 0x6e, 0x46, 0xee, 0xfc, 0xcd, 0x82, 0x83, 0xe7, 0x63,
+	0x11, 0xcc, 0xfc, 0xd2, 0x6e, 0x2e, 0xa1, 0xb5, 0x6d, 0x09, 0xd3, 0x1d, 0x50, 0xc8, 0xed, 0x80,
+	0xfc, 0xaa, 0x15, 0xd7, 0x56, 0x4d, 0x14, 0xf4, 0x32, 0x49, 0x78, 0xf8, 0x92, 0xd3, 0xc8, 0xcb,
+	0x06, 0xa9, 0xe8, 0x76, 0x24, 0x75, 0x2a, 0x98, 0xe4, 0xc1, 0xf1, 0x4d, 0x40, 0x34, 0x20, 0xde,
+	0x98, 0x5e, 0xb2, 0x88, 0x1a, 0xb9, 0xba, 0x04, 0xdb, 0x34, 0x20, 0x43, 0x49, 0x24, 0x6a, 0x93,
+	0x79, 0x2a, 0x99, 0x07, 0x90, 0xf3, 0x0b, 0x0b, 0x0e, 0xf3, 0x33, 0xd5, 0x11, 0x7f, 0xb4, 0x51,
+	0xd8, 0xef, 0x8e, 0xb9, 0x51, 0xfe, 0x77, 0x51, 0xef, 0x40, 0xeb, 0x09, 0x8e, 0x02, 0x3f, 0x98,
+	0x9a, 0x3c, 0xf4, 0x14, 0xda, 0x29, 0xa4, 0x3d, 0x1b, 0x40, 0x65, 0xc1, 0x02, 0x3e, 0x4b, 0xfc,
+	0xea, 0x66, 0xfc, 0xfa, 0x54, 0x10, 0xf3, 0x95, 0xe9, 0xa3, 0x95, 0xce, 0x1f, 0x2c, 0x68, 0xad,
+	0x71, 0xe8, 0x21, 0x94, 0x25, 0x6b, 0x5b, 0xd7, 0x5d, 0x01, 0xae, 0x12, 0xa2, 0xef, 0x01, 0xc4,
+	0x98, 0xd3, 0xf9, 0xdc, 0xe7, 0x26, 0x2f, 0xdf, 0xca, 0x46, 0x25, 0x21, 0xcd, 0xf8, 0x19, 0xbd,
+	0x58, 0x80, 0x69, 0xc4, 0x4c, 0xb2, 0x53, 0x0d, 0xf1, 0x6a, 0x9c, 0xd1, 0x39, 0xd1, 0xab, 0x2c,
+	0xbf, 0x51, 0x1b, 0x8a, 0x01, 0x4d, 0x56, 0x52, 0x7c, 0x3a, 0xbf, 0x2b, 0x40, 0x67, 0xc3, 0xba,
+	0xc8, 0xa2, 0xc6, 0xbe, 0x49, 0x42, 0x6e, 0xdd, 0x60, 0x23, 0x82, 0xde, 0x81, 0xaa, 0xcc, 0x3c,
+	0x78, 0x9a, 0xa4, 0x62, 0x99, 0xb2, 0x4e, 0xa7, 0x54, 0xec, 0x52, 0x9a, 0xcd, 0xbe, 0xba, 0x25,
+	0x2e, 0x20, 0xfd, 0x4e, 0xcc, 0xa5, 0xde, 0x86, 0x02, 0x75, 0xf2, 0xbd, 0x0b, 0x0d, 0xbc, 0x24,
+	0x3e, 0x4f, 0x34, 0xca, 0xd7, 0xba, 0xc4, 0xb4, 0xe4, 0x5d, 0x68, 0x11, 0x3f, 0xbe, 0xf2, 0xc6,
+	0x2b, 0x4e, 0xbd, 0x19, 0x5b, 0x46, 0x2a, 0x89, 0x58, 0xee, 0xbe, 0x80, 0x87, 0x2b, 0x4e, 0x9f,
+	0x09, 0x30, 0x8d, 0xcb, 0x5e, 0x36, 0x2e, 0x77, 0xa1, 0x21, 0x62, 0xe1, 0x85, 0x34, 0x9a, 0x88,
+	0x6d, 0x5d, 0x55, 0x03, 0x08, 0xec, 0x5c, 0x41, 0x26, 0x74, 0xb5, 0xcd, 0xd0, 0x81, 0x09, 0xdd,
+	0xe0, 0x97, 0x25, 0x68, 0x7c, 0x8c, 0xc9, 0x28, 0x59, 0x25, 0x34, 0x02, 0x48, 0xdf, 0x92, 0x28,
+	0xbb, 0x7e, 0x1b, 0x4f, 0xcc, 0xee, 0xed, 0x1d, 0xac, 0xde, 0x8a, 0x67, 0x50, 0x4d, 0x5e, 0x03,
+	0x28, 0xbb, 0x0d, 0xd7, 0xde, 0x1b, 0xdd, 0xa3, 0xad, 0x9c, 0x36, 0x32, 0x02, 0x48, 0xeb, 0xfd,
+	0x9c, 0x3f, 0x1b, 0xaf, 0x88, 0xee, 0xed, 0x1d, 0x6c, 0xea, 0x4f, 0x52, 0x7b, 0xe7, 0xfc, 0x59,
+	0xab, 0xf8, 0xbb, 0x47, 0x5b, 0xb9, 0xd4, 0x48, 0x52, 0x8c, 0xe6, 0x8c, 0xac, 0x15, 0xc4, 0xdd,
+	0xa3, 0xad, 0x9c, 0x36, 0xf2, 0x14, 0x6a, 0xa6, 0x0e, 0x45, 0x59, 0xe5, 0x7a, 0xc5, 0xda, 0xbd,
+	0xb5, 0x9d, 0xd4, 0x76, 0x5c, 0xd8, 0xcf, 0xbd, 0xcb, 0x51, 0x6f, 0xf7, 0x8b, 0x5d, 0xd9, 0x3b,
+	0xbe, 0xee, 0x49, 0x3f, 0xf8, 0x8d, 0x05, 0xed, 0xe7, 0x2f, 0x69, 0x34, 0xc7, 0xab, 0xff, 0xcb,
+	0xae, 0xf8, 0x1f, 0xcd, 0x7d, 0xf0, 0x77, 0x0b, 0x0e, 0xe4, 0xbf, 0x9e, 0x0b, 0xce, 0x22, 0x9a,
+	0xba, 0x3a, 0x84, 0xb2, 0x2c, 0xd6, 0xd1, 0xdb, 0x6b, 0xc5, 0x96, 0xb1, 0x7b, 0x4d, 0x15, 0xe6,
+	0xdc, 0x40, 0xcf, 0xa0, 0x66, 0xea, 0xd9, 0xbc, 0x8f, 0x6b, 0xa5, 0x6f, 0xf7, 0xd6, 0x76, 0xd2,
+	0x58, 0x3a, 0x83, 0xaa, 0xb9, 0x90, 0xb2, 0xdb, 0x65, 0xed, 0x2a, 0xef, 0x1e, 0x6d, 0xe5, 0xf4,
+	0x54, 0x7f, 0x6e, 0xc1, 0x61, 0xe6, 0x67, 0x51, 0x3a, 0xd7, 0x10, 0xde, 0xde, 0xf1, 0x0b, 0x0a,
+	0xbd, 0x97, 0x3d, 0x0b, 0xff, 0xf6, 0xe7, 0x5f, 0xf7, 0xfd, 0x37, 0x91, 0x6a, 0x57, 0x7e, 0x6f,
+	0x41, 0x4b, 0xe5, 0xb5, 0xd4, 0x8b, 0xcf, 0xa1, 0x91, 0x4d, 0x92, 0x28, 0x1b, 0xdf, 0x2d, 0x75,
+	0x42, 0xb7, 0xb7, 0x93, 0x37, 0x61, 0x7b, 0xb1, 0x5e, 0xa1, 0xf5, 0x76, 0xa6, 0xd7, 0x2d, 0x1b,
+	0x7b, 0x6b, 0x95, 0xe4, 0xdc, 0x18, 0x96, 0x7e, 0x54, 0x08, 0xc7, 0xe3, 0x8a, 0x4c, 0x61, 0x1f,
+	0xfc, 0x6b, 0x00, 0x36, 0x10, 0xb6, 0x3d, 0x9c, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2016,6 +2276,7 @@ type PieceStoreInspectorClient interface {
 	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatSummaryResponse, error)
 	// Dashboard returns stats for a specific storagenode
 	Dashboard(ctx context.Context, in *DashboardRequest, opts ...grpc.CallOption) (*DashboardResponse, error)
+	Earnings(ctx context.Context, in *EarningsRequest, opts ...grpc.CallOption) (*EarningsResponse, error)
 }
 
 type pieceStoreInspectorClient struct {
@@ -2044,12 +2305,22 @@ func (c *pieceStoreInspectorClient) Dashboard(ctx context.Context, in *Dashboard
 	return out, nil
 }
 
+func (c *pieceStoreInspectorClient) Earnings(ctx context.Context, in *EarningsRequest, opts ...grpc.CallOption) (*EarningsResponse, error) {
+	out := new(EarningsResponse)
+	err := c.cc.Invoke(ctx, "/inspector.PieceStoreInspector/Earnings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PieceStoreInspectorServer is the server API for PieceStoreInspector service.
 type PieceStoreInspectorServer interface {
 	// Stats return space and bandwidth stats for a storagenode
 	Stats(context.Context, *StatsRequest) (*StatSummaryResponse, error)
 	// Dashboard returns stats for a specific storagenode
 	Dashboard(context.Context, *DashboardRequest) (*DashboardResponse, error)
+	Earnings(context.Context, *EarningsRequest) (*EarningsResponse, error)
 }
 
 func RegisterPieceStoreInspectorServer(s *grpc.Server, srv PieceStoreInspectorServer) {
@@ -2092,6 +2363,24 @@ func _PieceStoreInspector_Dashboard_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PieceStoreInspector_Earnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreInspectorServer).Earnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.PieceStoreInspector/Earnings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreInspectorServer).Earnings(ctx, req.(*EarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PieceStoreInspector_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inspector.PieceStoreInspector",
 	HandlerType: (*PieceStoreInspectorServer)(nil),
@@ -2104,6 +2393,10 @@ var _PieceStoreInspector_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Dashboard",
 			Handler:    _PieceStoreInspector_Dashboard_Handler,
 		},
+		{
+			MethodName: "Earnings",
+			Handler:    _PieceStoreInspector_Earnings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspector.proto",
@@ -4804,6 +4804,174 @@ func _BatchResponseItem_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+type RevokeAPIKeyRequest struct {
+	ApiKey               []byte   `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAPIKeyRequest) Reset()         { *m = RevokeAPIKeyRequest{} }
+func (m *RevokeAPIKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeAPIKeyRequest) ProtoMessage()    {}
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{70}
+}
+func (m *RevokeAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Unmarshal(m, b)
+}
+func (m *RevokeAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAPIKeyRequest.Merge(m, src)
+}
+func (m *RevokeAPIKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Size(m)
+}
+func (m *RevokeAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAPIKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAPIKeyRequest proto.InternalMessageInfo
+
+func (m *RevokeAPIKeyRequest) GetApiKey() []byte {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
+type RevokeAPIKeyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAPIKeyResponse) Reset()         { *m = RevokeAPIKeyResponse{} }
+func (m *RevokeAPIKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeAPIKeyResponse) ProtoMessage()    {}
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{71}
+}
+func (m *RevokeAPIKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAPIKeyResponse.Unmarshal(m, b)
+}
+func (m *RevokeAPIKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAPIKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeAPIKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAPIKeyResponse.Merge(m, src)
+}
+func (m *RevokeAPIKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeAPIKeyResponse.Size(m)
+}
+func (m *RevokeAPIKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAPIKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAPIKeyResponse proto.InternalMessageInfo
+
+type SegmentMoveRequestOld struct {
+	Bucket               []byte   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Path                 []byte   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Segment              int64    `protobuf:"varint,3,opt,name=segment,proto3" json:"segment,omitempty"`
+	NewPath              []byte   `protobuf:"bytes,4,opt,name=new_path,json=newPath,proto3" json:"new_path,omitempty"`
+	Metadata             []byte   `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentMoveRequestOld) Reset()         { *m = SegmentMoveRequestOld{} }
+func (m *SegmentMoveRequestOld) String() string { return proto.CompactTextString(m) }
+func (*SegmentMoveRequestOld) ProtoMessage()    {}
+func (*SegmentMoveRequestOld) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{72}
+}
+func (m *SegmentMoveRequestOld) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentMoveRequestOld.Unmarshal(m, b)
+}
+func (m *SegmentMoveRequestOld) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentMoveRequestOld.Marshal(b, m, deterministic)
+}
+func (m *SegmentMoveRequestOld) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentMoveRequestOld.Merge(m, src)
+}
+func (m *SegmentMoveRequestOld) XXX_Size() int {
+	return xxx_messageInfo_SegmentMoveRequestOld.Size(m)
+}
+func (m *SegmentMoveRequestOld) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentMoveRequestOld.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentMoveRequestOld proto.InternalMessageInfo
+
+func (m *SegmentMoveRequestOld) GetBucket() []byte {
+	if m != nil {
+		return m.Bucket
+	}
+	return nil
+}
+
+func (m *SegmentMoveRequestOld) GetPath() []byte {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *SegmentMoveRequestOld) GetSegment() int64 {
+	if m != nil {
+		return m.Segment
+	}
+	return 0
+}
+
+func (m *SegmentMoveRequestOld) GetNewPath() []byte {
+	if m != nil {
+		return m.NewPath
+	}
+	return nil
+}
+
+func (m *SegmentMoveRequestOld) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type SegmentMoveResponseOld struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentMoveResponseOld) Reset()         { *m = SegmentMoveResponseOld{} }
+func (m *SegmentMoveResponseOld) String() string { return proto.CompactTextString(m) }
+func (*SegmentMoveResponseOld) ProtoMessage()    {}
+func (*SegmentMoveResponseOld) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631e2f30a93cd64e, []int{73}
+}
+func (m *SegmentMoveResponseOld) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentMoveResponseOld.Unmarshal(m, b)
+}
+func (m *SegmentMoveResponseOld) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentMoveResponseOld.Marshal(b, m, deterministic)
+}
+func (m *SegmentMoveResponseOld) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentMoveResponseOld.Merge(m, src)
+}
+func (m *SegmentMoveResponseOld) XXX_Size() int {
+	return xxx_messageInfo_SegmentMoveResponseOld.Size(m)
+}
+func (m *SegmentMoveResponseOld) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentMoveResponseOld.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentMoveResponseOld proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("metainfo.Object_Status", Object_Status_name, Object_Status_value)
 	proto.RegisterType((*Bucket)(nil), "metainfo.Bucket")
@@ -4877,238 +5045,248 @@ func init() {
 	proto.RegisterType((*BatchRequestItem)(nil), "metainfo.BatchRequestItem")
 	proto.RegisterType((*BatchResponse)(nil), "metainfo.BatchResponse")
 	proto.RegisterType((*BatchResponseItem)(nil), "metainfo.BatchResponseItem")
+	proto.RegisterType((*RevokeAPIKeyRequest)(nil), "metainfo.RevokeAPIKeyRequest")
+	proto.RegisterType((*RevokeAPIKeyResponse)(nil), "metainfo.RevokeAPIKeyResponse")
+	proto.RegisterType((*SegmentMoveRequestOld)(nil), "metainfo.SegmentMoveRequestOld")
+	proto.RegisterType((*SegmentMoveResponseOld)(nil), "metainfo.SegmentMoveResponseOld")
 }
 
 func init() { proto.RegisterFile("metainfo.proto", fileDescriptor_631e2f30a93cd64e) }
 
 var fileDescriptor_631e2f30a93cd64e = []byte{
-	// 3607 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0xcd, 0x6f, 0x1c, 0xc7,
-	0x95, 0xe7, 0x7c, 0xcf, 0xbc, 0x19, 0x72, 0x86, 0x45, 0x8a, 0x1a, 0x0d, 0x45, 0x51, 0x6a, 0x7d,
-	0x58, 0x06, 0x6c, 0xca, 0xa0, 0x77, 0x17, 0x5e, 0x58, 0x5e, 0x2f, 0xa9, 0xa1, 0xc4, 0xb1, 0x45,
-	0x8a, 0x6e, 0x4a, 0x96, 0x56, 0x6b, 0x67, 0xd0, 0x64, 0x17, 0xc9, 0x8e, 0x66, 0xa6, 0x27, 0xdd,
-	0x3d, 0x0e, 0xe5, 0x53, 0x0e, 0x01, 0x82, 0xc0, 0x39, 0xe4, 0x98, 0x93, 0x2f, 0x41, 0x4e, 0xf9,
-	0x0b, 0x02, 0x04, 0xb9, 0x26, 0x08, 0x82, 0x1c, 0x9c, 0x5b, 0x02, 0x38, 0x39, 0xe5, 0x98, 0x8b,
-	0x6f, 0x01, 0x02, 0x04, 0xf5, 0xd5, 0x5d, 0xdd, 0x5d, 0xdd, 0x33, 0xa4, 0x28, 0x01, 0xbe, 0x75,
-	0xbf, 0x7a, 0xf5, 0xaa, 0xea, 0x7d, 0xfc, 0xea, 0xd5, 0xab, 0x6e, 0x98, 0xe9, 0x63, 0xcf, 0xb0,
-	0x06, 0x07, 0xf6, 0xca, 0xd0, 0xb1, 0x3d, 0x1b, 0x95, 0xc5, 0x7b, 0xab, 0x81, 0x07, 0xfb, 0xce,
-	0xf3, 0xa1, 0x67, 0xd9, 0x03, 0xd6, 0xd6, 0x82, 0x43, 0xfb, 0x90, 0xf3, 0xb5, 0x96, 0x0f, 0x6d,
-	0xfb, 0xb0, 0x87, 0x6f, 0xd1, 0xb7, 0xbd, 0xd1, 0xc1, 0x2d, 0xcf, 0xea, 0x63, 0xd7, 0x33, 0xfa,
-	0x43, 0xc1, 0x3c, 0xb0, 0x4d, 0xcc, 0x9f, 0xeb, 0x43, 0xdb, 0x1a, 0x78, 0xd8, 0x31, 0xf7, 0x38,
-	0xa1, 0x66, 0x3b, 0x26, 0x76, 0x5c, 0xf6, 0xa6, 0xfd, 0x32, 0x07, 0xc5, 0xf5, 0xd1, 0xfe, 0x33,
-	0xec, 0x21, 0x04, 0xf9, 0x81, 0xd1, 0xc7, 0xcd, 0xcc, 0xe5, 0xcc, 0xcd, 0x9a, 0x4e, 0x9f, 0xd1,
-	0x3b, 0x50, 0x1d, 0x1a, 0xde, 0x51, 0x77, 0xdf, 0x1a, 0x1e, 0x61, 0xa7, 0x99, 0xbd, 0x9c, 0xb9,
-	0x39, 0xb3, 0x7a, 0x7e, 0x45, 0x9a, 0xde, 0x1d, 0xda, 0xb2, 0x3b, 0xb2, 0x3c, 0xac, 0x03, 0xe1,
-	0x65, 0x04, 0x74, 0x07, 0x60, 0xdf, 0xc1, 0x86, 0x87, 0xcd, 0xae, 0xe1, 0x35, 0x73, 0x97, 0x33,
-	0x37, 0xab, 0xab, 0xad, 0x15, 0x36, 0xf3, 0x15, 0x31, 0xf3, 0x95, 0x87, 0x62, 0xe6, 0xeb, 0xe5,
-	0xdf, 0x7d, 0xbd, 0x3c, 0xf5, 0xd3, 0xbf, 0x2e, 0x67, 0xf4, 0x0a, 0xef, 0xb7, 0xe6, 0xa1, 0xb7,
-	0x60, 0xde, 0xc4, 0x07, 0xc6, 0xa8, 0xe7, 0x75, 0x5d, 0x7c, 0xd8, 0xc7, 0x03, 0xaf, 0xeb, 0x5a,
-	0x9f, 0xe3, 0x66, 0xfe, 0x72, 0xe6, 0x66, 0x4e, 0x47, 0xbc, 0x6d, 0x97, 0x35, 0xed, 0x5a, 0x9f,
-	0x63, 0xf4, 0x18, 0x2e, 0x88, 0x1e, 0x0e, 0x36, 0x47, 0x03, 0xd3, 0x18, 0xec, 0x3f, 0xef, 0xba,
-	0xfb, 0x47, 0xb8, 0x8f, 0x9b, 0x05, 0x3a, 0x8b, 0xc5, 0x95, 0x40, 0x25, 0xba, 0xcf, 0xb3, 0x4b,
-	0x59, 0xf4, 0xf3, 0xbc, 0x77, 0xb4, 0x01, 0x99, 0xb0, 0x24, 0x04, 0x07, 0xab, 0xef, 0x0e, 0x0d,
-	0xc7, 0xe8, 0x63, 0x0f, 0x3b, 0x6e, 0xb3, 0x48, 0x85, 0x5f, 0x96, 0x75, 0xb3, 0xe1, 0x3f, 0xee,
-	0xf8, 0x7c, 0xfa, 0x22, 0x17, 0xa3, 0x6a, 0x44, 0x4b, 0x00, 0x43, 0xc3, 0xf1, 0x06, 0xd8, 0xe9,
-	0x5a, 0x66, 0xb3, 0x44, 0x2d, 0x51, 0xe1, 0x94, 0x8e, 0xa9, 0x59, 0x30, 0xc3, 0x8c, 0x75, 0xdf,
-	0x72, 0xbd, 0x8e, 0x87, 0xfb, 0x4a, 0xa3, 0x85, 0x55, 0x9f, 0x3d, 0x95, 0xea, 0xb5, 0x6f, 0xb2,
-	0x30, 0xc7, 0xc6, 0xba, 0x43, 0x69, 0x3a, 0xfe, 0xde, 0x08, 0xbb, 0x67, 0xed, 0x25, 0x49, 0x06,
-	0xce, 0x9d, 0xce, 0xc0, 0xf9, 0x97, 0x69, 0xe0, 0xc2, 0xd9, 0x1b, 0xb8, 0x18, 0x35, 0xf0, 0xff,
-	0xc2, 0x7c, 0x58, 0xe9, 0xee, 0xd0, 0x1e, 0xb8, 0x18, 0xdd, 0x84, 0xe2, 0x1e, 0xa5, 0x53, 0xbd,
-	0x57, 0x57, 0x1b, 0x2b, 0x3e, 0x76, 0x30, 0x7e, 0x9d, 0xb7, 0x6b, 0x37, 0xa0, 0xc1, 0x28, 0xf7,
-	0xb0, 0x97, 0x62, 0x33, 0xed, 0x3d, 0x98, 0x95, 0xf8, 0x4e, 0x3c, 0xcc, 0xeb, 0xc2, 0x3b, 0xda,
-	0xb8, 0x87, 0x53, 0xbd, 0x43, 0x5b, 0x10, 0x6b, 0x12, 0xac, 0x6c, 0x30, 0xad, 0x2b, 0x66, 0x40,
-	0x9c, 0x59, 0x08, 0x58, 0x80, 0xe2, 0xfe, 0xc8, 0x71, 0x6d, 0x87, 0x8b, 0xe0, 0x6f, 0x68, 0x1e,
-	0x0a, 0x3d, 0xab, 0x6f, 0x31, 0x77, 0x2e, 0xe8, 0xec, 0x05, 0x5d, 0x84, 0x8a, 0x69, 0x39, 0x78,
-	0x9f, 0x28, 0x99, 0xfa, 0x4c, 0x41, 0x0f, 0x08, 0xda, 0x13, 0x40, 0xf2, 0x00, 0x7c, 0x8d, 0x2b,
-	0x50, 0xb0, 0x3c, 0xdc, 0x77, 0x9b, 0x99, 0xcb, 0xb9, 0x9b, 0xd5, 0xd5, 0x66, 0x74, 0x89, 0x22,
-	0xb4, 0x74, 0xc6, 0x46, 0x96, 0xd4, 0xb7, 0x1d, 0x4c, 0x07, 0x2e, 0xeb, 0xf4, 0x59, 0xdb, 0x81,
-	0x45, 0xc6, 0xbc, 0x8b, 0xbd, 0x35, 0xcf, 0x73, 0xac, 0xbd, 0x11, 0x19, 0x31, 0x2d, 0x46, 0xc2,
-	0x86, 0xcf, 0x46, 0x0d, 0x7f, 0x09, 0x2e, 0xaa, 0x25, 0x72, 0x65, 0xfd, 0x30, 0x03, 0x73, 0x6b,
-	0xa6, 0xe9, 0x60, 0xd7, 0xc5, 0xe6, 0x03, 0x82, 0xe0, 0xf7, 0xa9, 0x06, 0x6e, 0x0a, 0xbd, 0x30,
-	0x83, 0xa1, 0x15, 0x8e, 0xee, 0x01, 0x8b, 0xd0, 0xd5, 0x1d, 0x98, 0x77, 0x3d, 0xdb, 0x31, 0x0e,
-	0x71, 0x97, 0x6c, 0x0f, 0x5d, 0x83, 0x49, 0xe3, 0xf8, 0x30, 0xbb, 0x42, 0xf7, 0x8c, 0x6d, 0xdb,
-	0xc4, 0x7c, 0x18, 0x1d, 0x71, 0x76, 0x89, 0xa6, 0x7d, 0x99, 0x85, 0x05, 0x1e, 0x8d, 0x8f, 0x1d,
-	0xcb, 0xb7, 0xfb, 0x83, 0x9e, 0x49, 0x2c, 0x27, 0xf9, 0x4e, 0x4d, 0x78, 0x0a, 0x51, 0x06, 0x09,
-	0x78, 0xbe, 0x64, 0xfa, 0x8c, 0x9a, 0x50, 0xe2, 0xe1, 0xce, 0x23, 0x5d, 0xbc, 0xa2, 0x77, 0x01,
-	0x82, 0xb0, 0x9e, 0x24, 0x9e, 0x25, 0x76, 0xf4, 0x2e, 0xb4, 0xfa, 0xc6, 0xb1, 0x08, 0x5f, 0x6c,
-	0x86, 0x31, 0xa5, 0x40, 0x47, 0x3a, 0xdf, 0x37, 0x8e, 0x37, 0x04, 0x83, 0x0c, 0x2c, 0x6d, 0x00,
-	0x7c, 0x3c, 0xb4, 0x1c, 0x83, 0x3a, 0x53, 0xf1, 0x04, 0xa8, 0x29, 0xf5, 0xd3, 0xbe, 0xca, 0xc0,
-	0xf9, 0xb0, 0x82, 0x98, 0x01, 0x89, 0x86, 0x36, 0xa1, 0x61, 0x08, 0x13, 0x76, 0xa9, 0x51, 0x84,
-	0x13, 0x2e, 0x05, 0x4e, 0xa8, 0x30, 0xb2, 0x5e, 0xf7, 0xbb, 0xd1, 0x77, 0x17, 0xbd, 0x0d, 0xd3,
-	0x8e, 0x6d, 0x7b, 0xdd, 0xa1, 0x85, 0xf7, 0xb1, 0xef, 0x4f, 0xeb, 0x75, 0x32, 0xa5, 0x3f, 0x7f,
-	0xbd, 0x5c, 0xda, 0x21, 0xf4, 0x4e, 0x5b, 0xaf, 0x12, 0x2e, 0xf6, 0x62, 0x52, 0x94, 0x76, 0xac,
-	0xcf, 0x0c, 0x0f, 0x77, 0x9f, 0xe1, 0xe7, 0x54, 0xf1, 0xb5, 0xf5, 0xf3, 0xbc, 0x4b, 0x9d, 0x72,
-	0xed, 0xb0, 0xf6, 0x0f, 0xf1, 0x73, 0x1d, 0x86, 0xfe, 0xb3, 0xf6, 0xfb, 0x60, 0x51, 0x77, 0xec,
-	0x3e, 0x99, 0xd1, 0x59, 0x9b, 0xfd, 0x0d, 0x28, 0x71, 0x1b, 0x73, 0x9b, 0x23, 0xc9, 0xe6, 0x3b,
-	0xec, 0x49, 0x17, 0x2c, 0xe8, 0x5d, 0xa8, 0xdb, 0x8e, 0x75, 0x68, 0x0d, 0x8c, 0x9e, 0xd0, 0x63,
-	0x81, 0xea, 0x51, 0xe5, 0xfe, 0x33, 0x82, 0x95, 0xe9, 0x4e, 0xdb, 0x84, 0x66, 0x64, 0x2d, 0x81,
-	0x85, 0xa4, 0x69, 0x64, 0xc6, 0x4e, 0x43, 0x33, 0xe0, 0x02, 0x97, 0xd4, 0xb6, 0xbf, 0x3f, 0xe8,
-	0xd9, 0x86, 0x79, 0xd6, 0x7a, 0xd1, 0xfe, 0x98, 0x81, 0x56, 0x6c, 0x8c, 0x97, 0xe1, 0x51, 0xd2,
-	0xca, 0xb3, 0xe3, 0x0d, 0x70, 0x7a, 0x57, 0xfa, 0x14, 0xce, 0xf1, 0xf5, 0x74, 0x06, 0x07, 0xf6,
-	0x99, 0xeb, 0xeb, 0xae, 0x0f, 0x4f, 0x4c, 0xbc, 0xd2, 0xb4, 0xe3, 0x17, 0xa8, 0x75, 0x7d, 0x87,
-	0x0f, 0xed, 0x6f, 0x67, 0x37, 0xd1, 0x2f, 0x33, 0xbe, 0x1b, 0x86, 0xb7, 0xc5, 0xb3, 0x35, 0x6b,
-	0xc4, 0x50, 0xd9, 0xc9, 0x0d, 0xf5, 0x97, 0x0c, 0x2c, 0x90, 0xad, 0x90, 0x4f, 0xd2, 0x9d, 0x40,
-	0x03, 0x0b, 0x50, 0x1c, 0x3a, 0xf8, 0xc0, 0x3a, 0xe6, 0x3a, 0xe0, 0x6f, 0x68, 0x19, 0xaa, 0xae,
-	0x67, 0x38, 0x5e, 0xd7, 0x38, 0x20, 0xea, 0xa7, 0xde, 0xa2, 0x03, 0x25, 0xad, 0x11, 0x0a, 0xd9,
-	0x1b, 0xf1, 0xc0, 0xec, 0xee, 0xe1, 0x03, 0xb2, 0xd1, 0xe6, 0xd9, 0xde, 0x88, 0x07, 0xe6, 0x3a,
-	0x25, 0x90, 0x5d, 0xde, 0xc1, 0x24, 0x0f, 0xb0, 0x3e, 0x63, 0x28, 0x5e, 0xd6, 0x03, 0x42, 0x90,
-	0x19, 0x14, 0xe5, 0xcc, 0x60, 0x09, 0x80, 0x68, 0xaa, 0x7b, 0xd0, 0x33, 0x0e, 0x5d, 0x9a, 0x48,
-	0x97, 0xf4, 0x0a, 0xa1, 0xdc, 0x25, 0x04, 0x0a, 0xd3, 0xe1, 0xd5, 0x05, 0xda, 0xbf, 0x1d, 0x4e,
-	0x10, 0x6e, 0x04, 0x2a, 0x4f, 0xe8, 0xb1, 0x32, 0x26, 0x5d, 0x68, 0x61, 0xc8, 0x8b, 0x64, 0x9d,
-	0xba, 0x48, 0x46, 0x72, 0x91, 0x93, 0x05, 0xde, 0x22, 0x54, 0x2c, 0xb7, 0xcb, 0xb5, 0x9c, 0xa3,
-	0x43, 0x94, 0x2d, 0x77, 0x87, 0xbe, 0x6b, 0x4f, 0x89, 0x4b, 0x29, 0xf2, 0x11, 0xb2, 0xa8, 0x65,
-	0xa8, 0x32, 0x2b, 0x75, 0xa5, 0xcc, 0x04, 0x18, 0x69, 0x7b, 0x82, 0xfc, 0x64, 0x91, 0x60, 0x9d,
-	0x2a, 0x33, 0x79, 0xd0, 0x33, 0xb5, 0x79, 0x40, 0x3b, 0x8e, 0xfd, 0x5d, 0xbc, 0x2f, 0x07, 0xb5,
-	0xf6, 0x0e, 0xcc, 0x85, 0xa8, 0x3c, 0xff, 0xba, 0x02, 0xb5, 0x21, 0x23, 0x77, 0x5d, 0xa3, 0x27,
-	0x7c, 0xa8, 0xca, 0x69, 0xbb, 0x46, 0xcf, 0xd3, 0x7e, 0x5c, 0x82, 0xe2, 0x83, 0x3d, 0xf2, 0x9a,
-	0xe8, 0x6b, 0xd7, 0x61, 0x26, 0xd8, 0xe6, 0xa5, 0xb8, 0x9b, 0xf6, 0xa9, 0x3b, 0x3c, 0x00, 0x3f,
-	0xc3, 0x8e, 0x1b, 0xa4, 0x87, 0xe2, 0x15, 0xdd, 0x82, 0xa2, 0xeb, 0x19, 0xde, 0xc8, 0xa5, 0xfe,
-	0x46, 0x8e, 0x2b, 0xbe, 0x99, 0xd9, 0xd0, 0x2b, 0xbb, 0xb4, 0x59, 0xe7, 0x6c, 0xe8, 0x4d, 0xa8,
-	0xb8, 0x9e, 0x83, 0x8d, 0x3e, 0xd1, 0x4f, 0x81, 0x06, 0x52, 0x83, 0x07, 0x52, 0x79, 0x97, 0x36,
-	0x74, 0xda, 0x7a, 0x99, 0xb1, 0x74, 0xcc, 0xc8, 0x21, 0xac, 0x78, 0xba, 0xf3, 0xef, 0x1a, 0x19,
-	0x93, 0x8c, 0x4e, 0x64, 0x94, 0x4e, 0x20, 0xa3, 0xcc, 0xba, 0xad, 0x91, 0xb4, 0x8f, 0xa5, 0x27,
-	0x98, 0xca, 0x28, 0x9f, 0x64, 0x1e, 0xbc, 0xdf, 0x9a, 0x87, 0xee, 0x41, 0x33, 0xd0, 0x36, 0xd1,
-	0x93, 0x69, 0x78, 0x46, 0x77, 0x60, 0x0f, 0xf6, 0x71, 0xb3, 0x42, 0x55, 0x31, 0xcd, 0x55, 0x51,
-	0xd8, 0x26, 0x44, 0x7d, 0xc1, 0x67, 0xdf, 0xe2, 0xdc, 0x94, 0x8e, 0xde, 0x04, 0x14, 0x17, 0xd4,
-	0x04, 0x6a, 0xba, 0xd9, 0x58, 0x1f, 0xf4, 0x06, 0xa0, 0x03, 0xeb, 0x38, 0x9a, 0xc8, 0x55, 0x29,
-	0x94, 0x36, 0x68, 0x8b, 0x9c, 0xc1, 0x6d, 0xc2, 0x6c, 0xfc, 0x48, 0x58, 0x1b, 0x9f, 0x42, 0x36,
-	0x9c, 0xe8, 0x59, 0xf0, 0x11, 0x9c, 0x53, 0x9f, 0x01, 0xa7, 0x27, 0x3c, 0x03, 0xce, 0xe3, 0x84,
-	0xc3, 0x9f, 0x67, 0x7b, 0x46, 0x8f, 0x2d, 0x63, 0x86, 0x2e, 0xa3, 0x42, 0x29, 0x74, 0xfe, 0xcb,
-	0x50, 0xb5, 0x06, 0x3d, 0x6b, 0x80, 0x59, 0x7b, 0x9d, 0xb6, 0x03, 0x23, 0x09, 0x06, 0x07, 0xf7,
-	0x6d, 0x8f, 0x33, 0x34, 0x18, 0x03, 0x23, 0x11, 0x06, 0xed, 0x23, 0x28, 0x32, 0xaf, 0x45, 0x55,
-	0x28, 0x75, 0xb6, 0x3f, 0x5e, 0xbb, 0xdf, 0x69, 0x37, 0xa6, 0xd0, 0x34, 0x54, 0x1e, 0xed, 0xdc,
-	0x7f, 0xb0, 0xd6, 0xee, 0x6c, 0xdf, 0x6b, 0x64, 0xd0, 0x0c, 0xc0, 0x9d, 0x07, 0x5b, 0x5b, 0x9d,
-	0x87, 0x0f, 0xc9, 0x7b, 0x96, 0x34, 0xf3, 0xf7, 0x8d, 0x76, 0x23, 0x87, 0x6a, 0x50, 0x6e, 0x6f,
-	0xdc, 0xdf, 0xa0, 0x8d, 0x79, 0xed, 0x4f, 0x59, 0x40, 0x2c, 0x20, 0xd6, 0xf1, 0xa1, 0x35, 0x90,
-	0xce, 0x69, 0x2f, 0x27, 0x2e, 0xc3, 0xfe, 0x9a, 0x3f, 0x9d, 0xbf, 0x2a, 0x3d, 0xa1, 0x74, 0xa6,
-	0x9e, 0x50, 0x7e, 0x11, 0x4f, 0xd0, 0x7e, 0x93, 0x85, 0xb9, 0x90, 0x56, 0x39, 0x38, 0xbe, 0x34,
-	0xb5, 0x86, 0xd0, 0x2b, 0x3f, 0x16, 0xbd, 0x94, 0x0a, 0x2c, 0x9c, 0xa9, 0x02, 0x8b, 0x2f, 0xa4,
-	0xc0, 0x5f, 0x67, 0x84, 0x02, 0x43, 0x27, 0x92, 0xf0, 0x3a, 0x33, 0x63, 0xd7, 0x99, 0x06, 0x6c,
-	0xd9, 0x17, 0x07, 0xb6, 0x5c, 0x02, 0xb0, 0x69, 0x0b, 0x30, 0x1f, 0x9e, 0x3d, 0x3f, 0xe6, 0x3f,
-	0x83, 0x06, 0xa3, 0x4b, 0xd5, 0x9b, 0x97, 0xe5, 0x13, 0xda, 0x7b, 0x30, 0x2b, 0x0d, 0x16, 0x94,
-	0x80, 0x6c, 0x4a, 0x8c, 0x97, 0x80, 0x18, 0xb3, 0xce, 0xdb, 0xb5, 0x1f, 0x64, 0x45, 0xff, 0x48,
-	0x01, 0x47, 0x39, 0xdb, 0xd7, 0xa1, 0x21, 0xcd, 0x56, 0x4e, 0x13, 0xeb, 0xc1, 0x7c, 0x59, 0xbe,
-	0x18, 0x62, 0xe5, 0xd5, 0xa0, 0x5c, 0x84, 0xf5, 0x0e, 0x2b, 0x0b, 0x85, 0x52, 0xc3, 0x7c, 0x62,
-	0x6a, 0x58, 0x90, 0x53, 0xc3, 0x0e, 0xd4, 0xd9, 0x0a, 0xba, 0xd6, 0x60, 0xbf, 0x37, 0x32, 0x71,
-	0xe0, 0x8b, 0x91, 0xa5, 0x8a, 0x52, 0x50, 0x87, 0xf3, 0xe9, 0x33, 0xac, 0xa3, 0x78, 0xd7, 0x9e,
-	0x08, 0x6c, 0x9c, 0xb0, 0xc2, 0x14, 0x16, 0x9b, 0x56, 0x61, 0xfa, 0x6d, 0x0e, 0x66, 0xc2, 0xdc,
-	0x0a, 0x7b, 0x67, 0xc6, 0xd8, 0x3b, 0x9b, 0x94, 0xf2, 0xe4, 0x26, 0x4b, 0x79, 0xc2, 0x39, 0x4c,
-	0xfe, 0x0c, 0x72, 0x98, 0xc2, 0x19, 0xe4, 0x30, 0xc5, 0xb3, 0xcf, 0x61, 0x4a, 0x2f, 0x1e, 0xea,
-	0xe5, 0xa4, 0x50, 0xff, 0x0f, 0x58, 0x50, 0x7b, 0x13, 0x6a, 0x41, 0xd9, 0xef, 0x9e, 0x61, 0xb9,
-	0xbc, 0x78, 0xd7, 0x5c, 0x68, 0x4a, 0xfb, 0x43, 0xb8, 0xc8, 0xfa, 0xd2, 0x00, 0xe1, 0x03, 0xb8,
-	0xa0, 0x18, 0x94, 0x7b, 0xf5, 0xc9, 0x90, 0x35, 0x90, 0x75, 0xd7, 0x1a, 0x58, 0xee, 0x51, 0x78,
-	0x05, 0x27, 0x94, 0x75, 0x11, 0x5a, 0x2a, 0x59, 0x1c, 0x33, 0xff, 0x91, 0x85, 0xea, 0xae, 0xe1,
-	0x89, 0x7e, 0x2f, 0x6f, 0x0f, 0x7d, 0xa1, 0xda, 0x64, 0x07, 0xa6, 0x69, 0x4c, 0x90, 0x5d, 0xd0,
-	0x34, 0x3c, 0x7c, 0xa2, 0x50, 0xa8, 0x89, 0xae, 0x6d, 0xc3, 0xc3, 0x68, 0x0b, 0xea, 0x41, 0xc5,
-	0x91, 0x09, 0x3b, 0x49, 0x4c, 0xcc, 0x04, 0x9d, 0xa9, 0xb8, 0x5b, 0x30, 0xe7, 0x1a, 0x1e, 0xee,
-	0xf5, 0x2c, 0x9a, 0x58, 0x1e, 0x0e, 0x0c, 0x6f, 0xe4, 0xf0, 0xbc, 0x5e, 0x47, 0x7e, 0xd3, 0xae,
-	0x68, 0xd1, 0xfe, 0x96, 0x85, 0x12, 0xcf, 0xbb, 0x4f, 0xba, 0xdf, 0xfe, 0x27, 0x94, 0x87, 0xb6,
-	0x6b, 0x79, 0x02, 0x9d, 0xaa, 0xab, 0x17, 0x02, 0x10, 0xe2, 0x32, 0x77, 0x38, 0x83, 0xee, 0xb3,
-	0xa2, 0xf7, 0x60, 0x2e, 0x30, 0xdd, 0x33, 0xfc, 0x9c, 0x87, 0x6d, 0x4e, 0x15, 0xb6, 0x41, 0x08,
-	0x7e, 0x88, 0x9f, 0xb3, 0x88, 0xbd, 0x0a, 0xd3, 0xa1, 0xee, 0xbc, 0xc4, 0x50, 0x93, 0x39, 0xd1,
-	0x0a, 0xcc, 0x91, 0xac, 0x5a, 0xaa, 0x1e, 0xd3, 0xc0, 0x64, 0x55, 0xe3, 0x59, 0xd2, 0xe4, 0x97,
-	0x8d, 0xdb, 0xe4, 0x6c, 0xb2, 0xea, 0x27, 0x36, 0xd8, 0xec, 0xf2, 0xbc, 0x9d, 0xf6, 0x60, 0x97,
-	0x3a, 0xc1, 0x84, 0x3b, 0xb4, 0x8d, 0xf6, 0x79, 0x0d, 0x8a, 0xb4, 0x64, 0xeb, 0x36, 0x4b, 0x74,
-	0x6b, 0xa8, 0x07, 0x8b, 0xa7, 0xb5, 0x18, 0x9d, 0x37, 0x6b, 0x9b, 0x50, 0xa0, 0x04, 0x72, 0xe0,
-	0x67, 0x45, 0xde, 0xc1, 0xa8, 0x4f, 0xf5, 0x5b, 0xd0, 0xcb, 0x94, 0xb0, 0x3d, 0xea, 0x23, 0x0d,
-	0xf2, 0x03, 0xdb, 0x14, 0x99, 0xca, 0x0c, 0xd7, 0x43, 0x71, 0xdb, 0x36, 0x71, 0xa7, 0xad, 0xd3,
-	0x36, 0x6d, 0x13, 0xea, 0x11, 0xbd, 0x92, 0x63, 0x04, 0x39, 0xd8, 0x13, 0x91, 0x7b, 0xbc, 0xd2,
-	0x59, 0xd0, 0xe9, 0xe9, 0x7f, 0x9b, 0x52, 0xc8, 0xbe, 0x69, 0x0d, 0x4c, 0x7c, 0x2c, 0x2e, 0x5b,
-	0xe8, 0x8b, 0xf6, 0xf3, 0x0c, 0xcc, 0x71, 0x51, 0xa1, 0xa3, 0xc0, 0xab, 0x71, 0x81, 0x1b, 0x50,
-	0xef, 0x1b, 0xc7, 0x5d, 0x5a, 0xdf, 0x65, 0x35, 0x31, 0x5e, 0x52, 0x9b, 0xee, 0x1b, 0xc7, 0x41,
-	0x09, 0x4c, 0xfb, 0x43, 0x06, 0xe6, 0xc3, 0xb3, 0xe4, 0xf8, 0xf5, 0x16, 0x80, 0x38, 0x45, 0xfa,
-	0xf3, 0x9c, 0xe5, 0xf3, 0xac, 0x88, 0xa2, 0x61, 0x5b, 0xaf, 0x70, 0xa6, 0x8e, 0xba, 0x0c, 0x97,
-	0x3d, 0x8b, 0x32, 0xdc, 0x09, 0xea, 0xa5, 0xbf, 0xc8, 0xfa, 0xcb, 0x09, 0x27, 0xba, 0x27, 0x5f,
-	0x4e, 0x42, 0x10, 0x65, 0x4f, 0x1b, 0x44, 0xb9, 0xc9, 0x83, 0x28, 0x9f, 0x14, 0x44, 0xf7, 0x60,
-	0x7a, 0x34, 0xec, 0xd9, 0x86, 0xd9, 0x75, 0xb0, 0x3b, 0xea, 0x79, 0xbc, 0x8e, 0xaf, 0xc5, 0x3d,
-	0x82, 0xe8, 0xe8, 0xd1, 0x90, 0x17, 0xc0, 0x47, 0x3d, 0x4f, 0xaf, 0x8d, 0xa4, 0x37, 0xed, 0x47,
-	0x41, 0x3d, 0x35, 0xc6, 0x9a, 0x1e, 0x44, 0xaf, 0x41, 0x89, 0xde, 0x87, 0xf9, 0xb7, 0x28, 0xd1,
-	0x38, 0x2a, 0x92, 0xe6, 0x8e, 0x89, 0xae, 0x43, 0xfe, 0xc8, 0x70, 0x8f, 0xf8, 0xb7, 0x0c, 0xb3,
-	0xe2, 0xaa, 0x81, 0x0e, 0xb7, 0x69, 0xb8, 0x47, 0x3a, 0x6d, 0xd6, 0xfe, 0x95, 0x85, 0x1a, 0xd9,
-	0x8e, 0x84, 0x09, 0xd0, 0x6a, 0x34, 0x3e, 0xaa, 0xab, 0xe7, 0xa4, 0xf5, 0x05, 0x3b, 0x97, 0x14,
-	0x24, 0x91, 0x10, 0xcd, 0x26, 0x87, 0x68, 0x4e, 0x0a, 0xd1, 0xf8, 0xbd, 0x50, 0x61, 0x82, 0x7b,
-	0xa1, 0x8f, 0xe0, 0x9c, 0x7f, 0x9b, 0x22, 0x85, 0x17, 0xc9, 0x8a, 0x27, 0xf0, 0xf5, 0x39, 0xd1,
-	0x37, 0xa0, 0xb9, 0xf1, 0xcd, 0xae, 0x74, 0xea, 0xcd, 0x2e, 0x61, 0x77, 0x2a, 0x27, 0xee, 0x4e,
-	0xe7, 0xfd, 0x1b, 0x86, 0xc8, 0xd9, 0xea, 0x67, 0x59, 0xdf, 0x45, 0xb6, 0x8c, 0x67, 0x98, 0xc1,
-	0xf2, 0xab, 0x05, 0xb1, 0x57, 0xb1, 0x8f, 0x25, 0xee, 0x4b, 0x85, 0xc4, 0x7d, 0x89, 0x55, 0x77,
-	0x63, 0x9a, 0xe1, 0x7a, 0xb3, 0xfd, 0x46, 0x45, 0x2e, 0xba, 0x18, 0xd3, 0xdb, 0x0b, 0x6b, 0x49,
-	0xfb, 0x2a, 0xb8, 0xf4, 0x52, 0x25, 0xa2, 0xdf, 0x4e, 0x20, 0xff, 0x49, 0xb0, 0x28, 0x55, 0x46,
-	0x7c, 0xf2, 0x45, 0xdd, 0x86, 0x12, 0xc3, 0x4c, 0xb1, 0x96, 0x04, 0xd0, 0xf4, 0xb5, 0x47, 0x40,
-	0x53, 0x74, 0x89, 0xe1, 0xa5, 0xcc, 0xf5, 0x6a, 0xf1, 0x72, 0x09, 0x16, 0x95, 0x7a, 0xe1, 0xde,
-	0xf7, 0x45, 0x06, 0x10, 0x6f, 0x97, 0xcb, 0x0c, 0xa9, 0x7e, 0xb7, 0x0e, 0x75, 0x56, 0x36, 0xe8,
-	0x4e, 0xee, 0x7e, 0x33, 0xac, 0x87, 0x9f, 0x24, 0xf9, 0xb5, 0x83, 0x9c, 0x54, 0x3b, 0xd0, 0x9e,
-	0xfa, 0x29, 0x50, 0xe8, 0xc4, 0x7f, 0x2b, 0x7c, 0xe2, 0x8f, 0x0f, 0x33, 0xc9, 0x91, 0x3f, 0xc8,
-	0xd4, 0xfc, 0x23, 0xbf, 0x1c, 0x40, 0x99, 0xc9, 0x03, 0xe8, 0x8b, 0x8c, 0x7f, 0x0d, 0x1a, 0xb9,
-	0x99, 0x3e, 0x29, 0xce, 0x9d, 0x81, 0x26, 0xb5, 0x5f, 0xe5, 0x82, 0xcb, 0xd4, 0xc8, 0x1d, 0xf6,
-	0xb7, 0x33, 0x96, 0x93, 0x21, 0x36, 0x9f, 0x9c, 0xfa, 0x5f, 0x81, 0x9a, 0xe2, 0x6b, 0x94, 0xaa,
-	0x2b, 0xdd, 0x5f, 0x24, 0xec, 0x0e, 0xc5, 0xd3, 0xee, 0x0e, 0x25, 0xc5, 0xee, 0xf0, 0x26, 0xe4,
-	0x07, 0xf8, 0x58, 0x5c, 0x04, 0xa5, 0x58, 0x91, 0xb2, 0x69, 0x77, 0xa1, 0xb6, 0x6e, 0x78, 0xfb,
-	0x47, 0xc2, 0x7d, 0xfe, 0x0b, 0xca, 0x0e, 0x7b, 0x14, 0xbe, 0xde, 0x92, 0xbe, 0x9f, 0x92, 0x38,
-	0xa9, 0xb3, 0xfb, 0xbc, 0xda, 0xdf, 0x01, 0x1a, 0xd1, 0x66, 0xd4, 0x86, 0x69, 0x7e, 0x27, 0xc9,
-	0xaa, 0x45, 0xdc, 0xc5, 0x97, 0xa2, 0x5f, 0x64, 0x85, 0x3e, 0x40, 0xdc, 0x9c, 0xd2, 0x6b, 0x7b,
-	0x12, 0x99, 0x9c, 0xca, 0xb9, 0x94, 0x43, 0x1c, 0x7c, 0xed, 0x18, 0x11, 0x11, 0x94, 0x53, 0x37,
-	0xa7, 0xf4, 0xca, 0x9e, 0xa0, 0x49, 0x53, 0x30, 0x29, 0xec, 0x70, 0xb0, 0x8a, 0x4d, 0x21, 0x04,
-	0xd6, 0xc1, 0x14, 0x18, 0x19, 0xfd, 0x8f, 0x7f, 0xb9, 0xda, 0xb3, 0x5c, 0xcf, 0xaf, 0x0c, 0x28,
-	0x3e, 0x2c, 0x0b, 0x24, 0xf0, 0x49, 0x13, 0x22, 0xfa, 0x14, 0x16, 0x78, 0x7f, 0x17, 0x7b, 0x5d,
-	0x23, 0xb8, 0x64, 0xe5, 0x45, 0x82, 0xeb, 0x51, 0x51, 0xca, 0x6b, 0xde, 0xcd, 0x29, 0x7d, 0x7e,
-	0x4f, 0xd1, 0x8c, 0xd6, 0xa0, 0xc6, 0x0b, 0x9e, 0x7b, 0x64, 0x3b, 0xe5, 0xc5, 0x82, 0x8b, 0xd1,
-	0xea, 0x9f, 0x7c, 0xa8, 0xdb, 0x9c, 0xd2, 0xab, 0x76, 0x40, 0x25, 0x7a, 0xe2, 0x22, 0xf6, 0x69,
-	0x52, 0xc5, 0x13, 0xba, 0xa5, 0xa8, 0x8c, 0xd0, 0x19, 0x85, 0xe8, 0xc9, 0x96, 0xc8, 0xc4, 0x54,
-	0x5c, 0x0a, 0x31, 0x55, 0x39, 0x6a, 0xaa, 0x68, 0xe5, 0x9b, 0x98, 0xca, 0x16, 0x34, 0xa2, 0x64,
-	0xde, 0x99, 0x2a, 0xb9, 0x12, 0x55, 0x72, 0xac, 0x14, 0x4d, 0x94, 0x6c, 0xfb, 0x44, 0xf4, 0x10,
-	0xe6, 0x64, 0x2d, 0x08, 0x83, 0x03, 0x95, 0xa3, 0x29, 0x95, 0x11, 0xb5, 0xfa, 0xac, 0x1d, 0x6d,
-	0x43, 0x8f, 0x61, 0x9e, 0x4b, 0x3d, 0xa0, 0xbb, 0x97, 0x10, 0x5b, 0xa5, 0x62, 0xaf, 0x46, 0xc5,
-	0x2a, 0xb6, 0xfe, 0xcd, 0x29, 0x1d, 0xd9, 0xb1, 0x46, 0xa2, 0x71, 0x81, 0x17, 0xcc, 0x6a, 0xb5,
-	0xa8, 0xc6, 0x15, 0x67, 0x71, 0xa2, 0x71, 0x57, 0x22, 0xa3, 0x7b, 0x30, 0x23, 0xa4, 0x70, 0xc3,
-	0xb1, 0x1b, 0xcc, 0x4b, 0x31, 0x31, 0x51, 0xcb, 0x89, 0xd1, 0xb9, 0xe9, 0x1e, 0xc2, 0x9c, 0x10,
-	0xd4, 0x37, 0x9e, 0x61, 0x8e, 0x7a, 0xf4, 0x0e, 0x53, 0x95, 0x79, 0xc4, 0x12, 0x6c, 0xa2, 0x3d,
-	0x37, 0xda, 0x46, 0xb4, 0x17, 0x5a, 0xa4, 0xd0, 0x5e, 0x3d, 0xaa, 0xbd, 0xc4, 0x04, 0x94, 0x68,
-	0xcf, 0x8d, 0x35, 0xa2, 0xa7, 0x70, 0x4e, 0x08, 0x0e, 0xdb, 0xa5, 0x41, 0x25, 0x5f, 0x8b, 0x49,
-	0x56, 0x1b, 0x46, 0xac, 0x39, 0x64, 0x99, 0xb5, 0x00, 0xc9, 0xa9, 0x27, 0xce, 0x46, 0xc3, 0x29,
-	0x9e, 0xae, 0x90, 0x70, 0x72, 0x03, 0x2a, 0xda, 0x82, 0x86, 0x10, 0x61, 0xf2, 0x2d, 0xb1, 0x89,
-	0xa2, 0x77, 0x10, 0xea, 0x1d, 0x7c, 0x73, 0x4a, 0xaf, 0xbb, 0xe1, 0x96, 0xf5, 0x0a, 0x94, 0xc4,
-	0x47, 0x17, 0x1f, 0xc0, 0x34, 0xc7, 0x59, 0xbe, 0xc3, 0xfe, 0x37, 0x54, 0x1c, 0xfe, 0x2c, 0x20,
-	0x7b, 0x31, 0x06, 0xd9, 0xac, 0x9d, 0x62, 0x76, 0xc0, 0xad, 0xfd, 0x13, 0x60, 0x36, 0xc6, 0x80,
-	0x36, 0xd4, 0xa8, 0x7d, 0x29, 0x09, 0xb5, 0x59, 0xd7, 0x18, 0x6c, 0xdf, 0x56, 0xc0, 0xf6, 0xa2,
-	0x12, 0xb6, 0x7d, 0x01, 0x12, 0x6e, 0x6f, 0xa8, 0x71, 0xfb, 0x52, 0x12, 0x6e, 0x47, 0x27, 0xc1,
-	0x4d, 0xf9, 0xbe, 0x0a, 0xb8, 0x2f, 0xaa, 0x81, 0xdb, 0x17, 0x21, 0x23, 0xf7, 0x77, 0xc6, 0x20,
-	0xf7, 0x8d, 0x71, 0xc8, 0xed, 0x4b, 0x55, 0x43, 0xf7, 0xba, 0x12, 0xba, 0x97, 0x12, 0xa0, 0xdb,
-	0x17, 0x16, 0xc2, 0xee, 0x0d, 0x35, 0x76, 0x5f, 0x4a, 0xc2, 0xee, 0x40, 0x57, 0x21, 0xf0, 0xbe,
-	0xad, 0x00, 0xef, 0x45, 0x25, 0x78, 0x07, 0x06, 0x0b, 0xd0, 0xfb, 0x7d, 0x15, 0x7a, 0x5f, 0x54,
-	0xa3, 0x77, 0xa0, 0x69, 0x09, 0xbe, 0x1f, 0xa5, 0xc1, 0xf7, 0xd5, 0x54, 0xf8, 0xf6, 0xe5, 0x29,
-	0xf0, 0xfb, 0x49, 0x2a, 0x7e, 0x5f, 0x4b, 0xc7, 0x6f, 0x5f, 0xb0, 0x0a, 0xc0, 0x37, 0xd4, 0x00,
-	0x7e, 0x29, 0x09, 0xc0, 0x03, 0xb5, 0x87, 0x10, 0x7c, 0x33, 0x01, 0xc1, 0x97, 0x13, 0x11, 0xdc,
-	0x17, 0x14, 0x81, 0xf0, 0x47, 0x69, 0x10, 0x7e, 0x35, 0x15, 0xc2, 0x03, 0x0d, 0xc6, 0x31, 0xfc,
-	0x49, 0x2a, 0x86, 0x5f, 0x4b, 0xc7, 0xf0, 0x40, 0x83, 0x0a, 0x10, 0xff, 0xff, 0x74, 0x10, 0xbf,
-	0x3e, 0x06, 0xc4, 0x7d, 0xd9, 0x4a, 0x14, 0x5f, 0x57, 0xa2, 0xf8, 0x52, 0x02, 0x8a, 0x07, 0x91,
-	0x25, 0xc3, 0xf8, 0x76, 0x22, 0x8c, 0x5f, 0x49, 0x81, 0x71, 0x5f, 0x56, 0x0c, 0xc7, 0x01, 0xca,
-	0xa2, 0x79, 0xf5, 0x9b, 0x59, 0x28, 0x6f, 0x71, 0x19, 0x68, 0x0b, 0x6a, 0x0c, 0x36, 0xf9, 0xaf,
-	0x5a, 0xe9, 0x29, 0x72, 0x6b, 0x0c, 0x16, 0xa3, 0x36, 0x54, 0xee, 0x61, 0x8f, 0xcb, 0x4a, 0xc9,
-	0x95, 0x5b, 0x69, 0x80, 0x4c, 0x26, 0xc5, 0x74, 0x99, 0x34, 0xa9, 0xd0, 0x6e, 0xda, 0x1a, 0x83,
-	0xcd, 0x68, 0x13, 0xaa, 0x44, 0xa9, 0xac, 0xcd, 0x45, 0x69, 0xe9, 0x73, 0x2b, 0x15, 0xa2, 0x11,
-	0x86, 0xf9, 0x5d, 0xb1, 0x3c, 0x19, 0x4c, 0x27, 0x4b, 0xa3, 0x5b, 0x13, 0x62, 0x36, 0xfa, 0x00,
-	0xaa, 0xd4, 0x5b, 0xf9, 0x97, 0x8a, 0xa9, 0xf9, 0x74, 0x2b, 0x1d, 0xb2, 0xa9, 0x81, 0x69, 0x94,
-	0x72, 0x61, 0xe9, 0x89, 0x75, 0x6b, 0x0c, 0x76, 0x73, 0x03, 0x73, 0x59, 0x29, 0x19, 0x76, 0x2b,
-	0x0d, 0xc0, 0x85, 0x45, 0x58, 0x43, 0xc8, 0x22, 0xb1, 0x5c, 0xbb, 0x95, 0x0a, 0xe5, 0xe8, 0x13,
-	0x98, 0x95, 0x02, 0x9b, 0xcf, 0x6b, 0x82, 0x9c, 0xbb, 0x35, 0x09, 0xb0, 0xa3, 0x2e, 0x20, 0x39,
-	0xb4, 0xb9, 0xf8, 0x49, 0x72, 0xef, 0xd6, 0x44, 0x00, 0x4f, 0xac, 0x43, 0xc7, 0x15, 0x97, 0x9e,
-	0xe9, 0x49, 0x78, 0x6b, 0x0c, 0xc4, 0xa3, 0x1d, 0x98, 0x66, 0xf6, 0x12, 0xf2, 0xc6, 0x64, 0xe3,
-	0xad, 0x71, 0x58, 0x4f, 0xf4, 0x1b, 0x20, 0xb2, 0x90, 0x3a, 0x41, 0x56, 0xde, 0x9a, 0x04, 0xf6,
-	0x89, 0x7e, 0x25, 0xb5, 0x0b, 0xf1, 0x93, 0x64, 0xe7, 0xad, 0x89, 0xe0, 0x1f, 0xed, 0xc1, 0x9c,
-	0xac, 0x77, 0x31, 0xc2, 0x44, 0x59, 0x7a, 0x6b, 0xb2, 0x6d, 0x00, 0x7d, 0x08, 0x35, 0xf9, 0xf3,
-	0x6d, 0x94, 0x9a, 0xaf, 0xb7, 0xd2, 0xf7, 0x01, 0xf4, 0x31, 0xd4, 0x05, 0x68, 0x8b, 0xc9, 0x8e,
-	0x4d, 0xdc, 0x5b, 0xe3, 0xf7, 0x04, 0xf4, 0x0e, 0x14, 0x68, 0xc2, 0x8d, 0x16, 0xd4, 0x55, 0x95,
-	0xd6, 0xf9, 0x84, 0xd4, 0x1d, 0x3d, 0x86, 0x06, 0x03, 0x79, 0x2e, 0xfa, 0x41, 0xcf, 0x54, 0x4c,
-	0x29, 0xf2, 0xcf, 0x96, 0x62, 0x4a, 0xb1, 0x9f, 0x96, 0xfe, 0x0f, 0x1a, 0x21, 0x67, 0x25, 0xb4,
-	0x2b, 0xe9, 0xfe, 0x4a, 0x24, 0x6b, 0x63, 0x5c, 0x96, 0x88, 0xd9, 0x85, 0x19, 0xe9, 0x67, 0x0d,
-	0xfa, 0x95, 0x7a, 0xac, 0x57, 0xf8, 0x2f, 0x91, 0xd6, 0xe5, 0x04, 0x86, 0x40, 0x68, 0x17, 0x50,
-	0xc4, 0x34, 0x84, 0x7a, 0x75, 0x9c, 0x75, 0x88, 0xf0, 0x6b, 0x63, 0x0d, 0xc4, 0x15, 0x12, 0x72,
-	0x53, 0xb5, 0x42, 0xa2, 0xbf, 0x8d, 0x28, 0x14, 0x12, 0xff, 0xef, 0xe3, 0x63, 0xa8, 0xcb, 0x3e,
-	0x1a, 0xb1, 0xa1, 0xfa, 0x6f, 0x0c, 0xd9, 0x86, 0x49, 0x7f, 0x34, 0x7c, 0x02, 0xb3, 0xe1, 0x3d,
-	0x8c, 0x10, 0x43, 0x13, 0x52, 0xff, 0x35, 0x10, 0x86, 0x87, 0x84, 0xaf, 0xff, 0xc9, 0x3e, 0x28,
-	0x7d, 0xe7, 0x2f, 0x07, 0x56, 0xfc, 0xa7, 0x00, 0x39, 0xb0, 0x14, 0x3f, 0x07, 0xac, 0xe7, 0x9f,
-	0x66, 0x87, 0x7b, 0x7b, 0x45, 0x7a, 0x3f, 0xf8, 0xf6, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xc0,
-	0x85, 0xf8, 0x5e, 0x21, 0x3f, 0x00, 0x00,
+	// 3703 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0xcd, 0x6f, 0x1b, 0xd7,
+	0xb5, 0x17, 0xbf, 0xc9, 0x43, 0x8a, 0xa4, 0xae, 0x64, 0x89, 0x1e, 0x59, 0x96, 0x3c, 0xfe, 0x88,
+	0x03, 0x24, 0x72, 0xa0, 0xbc, 0xf7, 0x90, 0x87, 0x38, 0x2f, 0x4f, 0xb2, 0x64, 0x8b, 0x8e, 0x25,
+	0x2b, 0x23, 0x3b, 0xce, 0xf3, 0x4b, 0x4a, 0x8c, 0x34, 0x57, 0xd2, 0xd4, 0x24, 0x87, 0x9d, 0x19,
+	0x3a, 0x72, 0x56, 0x5d, 0x14, 0x28, 0x8a, 0x74, 0xd1, 0x45, 0x81, 0x76, 0x95, 0x4d, 0xd1, 0x55,
+	0xff, 0x82, 0x02, 0x45, 0xb7, 0x2d, 0x8a, 0xa2, 0x8b, 0x74, 0xd7, 0x02, 0x69, 0x57, 0x5d, 0x76,
+	0xd3, 0x5d, 0x81, 0x02, 0xc5, 0xfd, 0x9a, 0xb9, 0xf3, 0x49, 0x4a, 0x96, 0x0c, 0x64, 0xc7, 0x39,
+	0xf7, 0xdc, 0x33, 0xf7, 0x9e, 0x8f, 0xdf, 0x39, 0xf7, 0x5c, 0x0e, 0xd4, 0x7b, 0xd8, 0xd5, 0xcd,
+	0xfe, 0x81, 0xb5, 0x3c, 0xb0, 0x2d, 0xd7, 0x42, 0x65, 0xf1, 0xac, 0x34, 0x71, 0x7f, 0xdf, 0x7e,
+	0x31, 0x70, 0x4d, 0xab, 0xcf, 0xc6, 0x14, 0x38, 0xb4, 0x0e, 0x39, 0x9f, 0xb2, 0x78, 0x68, 0x59,
+	0x87, 0x5d, 0x7c, 0x8b, 0x3e, 0xed, 0x0d, 0x0f, 0x6e, 0xb9, 0x66, 0x0f, 0x3b, 0xae, 0xde, 0x1b,
+	0x08, 0xe6, 0xbe, 0x65, 0x60, 0xfe, 0xbb, 0x31, 0xb0, 0xcc, 0xbe, 0x8b, 0x6d, 0x63, 0x8f, 0x13,
+	0x6a, 0x96, 0x6d, 0x60, 0xdb, 0x61, 0x4f, 0xea, 0x2f, 0x72, 0x50, 0x5c, 0x1b, 0xee, 0x3f, 0xc3,
+	0x2e, 0x42, 0x90, 0xef, 0xeb, 0x3d, 0xdc, 0xca, 0x2c, 0x65, 0x6e, 0xd6, 0x34, 0xfa, 0x1b, 0xbd,
+	0x03, 0xd5, 0x81, 0xee, 0x1e, 0x75, 0xf6, 0xcd, 0xc1, 0x11, 0xb6, 0x5b, 0xd9, 0xa5, 0xcc, 0xcd,
+	0xfa, 0xca, 0xdc, 0xb2, 0xb4, 0xbc, 0x3b, 0x74, 0x64, 0x77, 0x68, 0xba, 0x58, 0x03, 0xc2, 0xcb,
+	0x08, 0xe8, 0x0e, 0xc0, 0xbe, 0x8d, 0x75, 0x17, 0x1b, 0x1d, 0xdd, 0x6d, 0xe5, 0x96, 0x32, 0x37,
+	0xab, 0x2b, 0xca, 0x32, 0x5b, 0xf9, 0xb2, 0x58, 0xf9, 0xf2, 0x23, 0xb1, 0xf2, 0xb5, 0xf2, 0x6f,
+	0xbf, 0x5e, 0x9c, 0xf8, 0xd1, 0x5f, 0x16, 0x33, 0x5a, 0x85, 0xcf, 0x5b, 0x75, 0xd1, 0x5b, 0x30,
+	0x63, 0xe0, 0x03, 0x7d, 0xd8, 0x75, 0x3b, 0x0e, 0x3e, 0xec, 0xe1, 0xbe, 0xdb, 0x71, 0xcc, 0xcf,
+	0x71, 0x2b, 0xbf, 0x94, 0xb9, 0x99, 0xd3, 0x10, 0x1f, 0xdb, 0x65, 0x43, 0xbb, 0xe6, 0xe7, 0x18,
+	0x3d, 0x81, 0x8b, 0x62, 0x86, 0x8d, 0x8d, 0x61, 0xdf, 0xd0, 0xfb, 0xfb, 0x2f, 0x3a, 0xce, 0xfe,
+	0x11, 0xee, 0xe1, 0x56, 0x81, 0xae, 0x62, 0x7e, 0xd9, 0x57, 0x89, 0xe6, 0xf1, 0xec, 0x52, 0x16,
+	0x6d, 0x8e, 0xcf, 0x0e, 0x0f, 0x20, 0x03, 0x16, 0x84, 0x60, 0x7f, 0xf7, 0x9d, 0x81, 0x6e, 0xeb,
+	0x3d, 0xec, 0x62, 0xdb, 0x69, 0x15, 0xa9, 0xf0, 0x25, 0x59, 0x37, 0x1b, 0xde, 0xcf, 0x1d, 0x8f,
+	0x4f, 0x9b, 0xe7, 0x62, 0xe2, 0x06, 0xd1, 0x02, 0xc0, 0x40, 0xb7, 0xdd, 0x3e, 0xb6, 0x3b, 0xa6,
+	0xd1, 0x2a, 0x51, 0x4b, 0x54, 0x38, 0xa5, 0x6d, 0xa8, 0x26, 0xd4, 0x99, 0xb1, 0x1e, 0x98, 0x8e,
+	0xdb, 0x76, 0x71, 0x2f, 0xd6, 0x68, 0x41, 0xd5, 0x67, 0x4f, 0xa5, 0x7a, 0xf5, 0x1f, 0x59, 0x98,
+	0x66, 0xef, 0xba, 0x43, 0x69, 0x1a, 0xfe, 0xce, 0x10, 0x3b, 0x67, 0xed, 0x25, 0x49, 0x06, 0xce,
+	0x9d, 0xce, 0xc0, 0xf9, 0xf3, 0x34, 0x70, 0xe1, 0xec, 0x0d, 0x5c, 0x0c, 0x1b, 0xf8, 0x7f, 0x61,
+	0x26, 0xa8, 0x74, 0x67, 0x60, 0xf5, 0x1d, 0x8c, 0x6e, 0x42, 0x71, 0x8f, 0xd2, 0xa9, 0xde, 0xab,
+	0x2b, 0xcd, 0x65, 0x0f, 0x3b, 0x18, 0xbf, 0xc6, 0xc7, 0xd5, 0x1b, 0xd0, 0x64, 0x94, 0x7b, 0xd8,
+	0x4d, 0xb1, 0x99, 0xfa, 0x1e, 0x4c, 0x49, 0x7c, 0x27, 0x7e, 0xcd, 0xeb, 0xc2, 0x3b, 0xd6, 0x71,
+	0x17, 0xa7, 0x7a, 0x87, 0x3a, 0x0b, 0x33, 0x41, 0x56, 0xf6, 0x32, 0xb5, 0x03, 0x53, 0xbe, 0x33,
+	0x0b, 0x01, 0xb3, 0x50, 0xdc, 0x1f, 0xda, 0x8e, 0x65, 0x73, 0x11, 0xfc, 0x09, 0xcd, 0x40, 0xa1,
+	0x6b, 0xf6, 0x4c, 0xe6, 0xce, 0x05, 0x8d, 0x3d, 0xa0, 0x4b, 0x50, 0x31, 0x4c, 0x1b, 0xef, 0x13,
+	0x25, 0x53, 0x9f, 0x29, 0x68, 0x3e, 0x41, 0xfd, 0x18, 0x90, 0xfc, 0x02, 0xbe, 0xc7, 0x65, 0x28,
+	0x98, 0x2e, 0xee, 0x39, 0xad, 0xcc, 0x52, 0xee, 0x66, 0x75, 0xa5, 0x15, 0xde, 0xa2, 0x08, 0x2d,
+	0x8d, 0xb1, 0x91, 0x2d, 0xf5, 0x2c, 0x1b, 0xd3, 0x17, 0x97, 0x35, 0xfa, 0x5b, 0xdd, 0x81, 0x79,
+	0xc6, 0xbc, 0x8b, 0xdd, 0x55, 0xd7, 0xb5, 0xcd, 0xbd, 0x21, 0x79, 0x63, 0x5a, 0x8c, 0x04, 0x0d,
+	0x9f, 0x0d, 0x1b, 0xfe, 0x32, 0x5c, 0x8a, 0x97, 0xc8, 0x95, 0xf5, 0xbd, 0x0c, 0x4c, 0xaf, 0x1a,
+	0x86, 0x8d, 0x1d, 0x07, 0x1b, 0x0f, 0x09, 0x82, 0x3f, 0xa0, 0x1a, 0xb8, 0x29, 0xf4, 0xc2, 0x0c,
+	0x86, 0x96, 0x39, 0xba, 0xfb, 0x2c, 0x42, 0x57, 0x77, 0x60, 0xc6, 0x71, 0x2d, 0x5b, 0x3f, 0xc4,
+	0x1d, 0x92, 0x1e, 0x3a, 0x3a, 0x93, 0xc6, 0xf1, 0x61, 0x6a, 0x99, 0x10, 0x97, 0xb7, 0x2d, 0x03,
+	0xf3, 0xd7, 0x68, 0x88, 0xb3, 0x4b, 0x34, 0xf5, 0xcb, 0x2c, 0xcc, 0xf2, 0x68, 0x7c, 0x62, 0x9b,
+	0x9e, 0xdd, 0x1f, 0x76, 0x0d, 0x62, 0x39, 0xc9, 0x77, 0x6a, 0xc2, 0x53, 0x88, 0x32, 0x48, 0xc0,
+	0xf3, 0x2d, 0xd3, 0xdf, 0xa8, 0x05, 0x25, 0x1e, 0xee, 0x3c, 0xd2, 0xc5, 0x23, 0x7a, 0x17, 0xc0,
+	0x0f, 0xeb, 0x71, 0xe2, 0x59, 0x62, 0x47, 0xef, 0x82, 0xd2, 0xd3, 0x8f, 0x45, 0xf8, 0x62, 0x23,
+	0x88, 0x29, 0x05, 0xfa, 0xa6, 0xb9, 0x9e, 0x7e, 0xbc, 0x21, 0x18, 0x64, 0x60, 0x59, 0x07, 0xc0,
+	0xc7, 0x03, 0xd3, 0xd6, 0xa9, 0x33, 0x15, 0x4f, 0x80, 0x9a, 0xd2, 0x3c, 0xf5, 0xab, 0x0c, 0xcc,
+	0x05, 0x15, 0xc4, 0x0c, 0x48, 0x34, 0xb4, 0x09, 0x4d, 0x5d, 0x98, 0xb0, 0x43, 0x8d, 0x22, 0x9c,
+	0x70, 0xc1, 0x77, 0xc2, 0x18, 0x23, 0x6b, 0x0d, 0x6f, 0x1a, 0x7d, 0x76, 0xd0, 0xdb, 0x30, 0x69,
+	0x5b, 0x96, 0xdb, 0x19, 0x98, 0x78, 0x1f, 0x7b, 0xfe, 0xb4, 0xd6, 0x20, 0x4b, 0xfa, 0xd3, 0xd7,
+	0x8b, 0xa5, 0x1d, 0x42, 0x6f, 0xaf, 0x6b, 0x55, 0xc2, 0xc5, 0x1e, 0x0c, 0x8a, 0xd2, 0xb6, 0xf9,
+	0x5c, 0x77, 0x71, 0xe7, 0x19, 0x7e, 0x41, 0x15, 0x5f, 0x5b, 0x9b, 0xe3, 0x53, 0x1a, 0x94, 0x6b,
+	0x87, 0x8d, 0x7f, 0x80, 0x5f, 0x68, 0x30, 0xf0, 0x7e, 0xab, 0xbf, 0xf3, 0x37, 0x75, 0xc7, 0xea,
+	0x91, 0x15, 0x9d, 0xb5, 0xd9, 0xdf, 0x80, 0x12, 0xb7, 0x31, 0xb7, 0x39, 0x92, 0x6c, 0xbe, 0xc3,
+	0x7e, 0x69, 0x82, 0x05, 0xbd, 0x0b, 0x0d, 0xcb, 0x36, 0x0f, 0xcd, 0xbe, 0xde, 0x15, 0x7a, 0x2c,
+	0x2c, 0xe5, 0x12, 0xdc, 0xbf, 0x2e, 0x58, 0xe9, 0xa3, 0xa3, 0x6e, 0x42, 0x2b, 0xb4, 0x17, 0xdf,
+	0x42, 0xd2, 0x32, 0x32, 0x23, 0x97, 0xa1, 0xea, 0x70, 0x91, 0x4b, 0x5a, 0xb7, 0x3e, 0xeb, 0x77,
+	0x2d, 0xdd, 0x38, 0x6b, 0xbd, 0xa8, 0x7f, 0xc8, 0x80, 0x12, 0x79, 0xc7, 0x79, 0x78, 0x94, 0xb4,
+	0xf3, 0xec, 0x68, 0x03, 0x9c, 0xde, 0x95, 0x3e, 0x85, 0x0b, 0x7c, 0x3f, 0xed, 0xfe, 0x81, 0x75,
+	0xe6, 0xfa, 0xba, 0x0b, 0xb3, 0x01, 0xf1, 0xb1, 0xa6, 0x1d, 0xbd, 0x41, 0xb5, 0xe3, 0x39, 0x7c,
+	0x20, 0xbf, 0x9d, 0xdd, 0x42, 0xbf, 0xcc, 0x40, 0x2b, 0xf4, 0x86, 0xf3, 0x30, 0x6b, 0xc8, 0x50,
+	0xd9, 0xf1, 0x0d, 0xf5, 0xe7, 0x0c, 0xcc, 0x92, 0x54, 0xc8, 0x17, 0xe9, 0x8c, 0xa1, 0x81, 0x59,
+	0x28, 0x0e, 0x6c, 0x7c, 0x60, 0x1e, 0x73, 0x1d, 0xf0, 0x27, 0xb4, 0x08, 0x55, 0xc7, 0xd5, 0x6d,
+	0xb7, 0xa3, 0x1f, 0x10, 0xf5, 0x53, 0x6f, 0xd1, 0x80, 0x92, 0x56, 0x09, 0x85, 0xe4, 0x46, 0xdc,
+	0x37, 0x3a, 0x7b, 0xf8, 0x80, 0x24, 0xda, 0x3c, 0xcb, 0x8d, 0xb8, 0x6f, 0xac, 0x51, 0x02, 0xc9,
+	0xf2, 0x36, 0x26, 0x75, 0x80, 0xf9, 0x9c, 0xa1, 0x78, 0x59, 0xf3, 0x09, 0x7e, 0x65, 0x50, 0x94,
+	0x2b, 0x83, 0x05, 0x00, 0xa2, 0xa9, 0xce, 0x41, 0x57, 0x3f, 0x74, 0x68, 0x21, 0x5d, 0xd2, 0x2a,
+	0x84, 0x72, 0x97, 0x10, 0x28, 0x4c, 0x07, 0x77, 0xe7, 0x6b, 0xff, 0x76, 0xb0, 0x40, 0xb8, 0xe1,
+	0xab, 0x3c, 0x61, 0xc6, 0xf2, 0x88, 0x72, 0x41, 0xc1, 0x90, 0x17, 0xc5, 0x3a, 0x75, 0x91, 0x8c,
+	0xe4, 0x22, 0x27, 0x0b, 0xbc, 0x79, 0xa8, 0x98, 0x4e, 0x87, 0x6b, 0x39, 0x47, 0x5f, 0x51, 0x36,
+	0x9d, 0x1d, 0xfa, 0xac, 0x3e, 0x85, 0x56, 0xb8, 0x7a, 0xf0, 0x6c, 0xb6, 0x08, 0x55, 0x66, 0xa5,
+	0x8e, 0x54, 0x99, 0x00, 0x23, 0x6d, 0x8f, 0x51, 0x9f, 0xcc, 0xc3, 0xc5, 0xb0, 0x6c, 0x6f, 0xff,
+	0xea, 0x0c, 0xa0, 0x1d, 0xdb, 0xfa, 0x36, 0xde, 0x97, 0x83, 0x5a, 0x7d, 0x07, 0xa6, 0x03, 0x54,
+	0xc6, 0x8f, 0xae, 0x40, 0x6d, 0xc0, 0xc8, 0x1d, 0x47, 0xef, 0x0a, 0x1f, 0xaa, 0x72, 0xda, 0xae,
+	0xde, 0x75, 0xd5, 0x1f, 0x94, 0xa0, 0xf8, 0x70, 0x8f, 0x3c, 0x26, 0xfa, 0xda, 0x75, 0xa8, 0xfb,
+	0x69, 0x5e, 0x8a, 0xbb, 0x49, 0x8f, 0xba, 0xc3, 0x03, 0xf0, 0x39, 0xb6, 0x1d, 0xbf, 0x3c, 0x14,
+	0x8f, 0xe8, 0x16, 0x14, 0x1d, 0x57, 0x77, 0x87, 0x4e, 0x2b, 0xcf, 0x8f, 0x2b, 0x9e, 0x99, 0xd9,
+	0xab, 0x97, 0x77, 0xe9, 0xb0, 0xc6, 0xd9, 0xd0, 0x9b, 0x50, 0x71, 0x5c, 0x1b, 0xeb, 0x3d, 0xa2,
+	0x9f, 0x02, 0x0d, 0xa4, 0x26, 0x0f, 0xa4, 0xf2, 0x2e, 0x1d, 0x68, 0xaf, 0x6b, 0x65, 0xc6, 0xd2,
+	0x36, 0x42, 0x87, 0xb0, 0xe2, 0xe9, 0xce, 0xbf, 0xab, 0x50, 0x61, 0x6f, 0x27, 0x32, 0x4a, 0x27,
+	0x90, 0x51, 0x66, 0xd3, 0x56, 0x49, 0xd9, 0xc7, 0xca, 0x13, 0x4c, 0x65, 0x94, 0x4f, 0xb2, 0x0e,
+	0x3e, 0x6f, 0xd5, 0x45, 0xf7, 0xa0, 0xe5, 0x6b, 0x9b, 0xe8, 0xc9, 0xd0, 0x5d, 0xbd, 0xd3, 0xb7,
+	0xfa, 0xfb, 0xb8, 0x55, 0xa1, 0xaa, 0x98, 0xe4, 0xaa, 0x28, 0x6c, 0x13, 0xa2, 0x36, 0xeb, 0xb1,
+	0x6f, 0x71, 0x6e, 0x4a, 0x47, 0x6f, 0x02, 0x8a, 0x0a, 0x6a, 0x01, 0x35, 0xdd, 0x54, 0x64, 0x0e,
+	0x7a, 0x03, 0xd0, 0x81, 0x79, 0x1c, 0x2e, 0xe4, 0xaa, 0x14, 0x4a, 0x9b, 0x74, 0x44, 0xae, 0xe0,
+	0x36, 0x61, 0x2a, 0x7a, 0x24, 0xac, 0x8d, 0x2e, 0x21, 0x9b, 0x76, 0x88, 0x82, 0x1e, 0xc3, 0x85,
+	0xf8, 0x33, 0xe0, 0xe4, 0x98, 0x67, 0xc0, 0x19, 0x9c, 0x70, 0xf8, 0x73, 0x2d, 0x57, 0xef, 0xb2,
+	0x6d, 0xd4, 0xe9, 0x36, 0x2a, 0x94, 0x42, 0xd7, 0xbf, 0x08, 0x55, 0xb3, 0xdf, 0x35, 0xfb, 0x98,
+	0x8d, 0x37, 0xe8, 0x38, 0x30, 0x92, 0x60, 0xb0, 0x71, 0xcf, 0x72, 0x39, 0x43, 0x93, 0x31, 0x30,
+	0x12, 0x61, 0x50, 0x3f, 0x84, 0x22, 0xf3, 0x5a, 0x54, 0x85, 0x52, 0x7b, 0xfb, 0xa3, 0xd5, 0x07,
+	0xed, 0xf5, 0xe6, 0x04, 0x9a, 0x84, 0xca, 0xe3, 0x9d, 0x07, 0x0f, 0x57, 0xd7, 0xdb, 0xdb, 0xf7,
+	0x9a, 0x19, 0x54, 0x07, 0xb8, 0xf3, 0x70, 0x6b, 0xab, 0xfd, 0xe8, 0x11, 0x79, 0xce, 0x92, 0x61,
+	0xfe, 0xbc, 0xb1, 0xde, 0xcc, 0xa1, 0x1a, 0x94, 0xd7, 0x37, 0x1e, 0x6c, 0xd0, 0xc1, 0xbc, 0xfa,
+	0xc7, 0x2c, 0x20, 0x16, 0x10, 0x6b, 0xf8, 0xd0, 0xec, 0x4b, 0xe7, 0xb4, 0xf3, 0x89, 0xcb, 0xa0,
+	0xbf, 0xe6, 0x4f, 0xe7, 0xaf, 0xb1, 0x9e, 0x50, 0x3a, 0x53, 0x4f, 0x28, 0xbf, 0x8c, 0x27, 0xa8,
+	0xbf, 0xce, 0xc2, 0x74, 0x40, 0xab, 0x1c, 0x1c, 0xcf, 0x4d, 0xad, 0x01, 0xf4, 0xca, 0x8f, 0x44,
+	0xaf, 0x58, 0x05, 0x16, 0xce, 0x54, 0x81, 0xc5, 0x97, 0x52, 0xe0, 0xaf, 0x32, 0x42, 0x81, 0x81,
+	0x13, 0x49, 0x70, 0x9f, 0x99, 0x91, 0xfb, 0x4c, 0x03, 0xb6, 0xec, 0xcb, 0x03, 0x5b, 0x2e, 0x01,
+	0xd8, 0x48, 0x4f, 0x24, 0xb8, 0x7a, 0x7e, 0xcc, 0x7f, 0x06, 0x4d, 0x46, 0x97, 0xba, 0x37, 0xe7,
+	0xe5, 0x13, 0xa4, 0x05, 0x24, 0xbd, 0xcc, 0x6f, 0x01, 0x59, 0x94, 0x18, 0x6d, 0x01, 0x31, 0x66,
+	0x8d, 0x8f, 0xab, 0xdf, 0xcd, 0x8a, 0xf9, 0xa1, 0x06, 0x4e, 0xec, 0x6a, 0x5f, 0x87, 0xa6, 0xb4,
+	0x5a, 0xb9, 0x4c, 0x6c, 0xf8, 0xeb, 0xa5, 0xe4, 0x20, 0x2b, 0xef, 0x06, 0xe5, 0x42, 0xac, 0x77,
+	0x28, 0x39, 0x58, 0x1a, 0xe6, 0x13, 0x4b, 0xc3, 0x82, 0x5c, 0x1a, 0xb6, 0xa1, 0xc1, 0x76, 0xd0,
+	0x31, 0xfb, 0xfb, 0xdd, 0xa1, 0x81, 0x7d, 0x5f, 0x0c, 0x6d, 0x55, 0xb4, 0x82, 0xda, 0x9c, 0x4f,
+	0xab, 0xb3, 0x89, 0xe2, 0x99, 0x74, 0x98, 0x64, 0x0d, 0x8c, 0xec, 0x30, 0x05, 0xc5, 0xa6, 0x75,
+	0x98, 0x7e, 0x93, 0x83, 0x7a, 0x90, 0x3b, 0xc6, 0xde, 0x99, 0x11, 0xf6, 0xce, 0x26, 0x95, 0x3c,
+	0xb9, 0xf1, 0x4a, 0x9e, 0x60, 0x0d, 0x93, 0x3f, 0x83, 0x1a, 0xa6, 0x70, 0x06, 0x35, 0x4c, 0xf1,
+	0xec, 0x6b, 0x98, 0xd2, 0xcb, 0x87, 0x7a, 0x39, 0x29, 0xd4, 0xff, 0x03, 0x66, 0xe3, 0xbd, 0x09,
+	0x29, 0x50, 0xf6, 0xa6, 0x67, 0x58, 0x2d, 0x2f, 0x9e, 0x55, 0x07, 0x5a, 0x52, 0x7e, 0x08, 0x36,
+	0x59, 0xcf, 0x0d, 0x10, 0xee, 0xc3, 0xc5, 0x98, 0x97, 0x72, 0xaf, 0x3e, 0x19, 0xb2, 0xfa, 0xb2,
+	0xee, 0x9a, 0x7d, 0xd3, 0x39, 0x0a, 0xee, 0xe0, 0x84, 0xb2, 0x2e, 0x81, 0x12, 0x27, 0x8b, 0x63,
+	0xe6, 0xdf, 0xb3, 0x50, 0xdd, 0xd5, 0x5d, 0x31, 0xef, 0xfc, 0x72, 0xe8, 0x4b, 0xf5, 0x26, 0xdb,
+	0x30, 0x49, 0x63, 0x82, 0x64, 0x41, 0x43, 0x77, 0xf1, 0x89, 0x42, 0xa1, 0x26, 0xa6, 0xae, 0xeb,
+	0x2e, 0x46, 0x5b, 0xd0, 0xf0, 0x3b, 0x8e, 0x4c, 0xd8, 0x49, 0x62, 0xa2, 0xee, 0x4f, 0xa6, 0xe2,
+	0x6e, 0xc1, 0xb4, 0xa3, 0xbb, 0xb8, 0xdb, 0x35, 0x69, 0x61, 0x79, 0xd8, 0xd7, 0xdd, 0xa1, 0xcd,
+	0xeb, 0x7a, 0x0d, 0x79, 0x43, 0xbb, 0x62, 0x44, 0xfd, 0x6b, 0x16, 0x4a, 0xbc, 0xee, 0x3e, 0x69,
+	0xbe, 0xfd, 0x4f, 0x28, 0x0f, 0x2c, 0xc7, 0x74, 0x05, 0x3a, 0x55, 0x57, 0x2e, 0xfa, 0x20, 0xc4,
+	0x65, 0xee, 0x70, 0x06, 0xcd, 0x63, 0x45, 0xef, 0xc1, 0xb4, 0x6f, 0xba, 0x67, 0xf8, 0x05, 0x0f,
+	0xdb, 0x5c, 0x5c, 0xd8, 0xfa, 0x21, 0xf8, 0x01, 0x7e, 0xc1, 0x22, 0xf6, 0x2a, 0x4c, 0x06, 0xa6,
+	0xf3, 0x16, 0x43, 0x4d, 0xe6, 0x44, 0xcb, 0x30, 0x4d, 0xaa, 0x6a, 0xa9, 0x7b, 0x4c, 0x03, 0x93,
+	0x75, 0x8d, 0xa7, 0xc8, 0x90, 0xd7, 0x36, 0x5e, 0x27, 0x67, 0x93, 0x15, 0xaf, 0xb0, 0xc1, 0x46,
+	0x87, 0xd7, 0xed, 0x74, 0x06, 0xbb, 0xd4, 0xf1, 0x17, 0xdc, 0xa6, 0x63, 0x74, 0xce, 0x6b, 0x50,
+	0xa4, 0x2d, 0x5b, 0xd2, 0x91, 0x20, 0xa9, 0xa1, 0xe1, 0x6f, 0x9e, 0xf6, 0x62, 0x34, 0x3e, 0xac,
+	0x6e, 0x42, 0x81, 0x12, 0xc8, 0x81, 0x9f, 0x92, 0x3a, 0xfd, 0x61, 0x8f, 0xea, 0xb7, 0xa0, 0x95,
+	0x29, 0x61, 0x7b, 0xd8, 0x43, 0x2a, 0xe4, 0xfb, 0x96, 0x21, 0x2a, 0x95, 0x3a, 0xd7, 0x43, 0x91,
+	0x34, 0xec, 0xdb, 0xeb, 0x1a, 0x1d, 0x53, 0x37, 0xa1, 0x11, 0xd2, 0x2b, 0x39, 0x46, 0x90, 0x83,
+	0x3d, 0x11, 0xb9, 0xc7, 0x3b, 0x9d, 0x05, 0x8d, 0x9e, 0xfe, 0xb7, 0x29, 0x85, 0xe4, 0x4d, 0xb3,
+	0x6f, 0xe0, 0x63, 0x71, 0xd9, 0x42, 0x1f, 0xd4, 0x9f, 0x65, 0x60, 0x9a, 0x8b, 0x0a, 0x1c, 0x05,
+	0x5e, 0x8d, 0x0b, 0xdc, 0x80, 0x06, 0xe9, 0xed, 0xd3, 0xfe, 0x2e, 0xeb, 0x89, 0xf1, 0x96, 0xda,
+	0x64, 0x4f, 0x3f, 0xf6, 0x5b, 0x60, 0xea, 0xef, 0x33, 0x30, 0x13, 0x5c, 0x25, 0xc7, 0xaf, 0xb7,
+	0x00, 0xc4, 0x29, 0xd2, 0x5b, 0xe7, 0x14, 0x5f, 0x67, 0x85, 0xcf, 0x68, 0xaf, 0x6b, 0x15, 0xce,
+	0xd4, 0x8e, 0x6f, 0xc3, 0x65, 0xcf, 0xa2, 0x0d, 0x77, 0x82, 0x7e, 0xe9, 0xcf, 0xb3, 0xde, 0x76,
+	0x82, 0x85, 0xee, 0xc9, 0xb7, 0x93, 0x10, 0x44, 0xd9, 0xd3, 0x06, 0x51, 0x6e, 0xfc, 0x20, 0xca,
+	0x27, 0x05, 0xd1, 0x3d, 0x98, 0x1c, 0x0e, 0x48, 0x57, 0xbb, 0x63, 0x63, 0x67, 0xd8, 0x75, 0x79,
+	0x1f, 0x5f, 0x8d, 0x7a, 0x04, 0xd1, 0xd1, 0xe3, 0x01, 0x6f, 0x80, 0x93, 0xfb, 0xdb, 0xda, 0x50,
+	0x7a, 0x52, 0xbf, 0xef, 0xf7, 0x53, 0x23, 0xac, 0xe9, 0x41, 0xf4, 0x1a, 0x94, 0xe8, 0x7d, 0x98,
+	0x69, 0x24, 0xc4, 0x51, 0x91, 0x0c, 0xb7, 0x0d, 0x74, 0x1d, 0xf2, 0x47, 0xba, 0x73, 0xc4, 0xff,
+	0xcb, 0x30, 0x25, 0xae, 0x1a, 0xe8, 0xeb, 0x36, 0x75, 0xe7, 0x48, 0xa3, 0xc3, 0xea, 0xbf, 0xb2,
+	0x50, 0x23, 0xe9, 0x48, 0x98, 0x00, 0xad, 0x84, 0xe3, 0xa3, 0xba, 0x72, 0x41, 0xda, 0x9f, 0xee,
+	0xc6, 0x04, 0x49, 0x28, 0x44, 0xb3, 0xc9, 0x21, 0x9a, 0x93, 0x42, 0x34, 0x7a, 0x2f, 0x54, 0x18,
+	0xe3, 0x5e, 0xe8, 0x43, 0xb8, 0xe0, 0xdd, 0xa6, 0x48, 0xe1, 0x45, 0xaa, 0xe2, 0x31, 0x7c, 0x7d,
+	0x5a, 0xcc, 0xf5, 0x69, 0x4e, 0x34, 0xd9, 0x95, 0x4e, 0x9d, 0xec, 0x12, 0xb2, 0x53, 0x39, 0x31,
+	0x3b, 0xcd, 0xc1, 0x85, 0x50, 0xc0, 0xf0, 0x3a, 0xe1, 0xa7, 0x59, 0xcf, 0x45, 0xb6, 0xf4, 0x67,
+	0x98, 0xc1, 0xf2, 0xab, 0x05, 0xb1, 0x57, 0x91, 0xc7, 0x12, 0xf3, 0x52, 0x21, 0x31, 0x2f, 0xb1,
+	0xee, 0x6e, 0x44, 0x33, 0x5c, 0x6f, 0x16, 0x5c, 0x94, 0x01, 0x35, 0x58, 0xc9, 0xcd, 0x47, 0xf4,
+	0xf6, 0xd2, 0x5a, 0x52, 0xbf, 0xf2, 0x2f, 0xbd, 0xe2, 0x0a, 0xd1, 0x6f, 0x26, 0x90, 0xff, 0xd0,
+	0xdf, 0x54, 0x5c, 0x45, 0x7c, 0xf2, 0x4d, 0xdd, 0x86, 0x12, 0xc3, 0x4c, 0xb1, 0x97, 0x04, 0xd0,
+	0xf4, 0xb4, 0x47, 0x40, 0x53, 0x4c, 0x89, 0xe0, 0xa5, 0xcc, 0xf5, 0x6a, 0xf1, 0x72, 0x01, 0xe6,
+	0x63, 0xf5, 0xc2, 0xbd, 0xef, 0x8b, 0x0c, 0x20, 0x3e, 0x2e, 0xb7, 0x19, 0x52, 0xfd, 0x6e, 0x0d,
+	0x1a, 0xac, 0x6d, 0xd0, 0x19, 0xdf, 0xfd, 0xea, 0x6c, 0x86, 0x78, 0xf6, 0x7b, 0x07, 0x39, 0xa9,
+	0x77, 0xa0, 0x3e, 0x85, 0xe9, 0xc0, 0x62, 0xb8, 0x4b, 0xde, 0x0a, 0x9e, 0xf8, 0xa3, 0xaf, 0x19,
+	0xe7, 0xc8, 0xef, 0x57, 0x6a, 0x82, 0x3b, 0x10, 0x40, 0x99, 0xf1, 0x03, 0xe8, 0x8b, 0x0c, 0xcc,
+	0x46, 0x6e, 0x8d, 0x4f, 0x85, 0x73, 0x67, 0xa0, 0x49, 0xf5, 0x97, 0x39, 0x98, 0x8b, 0xac, 0xe6,
+	0x9b, 0x1c, 0xcb, 0xc9, 0x10, 0x9b, 0x4f, 0x2e, 0xfd, 0xaf, 0x40, 0x2d, 0xe6, 0xdf, 0x28, 0x55,
+	0x47, 0xba, 0xbf, 0x48, 0xc8, 0x0e, 0xc5, 0xd3, 0x66, 0x87, 0x52, 0x4c, 0x76, 0x78, 0x13, 0xf2,
+	0x7d, 0x7c, 0x2c, 0x2e, 0x82, 0x52, 0xac, 0x48, 0xd9, 0xd4, 0xbb, 0x50, 0x5b, 0xd3, 0xdd, 0xfd,
+	0x23, 0xe1, 0x3e, 0xff, 0x05, 0x65, 0x9b, 0xfd, 0x14, 0xbe, 0xae, 0xf8, 0x22, 0x64, 0x4e, 0xea,
+	0xec, 0x1e, 0xaf, 0xfa, 0x37, 0x80, 0x66, 0x78, 0x18, 0xad, 0xc3, 0x24, 0xbf, 0x93, 0x64, 0xdd,
+	0x22, 0xee, 0xe2, 0x0b, 0xe1, 0x7f, 0x64, 0x05, 0xfe, 0x80, 0xb8, 0x39, 0xa1, 0xd5, 0xf6, 0x24,
+	0x32, 0x39, 0x95, 0x73, 0x29, 0x87, 0xd8, 0xff, 0xb7, 0x63, 0x48, 0x84, 0xdf, 0x4e, 0xdd, 0x9c,
+	0xd0, 0x2a, 0x7b, 0x82, 0x26, 0x2d, 0xc1, 0xa0, 0xb0, 0xd3, 0xca, 0xc5, 0x2f, 0x21, 0x00, 0xd6,
+	0xfe, 0x12, 0x18, 0x19, 0xfd, 0x8f, 0x77, 0xb9, 0xda, 0x35, 0x1d, 0xd7, 0xeb, 0x0c, 0xc4, 0xfc,
+	0xb1, 0xcc, 0x97, 0x00, 0x7b, 0x1e, 0x11, 0x7d, 0x0a, 0xb3, 0x7c, 0xbe, 0x83, 0xdd, 0x8e, 0xee,
+	0x5f, 0xb2, 0xf2, 0x26, 0xc1, 0xf5, 0xb0, 0xa8, 0xd8, 0x6b, 0xde, 0xcd, 0x09, 0x6d, 0x66, 0x2f,
+	0x66, 0x18, 0xad, 0x42, 0x8d, 0x37, 0x3c, 0xf7, 0x48, 0x3a, 0xe5, 0xcd, 0x82, 0x4b, 0xe1, 0xee,
+	0x9f, 0x7c, 0xa8, 0xdb, 0x9c, 0xd0, 0xaa, 0x96, 0x4f, 0x25, 0x7a, 0xe2, 0x22, 0xf6, 0x69, 0x51,
+	0xd5, 0x2a, 0x85, 0xf5, 0x14, 0xd3, 0x8c, 0x27, 0x7a, 0xb2, 0x24, 0x32, 0x31, 0x15, 0x97, 0x72,
+	0x88, 0x85, 0x0b, 0x2a, 0x61, 0x11, 0x41, 0x53, 0x59, 0x82, 0x46, 0x94, 0xcc, 0x27, 0x53, 0x25,
+	0x57, 0xc2, 0x4a, 0x8e, 0xb4, 0xa2, 0x89, 0x92, 0x2d, 0x8f, 0x88, 0x1e, 0xc1, 0xb4, 0xac, 0x05,
+	0x61, 0x70, 0x58, 0xca, 0x04, 0x73, 0x67, 0x52, 0xdb, 0x6d, 0x73, 0x42, 0x9b, 0xb2, 0xc2, 0x63,
+	0xe8, 0x09, 0xcc, 0x70, 0xa9, 0x07, 0x34, 0x7b, 0x09, 0xb1, 0x55, 0x2a, 0xf6, 0x6a, 0x58, 0x6c,
+	0x4c, 0xea, 0xdf, 0x9c, 0xd0, 0x90, 0x15, 0x19, 0x24, 0x1a, 0x17, 0x78, 0xc1, 0xac, 0x56, 0x0b,
+	0x6b, 0x3c, 0xe6, 0x2c, 0x4e, 0x34, 0xee, 0x48, 0x64, 0x74, 0x0f, 0xea, 0x42, 0x0a, 0x37, 0x1c,
+	0xbb, 0xc1, 0xbc, 0x1c, 0x11, 0x13, 0xb6, 0xdc, 0xa4, 0x23, 0xd3, 0x89, 0xf6, 0x84, 0xa0, 0x9e,
+	0xfe, 0x0c, 0x73, 0xd4, 0x6b, 0xd5, 0xc3, 0xda, 0x4b, 0x2a, 0xb0, 0x89, 0xf6, 0x9c, 0xf0, 0x18,
+	0xd1, 0x5e, 0x60, 0x93, 0x42, 0x7b, 0x8d, 0xb0, 0xf6, 0x12, 0x0b, 0x50, 0xa2, 0x3d, 0x27, 0x32,
+	0x88, 0x9e, 0xc2, 0x05, 0x21, 0x38, 0x68, 0x97, 0x26, 0x95, 0x7c, 0x2d, 0x22, 0x39, 0xde, 0x30,
+	0xd3, 0x4e, 0x74, 0x94, 0x84, 0x93, 0x90, 0x4d, 0x3d, 0x71, 0x2a, 0x1c, 0x4e, 0xd1, 0x72, 0x85,
+	0x84, 0x93, 0xe3, 0x53, 0xd1, 0x16, 0x34, 0x85, 0x08, 0x83, 0xa7, 0xc4, 0x16, 0x0a, 0xdf, 0x41,
+	0xc4, 0x67, 0xf0, 0xcd, 0x09, 0xad, 0xe1, 0x04, 0x47, 0xd6, 0x2a, 0x50, 0xe2, 0xa3, 0xea, 0x7d,
+	0x98, 0xe4, 0x38, 0xcb, 0x33, 0xec, 0x7f, 0x93, 0x1b, 0x12, 0xf6, 0x5b, 0x40, 0xf6, 0x7c, 0x04,
+	0xb2, 0xd9, 0x38, 0xc5, 0x6c, 0x9f, 0x5b, 0xfd, 0x27, 0xc0, 0x54, 0x84, 0x01, 0x6d, 0xc4, 0xa3,
+	0xf6, 0xe5, 0x24, 0xd4, 0x66, 0x53, 0x23, 0xb0, 0x7d, 0x3b, 0x06, 0xb6, 0xe7, 0x63, 0x61, 0xdb,
+	0x13, 0x20, 0xe1, 0xf6, 0x46, 0x3c, 0x6e, 0x5f, 0x4e, 0xc2, 0xed, 0xf0, 0x22, 0xb8, 0x29, 0xdf,
+	0x8f, 0x03, 0xee, 0x4b, 0xf1, 0xc0, 0xed, 0x89, 0x90, 0x91, 0xfb, 0x5b, 0x23, 0x90, 0xfb, 0xc6,
+	0x28, 0xe4, 0xf6, 0xa4, 0xc6, 0x43, 0xf7, 0x5a, 0x2c, 0x74, 0x2f, 0x24, 0x40, 0xb7, 0x27, 0x2c,
+	0x80, 0xdd, 0x1b, 0xf1, 0xd8, 0x7d, 0x39, 0x09, 0xbb, 0x7d, 0x5d, 0x05, 0xc0, 0xfb, 0x76, 0x0c,
+	0x78, 0xcf, 0xc7, 0x82, 0xb7, 0x6f, 0x30, 0x1f, 0xbd, 0xdf, 0x8f, 0x43, 0xef, 0x4b, 0xf1, 0xe8,
+	0xed, 0x6b, 0x5a, 0x82, 0xef, 0xc7, 0x69, 0xf0, 0x7d, 0x35, 0x15, 0xbe, 0x3d, 0x79, 0x31, 0xf8,
+	0xfd, 0x71, 0x2a, 0x7e, 0x5f, 0x4b, 0xc7, 0x6f, 0x4f, 0x70, 0x1c, 0x80, 0x6f, 0xc4, 0x03, 0xf8,
+	0xe5, 0x24, 0x00, 0xf7, 0xd5, 0x1e, 0x40, 0xf0, 0xcd, 0x04, 0x04, 0x5f, 0x4c, 0x44, 0x70, 0x4f,
+	0x50, 0x08, 0xc2, 0x1f, 0xa7, 0x41, 0xf8, 0xd5, 0x54, 0x08, 0xf7, 0x35, 0x18, 0xc5, 0xf0, 0x8f,
+	0x53, 0x31, 0xfc, 0x5a, 0x3a, 0x86, 0xfb, 0x1a, 0x8c, 0x01, 0xf1, 0xff, 0x4f, 0x07, 0xf1, 0xeb,
+	0x23, 0x40, 0xdc, 0x93, 0x1d, 0x8b, 0xe2, 0x6b, 0xb1, 0x28, 0xbe, 0x90, 0x80, 0xe2, 0x7e, 0x64,
+	0xc9, 0x30, 0xbe, 0x9d, 0x08, 0xe3, 0x57, 0x52, 0x60, 0xdc, 0x93, 0x15, 0xc1, 0x71, 0x80, 0xb2,
+	0x77, 0xee, 0x5d, 0x86, 0x69, 0x0d, 0x3f, 0xb7, 0x9e, 0xe1, 0xd5, 0x9d, 0x36, 0x39, 0x7f, 0xf0,
+	0x02, 0x7c, 0x0e, 0x4a, 0xfa, 0xc0, 0xa4, 0xe5, 0x3d, 0xbf, 0xdd, 0xd2, 0x07, 0x26, 0xe9, 0x2f,
+	0xcc, 0xc2, 0x4c, 0x90, 0x9f, 0xcb, 0xf9, 0x71, 0xc6, 0xeb, 0x87, 0x6d, 0x59, 0xcf, 0xcf, 0xfe,
+	0x0f, 0xfb, 0x17, 0xa1, 0xdc, 0xc7, 0x9f, 0xb1, 0xfb, 0x34, 0x76, 0xfc, 0x29, 0xf5, 0xf1, 0x67,
+	0xf4, 0x26, 0x4d, 0xbe, 0xdf, 0x64, 0xcd, 0x27, 0xef, 0x59, 0x6d, 0xc1, 0x6c, 0x60, 0x55, 0xde,
+	0x9f, 0x09, 0x57, 0x7e, 0x32, 0x0d, 0xe5, 0x2d, 0xae, 0x3c, 0xb4, 0x05, 0x35, 0x96, 0x2f, 0xf8,
+	0x37, 0x6a, 0xe9, 0x67, 0x03, 0x65, 0x44, 0x12, 0x42, 0xeb, 0x50, 0xb9, 0x87, 0x5d, 0x2e, 0x2b,
+	0xe5, 0x90, 0xa0, 0xa4, 0x65, 0x22, 0xb2, 0x28, 0xe6, 0x44, 0x49, 0x8b, 0x0a, 0x94, 0x11, 0xca,
+	0x88, 0xa4, 0x84, 0x36, 0xa1, 0x4a, 0xbc, 0x89, 0x8d, 0x39, 0x28, 0xed, 0xdc, 0xa0, 0xa4, 0xe6,
+	0x26, 0x84, 0xc9, 0x5d, 0x01, 0x17, 0x24, 0x67, 0x91, 0xf1, 0xce, 0x0f, 0xca, 0x98, 0xc9, 0x0a,
+	0xdd, 0x87, 0x2a, 0x0d, 0x53, 0xfe, 0x17, 0xcd, 0xd4, 0x83, 0x84, 0x92, 0x9e, 0xab, 0xa8, 0x81,
+	0x29, 0x3c, 0x71, 0x61, 0xe9, 0x27, 0x0a, 0x65, 0x44, 0xd2, 0xe2, 0x06, 0xe6, 0xb2, 0x52, 0x8e,
+	0x16, 0x4a, 0x5a, 0xe6, 0x12, 0x16, 0x61, 0x03, 0x01, 0x8b, 0x44, 0x0e, 0x19, 0x4a, 0x6a, 0x0e,
+	0x43, 0x9f, 0xc0, 0x94, 0x84, 0x68, 0x7c, 0x5d, 0x63, 0x1c, 0x36, 0x94, 0x71, 0x32, 0x1a, 0xea,
+	0x00, 0x92, 0x31, 0x8d, 0x8b, 0x1f, 0xe7, 0xd0, 0xa1, 0x8c, 0x95, 0xd9, 0x88, 0x75, 0xe8, 0x7b,
+	0xc5, 0x6d, 0x6f, 0xfa, 0xe9, 0x43, 0x19, 0x91, 0xdb, 0xd0, 0x0e, 0x4c, 0x32, 0x7b, 0x09, 0x79,
+	0x23, 0x8e, 0x21, 0xca, 0xa8, 0x24, 0x47, 0xf4, 0xeb, 0xa7, 0x22, 0x21, 0x75, 0x8c, 0xe3, 0x88,
+	0x32, 0x4e, 0xbe, 0x23, 0xfa, 0x95, 0xd4, 0x2e, 0xc4, 0x8f, 0x73, 0x2c, 0x51, 0xc6, 0xca, 0x7b,
+	0x68, 0x0f, 0xa6, 0x65, 0xbd, 0x8b, 0x37, 0x8c, 0x75, 0x3c, 0x51, 0xc6, 0xcb, 0x7f, 0xe8, 0x03,
+	0xa8, 0xc9, 0xff, 0x5b, 0x47, 0xa9, 0x07, 0x15, 0x25, 0x3d, 0x01, 0xa2, 0x8f, 0xa0, 0x21, 0xb2,
+	0x95, 0x58, 0xec, 0xc8, 0x13, 0x8b, 0x32, 0x3a, 0x19, 0xa2, 0x77, 0xa0, 0x40, 0x4f, 0x1a, 0x68,
+	0x36, 0xbe, 0x9d, 0xa4, 0xcc, 0x25, 0x9c, 0x59, 0xd0, 0x13, 0x68, 0x32, 0x90, 0xe7, 0xa2, 0x49,
+	0x66, 0x8b, 0x2e, 0x29, 0xf4, 0xb1, 0x9a, 0x72, 0x25, 0x89, 0xc3, 0xff, 0x0c, 0xe0, 0xff, 0xa0,
+	0x19, 0x70, 0x56, 0x42, 0xbb, 0x92, 0xee, 0xaf, 0x44, 0xb2, 0x3a, 0xc2, 0x65, 0x89, 0x98, 0x5d,
+	0xa8, 0x4b, 0x5f, 0xa9, 0x10, 0x4a, 0xd4, 0xd1, 0x83, 0x9f, 0xc7, 0x28, 0x4b, 0x09, 0x0c, 0xbe,
+	0xd0, 0x0e, 0xa0, 0x90, 0x69, 0x08, 0xf5, 0xea, 0x28, 0xeb, 0x10, 0xe1, 0xd7, 0x46, 0x1a, 0x88,
+	0x2b, 0x24, 0xe0, 0xa6, 0xf1, 0x0a, 0x09, 0x7f, 0x2f, 0xa3, 0xa8, 0x89, 0x2c, 0xbe, 0xe8, 0x8f,
+	0xa0, 0x21, 0xfb, 0x68, 0xc8, 0x86, 0xf1, 0x9f, 0xa1, 0x28, 0x57, 0x92, 0x38, 0x7c, 0xb9, 0x9f,
+	0xc0, 0x54, 0x30, 0x87, 0x11, 0x62, 0x60, 0x41, 0xf1, 0x9f, 0x4b, 0x28, 0x57, 0x93, 0x79, 0x7c,
+	0xe9, 0xf7, 0xa1, 0x2a, 0x7d, 0xe0, 0x20, 0x07, 0x56, 0xf4, 0x6b, 0x08, 0x65, 0x21, 0x61, 0xd4,
+	0x47, 0x5a, 0xb9, 0x7c, 0x93, 0x91, 0x36, 0xa6, 0x0c, 0x54, 0x2e, 0x27, 0x0d, 0x73, 0x71, 0xbb,
+	0x50, 0x27, 0x75, 0x95, 0x64, 0xa9, 0xa8, 0x87, 0x05, 0xcb, 0x41, 0x65, 0x29, 0x81, 0xc1, 0xdb,
+	0xef, 0x5a, 0xfe, 0x69, 0x76, 0xb0, 0xb7, 0x57, 0xa4, 0x97, 0xb7, 0x6f, 0xff, 0x7b, 0x00, 0xd2,
+	0x14, 0x53, 0x9c, 0xbe, 0x40, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5152,6 +5330,8 @@ type MetainfoClient interface {
 	ListSegmentsOld(ctx context.Context, in *ListSegmentsRequestOld, opts ...grpc.CallOption) (*ListSegmentsResponseOld, error)
 	SetAttributionOld(ctx context.Context, in *SetAttributionRequestOld, opts ...grpc.CallOption) (*SetAttributionResponseOld, error)
 	ProjectInfo(ctx context.Context, in *ProjectInfoRequest, opts ...grpc.CallOption) (*ProjectInfoResponse, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+	MoveSegmentOld(ctx context.Context, in *SegmentMoveRequestOld, opts ...grpc.CallOption) (*SegmentMoveResponseOld, error)
 }
 
 type metainfoClient struct {
@@ -5405,6 +5585,24 @@ func (c *metainfoClient) ProjectInfo(ctx context.Context, in *ProjectInfoRequest
 	return out, nil
 }
 
+func (c *metainfoClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/RevokeAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metainfoClient) MoveSegmentOld(ctx context.Context, in *SegmentMoveRequestOld, opts ...grpc.CallOption) (*SegmentMoveResponseOld, error) {
+	out := new(SegmentMoveResponseOld)
+	err := c.cc.Invoke(ctx, "/metainfo.Metainfo/MoveSegmentOld", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MetainfoServer is the server API for Metainfo service.
 type MetainfoServer interface {
 	// Bucket
@@ -5436,6 +5634,8 @@ type MetainfoServer interface {
 	ListSegmentsOld(context.Context, *ListSegmentsRequestOld) (*ListSegmentsResponseOld, error)
 	SetAttributionOld(context.Context, *SetAttributionRequestOld) (*SetAttributionResponseOld, error)
 	ProjectInfo(context.Context, *ProjectInfoRequest) (*ProjectInfoResponse, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	MoveSegmentOld(context.Context, *SegmentMoveRequestOld) (*SegmentMoveResponseOld, error)
 }
 
 func RegisterMetainfoServer(s *grpc.Server, srv MetainfoServer) {
@@ -5928,6 +6128,42 @@ func _Metainfo_ProjectInfo_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Metainfo_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/RevokeAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Metainfo_MoveSegmentOld_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegmentMoveRequestOld)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetainfoServer).MoveSegmentOld(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/metainfo.Metainfo/MoveSegmentOld",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetainfoServer).MoveSegmentOld(ctx, req.(*SegmentMoveRequestOld))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Metainfo_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "metainfo.Metainfo",
 	HandlerType: (*MetainfoServer)(nil),
@@ -6040,6 +6276,14 @@ var _Metainfo_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProjectInfo",
 			Handler:    _Metainfo_ProjectInfo_Handler,
 		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _Metainfo_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "MoveSegmentOld",
+			Handler:    _Metainfo_MoveSegmentOld_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "metainfo.proto",
@@ -23,6 +23,7 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type SegmentMeta struct {
 	EncryptedKey         []byte   `protobuf:"bytes,1,opt,name=encrypted_key,json=encryptedKey,proto3" json:"encrypted_key,omitempty"`
 	KeyNonce             []byte   `protobuf:"bytes,2,opt,name=key_nonce,json=keyNonce,proto3" json:"key_nonce,omitempty"`
+	EncryptedSegmentInfo []byte   `protobuf:"bytes,3,opt,name=encrypted_segment_info,json=encryptedSegmentInfo,proto3" json:"encrypted_segment_info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -66,11 +67,22 @@ func (m *SegmentMeta) GetKeyNonce() []byte {
 	return nil
 }
 
+func (m *SegmentMeta) GetEncryptedSegmentInfo() []byte {
+	if m != nil {
+		return m.EncryptedSegmentInfo
+	}
+	return nil
+}
+
 type StreamInfo struct {
 	DeprecatedNumberOfSegments int64    `protobuf:"varint,1,opt,name=deprecated_number_of_segments,json=deprecatedNumberOfSegments,proto3" json:"deprecated_number_of_segments,omitempty"`
 	SegmentsSize               int64    `protobuf:"varint,2,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
 	LastSegmentSize            int64    `protobuf:"varint,3,opt,name=last_segment_size,json=lastSegmentSize,proto3" json:"last_segment_size,omitempty"`
 	Metadata                   []byte   `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	UncompressedSize           int64    `protobuf:"varint,5,opt,name=uncompressed_size,json=uncompressedSize,proto3" json:"uncompressed_size,omitempty"`
+	CompressedBlockSizes       []int64  `protobuf:"varint,6,rep,packed,name=compressed_block_sizes,json=compressedBlockSizes,proto3" json:"compressed_block_sizes,omitempty"`
+	Sha256Checksum             []byte   `protobuf:"bytes,7,opt,name=sha256_checksum,json=sha256Checksum,proto3" json:"sha256_checksum,omitempty"`
+	Md5Checksum                []byte   `protobuf:"bytes,8,opt,name=md5_checksum,json=md5Checksum,proto3" json:"md5_checksum,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
 	XXX_unrecognized           []byte   `json:"-"`
 	XXX_sizecache              int32    `json:"-"`
@@ -128,12 +140,42 @@ func (m *StreamInfo) GetMetadata() []byte {
 	return nil
 }
 
+func (m *StreamInfo) GetUncompressedSize() int64 {
+	if m != nil {
+		return m.UncompressedSize
+	}
+	return 0
+}
+
+func (m *StreamInfo) GetCompressedBlockSizes() []int64 {
+	if m != nil {
+		return m.CompressedBlockSizes
+	}
+	return nil
+}
+
+func (m *StreamInfo) GetSha256Checksum() []byte {
+	if m != nil {
+		return m.Sha256Checksum
+	}
+	return nil
+}
+
+func (m *StreamInfo) GetMd5Checksum() []byte {
+	if m != nil {
+		return m.Md5Checksum
+	}
+	return nil
+}
+
 type StreamMeta struct {
 	EncryptedStreamInfo  []byte       `protobuf:"bytes,1,opt,name=encrypted_stream_info,json=encryptedStreamInfo,proto3" json:"encrypted_stream_info,omitempty"`
 	EncryptionType       int32        `protobuf:"varint,2,opt,name=encryption_type,json=encryptionType,proto3" json:"encryption_type,omitempty"`
 	EncryptionBlockSize  int32        `protobuf:"varint,3,opt,name=encryption_block_size,json=encryptionBlockSize,proto3" json:"encryption_block_size,omitempty"`
 	LastSegmentMeta      *SegmentMeta `protobuf:"bytes,4,opt,name=last_segment_meta,json=lastSegmentMeta,proto3" json:"last_segment_meta,omitempty"`
 	NumberOfSegments     int64        `protobuf:"varint,5,opt,name=number_of_segments,json=numberOfSegments,proto3" json:"number_of_segments,omitempty"`
+	CompressionType      int32        `protobuf:"varint,6,opt,name=compression_type,json=compressionType,proto3" json:"compression_type,omitempty"`
+	CompressionBlockSize int32        `protobuf:"varint,7,opt,name=compression_block_size,json=compressionBlockSize,proto3" json:"compression_block_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -198,35 +240,97 @@ func (m *StreamMeta) GetNumberOfSegments() int64 {
 	return 0
 }
 
+func (m *StreamMeta) GetCompressionType() int32 {
+	if m != nil {
+		return m.CompressionType
+	}
+	return 0
+}
+
+func (m *StreamMeta) GetCompressionBlockSize() int32 {
+	if m != nil {
+		return m.CompressionBlockSize
+	}
+	return 0
+}
+
+type SegmentInfo struct {
+	CompressedBlockSizes []int64  `protobuf:"varint,1,rep,packed,name=compressed_block_sizes,json=compressedBlockSizes,proto3" json:"compressed_block_sizes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
+func (m *SegmentInfo) String() string { return proto.CompactTextString(m) }
+func (*SegmentInfo) ProtoMessage()    {}
+func (*SegmentInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6bbf8af0ec331d6, []int{3}
+}
+func (m *SegmentInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentInfo.Unmarshal(m, b)
+}
+func (m *SegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentInfo.Marshal(b, m, deterministic)
+}
+func (m *SegmentInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentInfo.Merge(m, src)
+}
+func (m *SegmentInfo) XXX_Size() int {
+	return xxx_messageInfo_SegmentInfo.Size(m)
+}
+func (m *SegmentInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentInfo proto.InternalMessageInfo
+
+func (m *SegmentInfo) GetCompressedBlockSizes() []int64 {
+	if m != nil {
+		return m.CompressedBlockSizes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SegmentMeta)(nil), "streams.SegmentMeta")
 	proto.RegisterType((*StreamInfo)(nil), "streams.StreamInfo")
 	proto.RegisterType((*StreamMeta)(nil), "streams.StreamMeta")
+	proto.RegisterType((*SegmentInfo)(nil), "streams.SegmentInfo")
 }
 
 func init() { proto.RegisterFile("streams.proto", fileDescriptor_c6bbf8af0ec331d6) }
 
 var fileDescriptor_c6bbf8af0ec331d6 = []byte{
-	// 330 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4d, 0x4b, 0xc3, 0x40,
-	0x10, 0xa5, 0x5f, 0x5a, 0xa7, 0xad, 0xd5, 0xa8, 0x50, 0x2a, 0x82, 0xd4, 0x83, 0x22, 0xd2, 0x43,
-	0xfd, 0x03, 0xda, 0x9b, 0x88, 0x2d, 0xa4, 0x9e, 0xbc, 0x2c, 0x9b, 0x74, 0x22, 0x21, 0xcd, 0x6e,
-	0xc8, 0xae, 0x87, 0xed, 0x7f, 0xf0, 0x1f, 0xf9, 0xe3, 0x64, 0x27, 0xd9, 0x26, 0x16, 0x8f, 0xfb,
-	0xe6, 0xf1, 0xe6, 0xbd, 0x37, 0x0b, 0x03, 0xa5, 0x73, 0xe4, 0xa9, 0x9a, 0x66, 0xb9, 0xd4, 0xd2,
-	0x3b, 0x2c, 0x9f, 0x93, 0x25, 0xf4, 0x56, 0xf8, 0x99, 0xa2, 0xd0, 0x6f, 0xa8, 0xb9, 0x77, 0x03,
-	0x03, 0x14, 0x61, 0x6e, 0x32, 0x8d, 0x6b, 0x96, 0xa0, 0x19, 0x35, 0xae, 0x1b, 0x77, 0x7d, 0xbf,
-	0xbf, 0x03, 0x5f, 0xd1, 0x78, 0x97, 0x70, 0x94, 0xa0, 0x61, 0x42, 0x8a, 0x10, 0x47, 0x4d, 0x22,
-	0x74, 0x13, 0x34, 0x0b, 0xfb, 0x9e, 0xfc, 0x34, 0x00, 0x56, 0x24, 0xfe, 0x22, 0x22, 0xe9, 0x3d,
-	0xc3, 0xd5, 0x1a, 0xb3, 0x1c, 0x43, 0x6e, 0x15, 0xc5, 0x57, 0x1a, 0x60, 0xce, 0x64, 0xc4, 0x54,
-	0xb1, 0x54, 0xd1, 0x82, 0x96, 0x3f, 0xae, 0x48, 0x0b, 0xe2, 0x2c, 0xa3, 0xd2, 0x96, 0xb2, 0x9e,
-	0x1c, 0x9b, 0xa9, 0x78, 0x5b, 0xac, 0x6c, 0xf9, 0x7d, 0x07, 0xae, 0xe2, 0x2d, 0x7a, 0xf7, 0x70,
-	0xba, 0xe1, 0x4a, 0x3b, 0xdd, 0x82, 0xd8, 0x22, 0xe2, 0xd0, 0x0e, 0x4a, 0x35, 0xe2, 0x8e, 0xa1,
-	0x9b, 0xa2, 0xe6, 0x6b, 0xae, 0xf9, 0xa8, 0x5d, 0xd8, 0x77, 0xef, 0xc9, 0x77, 0xd3, 0xd9, 0xa7,
-	0x3e, 0x66, 0x70, 0x51, 0xf5, 0x51, 0x74, 0xc6, 0x62, 0x11, 0xc9, 0xb2, 0x97, 0xb3, 0xdd, 0xb0,
-	0x16, 0xf9, 0x16, 0x86, 0x25, 0x1c, 0x4b, 0xc1, 0xb4, 0xc9, 0x0a, 0xc7, 0x1d, 0xff, 0xb8, 0x82,
-	0xdf, 0x4d, 0x86, 0x35, 0x71, 0x4b, 0x0c, 0x36, 0x32, 0x4c, 0x2a, 0xdf, 0x9d, 0x9d, 0x78, 0x2c,
-	0xc5, 0xdc, 0xce, 0xc8, 0xfb, 0xd3, 0x5e, 0x4e, 0x6b, 0x9c, 0x42, 0xf4, 0x66, 0xe7, 0x53, 0x77,
-	0xe3, 0xda, 0x45, 0xff, 0xa4, 0xa7, 0x48, 0x0f, 0xe0, 0xfd, 0x73, 0x86, 0x0e, 0x55, 0x75, 0x22,
-	0xf6, 0xca, 0x9f, 0xb7, 0x3f, 0x9a, 0x59, 0x10, 0x1c, 0xd0, 0xaf, 0x79, 0xfc, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0xc4, 0xcb, 0xe6, 0x4a, 0x46, 0x02, 0x00, 0x00,
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x55, 0xe2, 0xe6, 0x83, 0x49, 0xda, 0xb4, 0x4b, 0xa8, 0xac, 0x22, 0xa4, 0x12, 0x0e, 0x2d,
+	0x1f, 0xea, 0x21, 0x34, 0x9c, 0xa1, 0x3d, 0x21, 0x44, 0x91, 0x1c, 0x4e, 0x5c, 0x2c, 0xc7, 0x1e,
+	0x53, 0xcb, 0xdd, 0x5d, 0xcb, 0xbb, 0x39, 0xb8, 0x77, 0x7e, 0x2d, 0x12, 0xbf, 0x01, 0x79, 0x6c,
+	0xef, 0x2e, 0x11, 0x39, 0xce, 0x9b, 0xb7, 0xcf, 0xf3, 0xe6, 0x8d, 0xe1, 0x50, 0xe9, 0x12, 0x23,
+	0xae, 0xae, 0x8a, 0x52, 0x6a, 0xc9, 0x46, 0x6d, 0xb9, 0xf8, 0xd5, 0x83, 0xc9, 0x1a, 0x7f, 0x72,
+	0x14, 0xfa, 0x2b, 0xea, 0x88, 0xbd, 0x82, 0x43, 0x14, 0x71, 0x59, 0x15, 0x1a, 0x93, 0x30, 0xc7,
+	0xca, 0xef, 0x9d, 0xf7, 0x2e, 0xa7, 0xc1, 0xd4, 0x80, 0x5f, 0xb0, 0x62, 0xcf, 0xe1, 0x49, 0x8e,
+	0x55, 0x28, 0xa4, 0x88, 0xd1, 0xef, 0x13, 0x61, 0x9c, 0x63, 0x75, 0x57, 0xd7, 0xec, 0x1a, 0x4e,
+	0xad, 0x82, 0x6a, 0xa4, 0xc3, 0x4c, 0xa4, 0xd2, 0xf7, 0x88, 0x39, 0x37, 0xdd, 0xf6, 0xbb, 0x9f,
+	0x45, 0x2a, 0x17, 0xbf, 0xfb, 0x00, 0x6b, 0x9a, 0xa9, 0x2e, 0xd9, 0x27, 0x78, 0x91, 0x60, 0x51,
+	0x62, 0x1c, 0xd5, 0x2a, 0x62, 0xcb, 0x37, 0x58, 0x86, 0x32, 0xed, 0xf4, 0x14, 0x8d, 0xe5, 0x05,
+	0x67, 0x96, 0x74, 0x47, 0x9c, 0x6f, 0x69, 0x2b, 0xaa, 0x6a, 0x27, 0x1d, 0x3b, 0x54, 0xd9, 0x63,
+	0x33, 0xa8, 0x17, 0x4c, 0x3b, 0x70, 0x9d, 0x3d, 0x22, 0x7b, 0x03, 0x27, 0x0f, 0x91, 0xd2, 0x66,
+	0x4e, 0x22, 0x7a, 0x44, 0x9c, 0xd5, 0x8d, 0x56, 0x8d, 0xb8, 0x67, 0x30, 0xe6, 0xa8, 0xa3, 0x24,
+	0xd2, 0x91, 0x7f, 0xd0, 0x98, 0xee, 0x6a, 0xf6, 0x16, 0x4e, 0xb6, 0x22, 0x96, 0xbc, 0x28, 0x51,
+	0x29, 0x4c, 0x1a, 0x9d, 0x01, 0xe9, 0x1c, 0xbb, 0x0d, 0x12, 0xba, 0x86, 0x53, 0x87, 0xba, 0x79,
+	0x90, 0x71, 0x4e, 0x0f, 0x94, 0x3f, 0x3c, 0xf7, 0x2e, 0xbd, 0x60, 0x6e, 0xbb, 0x37, 0x75, 0xb3,
+	0x7e, 0xa4, 0xd8, 0x05, 0xcc, 0xd4, 0x7d, 0xb4, 0x5c, 0x7d, 0x08, 0xe3, 0x7b, 0x8c, 0x73, 0xb5,
+	0xe5, 0xfe, 0x88, 0xa6, 0x38, 0x6a, 0xe0, 0xdb, 0x16, 0x65, 0x2f, 0x61, 0xca, 0x93, 0x95, 0x65,
+	0x8d, 0x89, 0x35, 0xe1, 0xc9, 0xaa, 0xa3, 0x2c, 0xfe, 0x98, 0x6d, 0x53, 0xe8, 0x4b, 0x78, 0xe6,
+	0x44, 0x46, 0x78, 0x93, 0x58, 0x13, 0xfe, 0x53, 0x9b, 0x98, 0x4d, 0xe8, 0x02, 0x66, 0x2d, 0x9c,
+	0x49, 0x11, 0xea, 0xaa, 0x68, 0x16, 0x3c, 0x08, 0x8e, 0x2c, 0xfc, 0xbd, 0x2a, 0xd0, 0x11, 0xaf,
+	0x89, 0xd6, 0x2d, 0xad, 0x79, 0x60, 0xc4, 0x33, 0x29, 0x8c, 0x59, 0xf6, 0x71, 0x27, 0x16, 0x8e,
+	0xed, 0xce, 0x27, 0xcb, 0xf9, 0x55, 0x77, 0xc9, 0xce, 0xd9, 0xfe, 0x13, 0x16, 0x59, 0x7a, 0x07,
+	0xec, 0x3f, 0x57, 0xd3, 0x26, 0x22, 0x76, 0x6f, 0xe5, 0x35, 0x1c, 0x77, 0x3b, 0x37, 0x6e, 0x86,
+	0x34, 0xde, 0xcc, 0xc1, 0xc9, 0x8e, 0x13, 0xde, 0x8e, 0x9f, 0x11, 0x3d, 0x98, 0x3b, 0x5d, 0x63,
+	0x68, 0x71, 0x6b, 0xfe, 0x32, 0x5a, 0xde, 0xfe, 0x0b, 0xe8, 0xed, 0xbf, 0x80, 0x9b, 0x83, 0x1f,
+	0xfd, 0x62, 0xb3, 0x19, 0xd2, 0x1f, 0xfc, 0xfe, 0xef, 0x00, 0x9b, 0x4b, 0xd8, 0xaa, 0xd2, 0x03,
+	0x00, 0x00,
 }
@@ -38,7 +38,7 @@ func initDebug(logger *zap.Logger, r *monkit.Registry) (err error) {
 
 	mux.Handle("/version/", http.StripPrefix("/version", version.NewDebugHandler(logger.Named("version"))))
 	mux.Handle("/mon/", http.StripPrefix("/mon", present.HTTP(r)))
-	mux.HandleFunc("/metrics", prometheus)
+	mux.HandleFunc("/metrics", prometheusHandler(r))
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		_, _ = fmt.Fprintln(w, "OK")
 	})
@@ -79,14 +79,3 @@ func sanitize(val string) string {
 		}
 	}, val)
 }
-
-func prometheus(w http.ResponseWriter, r *http.Request) {
-	// writes https://prometheus.io/docs/instrumenting/exposition_formats/
-	// TODO(jt): deeper monkit integration so we can expose prometheus types
-	// (https://prometheus.io/docs/concepts/metric_types/)
-	monkit.Default.Stats(func(name string, val float64) {
-		metric := sanitize(name)
-		_, _ = fmt.Fprintf(w, "# TYPE %s gauge\n%s %g\n",
-			metric, metric, val)
-	})
-}
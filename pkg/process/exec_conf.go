@@ -248,6 +248,13 @@ func cleanup(cmd *cobra.Command) {
 			logger.Error("failed to start debug endpoints", zap.Error(err))
 		}
 
+		stopTracing, err := initTracing(ctx, logger.Named("tracing"), monkit.Default)
+		if err != nil {
+			logger.Error("failed to start tracing", zap.Error(err))
+		} else {
+			defer stopTracing()
+		}
+
 		var workErr error
 		work := func(ctx context.Context) {
 			commandMtx.Lock()
@@ -277,7 +284,14 @@ func cleanup(cmd *cobra.Command) {
 				logger.Error("failed to write svg", zap.Error(err))
 			}
 		} else {
-			work(ctx)
+			// the root trace was started before the tracing collector observed
+			// new traces, so the work runs in a trace of its own, which can be
+			// sampled by the collector
+			runCtx := context.Background()
+			func() {
+				defer mon.TaskNamed("run")(&runCtx)(&workErr)
+				work(runCtx)
+			}()
 		}
 
 		err = workErr
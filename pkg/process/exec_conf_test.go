@@ -4,15 +4,20 @@
 package process
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
 	"flag"
 	"io/ioutil"
 	"os"
 	"testing"
 
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/tracing"
 )
 
 func setenv(key, value string) func() {
@@ -83,3 +88,60 @@ func TestHidden(t *testing.T) {
 	require.NotContains(t, string(actualConfigFile), "# y: ")
 	require.NotContains(t, string(actualConfigFile), "# x: ")
 }
+
+func setflag(name, value string) func() {
+	old := flag.Lookup(name).Value.String()
+	_ = flag.Set(name, value)
+	return func() { _ = flag.Set(name, old) }
+}
+
+func TestExec_SamplesCommandTrace(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	spansFile := ctx.File("spans.json")
+	defer setflag("tracing.exporter", spansFile)()
+	defer setflag("tracing.sample", "1")()
+
+	// Set up a command doing its work in a task with a subtask.
+	cmd := &cobra.Command{RunE: func(cmd *cobra.Command, args []string) (err error) {
+		ctx := Ctx(cmd)
+		defer mon.TaskNamed("command")(&ctx)(&err)
+
+		func(ctx context.Context) {
+			defer mon.TaskNamed("step")(&ctx)(nil)
+		}(ctx)
+		return nil
+	}}
+
+	// Run the command through the exec call.
+	Exec(cmd)
+
+	file, err := os.Open(spansFile)
+	require.NoError(t, err)
+	defer ctx.Check(file.Close)
+
+	var spans []tracing.Span
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var span tracing.Span
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &span))
+		spans = append(spans, span)
+	}
+	require.NoError(t, scanner.Err())
+
+	// The work of the command is sampled as a single span tree.
+	trees := tracing.BuildTrees(spans)
+	require.Len(t, trees, 1)
+	run := trees[0]
+	assert.Equal(t, "storj.io/storj/pkg/process.run", run.Span.Name)
+	assert.Zero(t, run.Span.ParentID)
+
+	require.Len(t, run.Children, 1)
+	command := run.Children[0]
+	assert.Equal(t, "storj.io/storj/pkg/process.command", command.Span.Name)
+
+	require.Len(t, command.Children, 1)
+	assert.Equal(t, "storj.io/storj/pkg/process.step", command.Children[0].Span.Name)
+	assert.Equal(t, run.Span.TraceID, command.Children[0].Span.TraceID)
+}
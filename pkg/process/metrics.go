@@ -43,12 +43,6 @@ func flagDefault(dev, release string) string {
 // InitMetrics initializes telemetry reporting. Makes a telemetry.Client and calls
 // its Run() method in a goroutine.
 func InitMetrics(ctx context.Context, log *zap.Logger, r *monkit.Registry, instanceID string) (err error) {
-	if *metricCollector == "" || *metricInterval == 0 {
-		return Error.New("telemetry disabled")
-	}
-	if r == nil {
-		r = monkit.Default
-	}
 	if instanceID == "" {
 		instanceID = telemetry.DefaultInstanceID()
 	}
@@ -56,6 +50,17 @@ func InitMetrics(ctx context.Context, log *zap.Logger, r *monkit.Registry, insta
 	if len(instanceID) > maxInstanceLength {
 		instanceID = instanceID[:maxInstanceLength]
 	}
+
+	// the debug endpoint exposes the metrics even when telemetry is disabled
+	setProcessLabel("instance_id", instanceID)
+	setProcessLabel("application", *metricApp+*metricAppSuffix)
+
+	if *metricCollector == "" || *metricInterval == 0 {
+		return Error.New("telemetry disabled")
+	}
+	if r == nil {
+		r = monkit.Default
+	}
 	c, err := telemetry.NewClient(log, *metricCollector, telemetry.ClientOpts{
 		Interval:      *metricInterval,
 		Application:   *metricApp + *metricAppSuffix,
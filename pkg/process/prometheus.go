@@ -0,0 +1,346 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package process
+
+import (
+	"bufio"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/version"
+)
+
+// metric family types of the prometheus exposition format
+const (
+	typeCounter = "counter"
+	typeGauge   = "gauge"
+	typeSummary = "summary"
+)
+
+// distQuantiles maps the reservoir stats of monkit distributions to quantiles
+var distQuantiles = []struct {
+	stat     string
+	quantile string
+}{
+	{"rmin", "0"},
+	{"r10", "0.1"},
+	{"r50", "0.5"},
+	{"r90", "0.9"},
+	{"rmax", "1"},
+}
+
+// distStats are the stats monkit reports for distributions
+var distStats = map[string]bool{
+	"count": true, "sum": true, "min": true, "avg": true, "max": true,
+	"rmin": true, "ravg": true, "r10": true, "r50": true, "r90": true, "rmax": true,
+	"recent": true,
+}
+
+var processLabels = struct {
+	mu     sync.Mutex
+	labels map[string]string
+}{labels: map[string]string{}}
+
+// setProcessLabel sets a label which is attached to all exposed metrics.
+func setProcessLabel(name, value string) {
+	processLabels.mu.Lock()
+	defer processLabels.mu.Unlock()
+	processLabels.labels[name] = value
+}
+
+// label is a single prometheus label
+type label struct {
+	name  string
+	value string
+}
+
+// sample is a single line of a metric family
+type sample struct {
+	suffix string
+	labels []label
+	value  float64
+}
+
+// family is a prometheus metric family. The text format names samples
+// after their family, so the names of counter families end with _total.
+type family struct {
+	name    string
+	typ     string
+	samples []sample
+}
+
+// exposition collects the metric families of a registry
+type exposition struct {
+	families map[string]*family
+	common   []label
+}
+
+// add adds a sample to the family with the given name and type. If the name
+// is already used by a family of another type, the type is appended to the
+// name to keep the families apart.
+func (e *exposition) add(name, typ string, s sample) {
+	f, ok := e.families[name]
+	if ok && f.typ != typ {
+		name = name + "_" + typ
+		f, ok = e.families[name]
+	}
+	if !ok {
+		f = &family{name: name, typ: typ}
+		e.families[name] = f
+	}
+	s.labels = append(s.labels, e.common...)
+	f.samples = append(f.samples, s)
+}
+
+// addSummary adds a summary to the family from the stats of a monkit
+// distribution.
+func (e *exposition) addSummary(name string, labels []label, stats map[string]float64) {
+	if stats["count"] > 0 {
+		for _, q := range distQuantiles {
+			value, ok := stats[q.stat]
+			if !ok {
+				continue
+			}
+			e.add(name, typeSummary, sample{
+				labels: append(copyLabels(labels), label{"quantile", q.quantile}),
+				value:  value,
+			})
+		}
+	}
+	e.add(name, typeSummary, sample{suffix: "_sum", labels: copyLabels(labels), value: stats["sum"]})
+	e.add(name, typeSummary, sample{suffix: "_count", labels: copyLabels(labels), value: stats["count"]})
+}
+
+// collectFunc adds the stats of a monkit function.
+func (e *exposition) collectFunc(f *monkit.Func) {
+	labels := []label{{"scope", f.Scope().Name()}, {"name", f.ShortName()}}
+
+	e.add("function_current", typeGauge, sample{labels: copyLabels(labels), value: float64(f.Current())})
+	e.add("function_highwater", typeGauge, sample{labels: copyLabels(labels), value: float64(f.Highwater())})
+	e.add("function_successes_total", typeCounter, sample{labels: copyLabels(labels), value: float64(f.Success())})
+	e.add("function_panics_total", typeCounter, sample{labels: copyLabels(labels), value: float64(f.Panics())})
+
+	errors := f.Errors()
+	names := make([]string, 0, len(errors))
+	for name := range errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		e.add("function_errors_total", typeCounter, sample{
+			labels: append(copyLabels(labels), label{"error", name}),
+			value:  float64(errors[name]),
+		})
+	}
+
+	for _, times := range []struct {
+		result string
+		dist   *monkit.DurationDist
+	}{
+		{"success", f.SuccessTimes()},
+		{"failure", f.FailureTimes()},
+	} {
+		stats := map[string]float64{}
+		times.dist.Stats(func(name string, val float64) { stats[name] = val })
+		e.addSummary("function_duration_seconds", append(copyLabels(labels), label{"result", times.result}), stats)
+	}
+}
+
+// collectSource adds the stats of a non-function monkit source. The kind of
+// the source is inferred from the names of its stats.
+func (e *exposition) collectSource(scope, source string, stats map[string]float64) {
+	labels := []label{{"scope", scope}}
+	name := sanitize(source)
+
+	switch {
+	case hasExactly(stats, "rate", "total"): // meter
+		e.add(name+"_total", typeCounter, sample{labels: copyLabels(labels), value: stats["total"]})
+		e.add(name+"_rate", typeGauge, sample{labels: copyLabels(labels), value: stats["rate"]})
+		return
+	case hasExactly(stats, "high", "low", "val"): // counter
+		e.add(name, typeGauge, sample{labels: copyLabels(labels), value: stats["val"]})
+		e.add(name+"_high", typeGauge, sample{labels: copyLabels(labels), value: stats["high"]})
+		e.add(name+"_low", typeGauge, sample{labels: copyLabels(labels), value: stats["low"]})
+		return
+	case hasExactly(stats, "gauge"):
+		e.add(name, typeGauge, sample{labels: copyLabels(labels), value: stats["gauge"]})
+		return
+	}
+
+	// distributions of values and timers, where the timer splits are
+	// prefixed with the name of the split
+	dists := map[string]map[string]float64{}
+	var other []string
+	for stat := range stats {
+		prefix, key := "", stat
+		if i := strings.LastIndexByte(stat, ' '); i >= 0 {
+			prefix, key = stat[:i], stat[i+1:]
+		}
+		if !distStats[key] {
+			other = append(other, stat)
+			continue
+		}
+		if dists[prefix] == nil {
+			dists[prefix] = map[string]float64{}
+		}
+		dists[prefix][key] = stats[stat]
+	}
+
+	prefixes := make([]string, 0, len(dists))
+	for prefix, dist := range dists {
+		if _, ok := dist["count"]; !ok {
+			for key := range dist {
+				other = append(other, strings.TrimSpace(prefix+" "+key))
+			}
+			continue
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	for _, prefix := range prefixes {
+		familyName := name
+		if prefix != "" {
+			familyName = sanitize(source + "_" + strings.TrimSuffix(prefix, " -"))
+		}
+		e.addSummary(familyName, copyLabels(labels), dists[prefix])
+		if recent, ok := dists[prefix]["recent"]; ok {
+			e.add(familyName+"_recent", typeGauge, sample{labels: copyLabels(labels), value: recent})
+		}
+	}
+
+	sort.Strings(other)
+	for _, stat := range other {
+		e.add(sanitize(source+"_"+stat), typeGauge, sample{labels: copyLabels(labels), value: stats[stat]})
+	}
+}
+
+// collectExposition walks the registry and collects all metric families.
+func collectExposition(r *monkit.Registry, common []label) *exposition {
+	e := &exposition{families: map[string]*family{}, common: common}
+
+	r.Scopes(func(scope *monkit.Scope) {
+		funcs := map[string]*monkit.Func{}
+		scope.Funcs(func(f *monkit.Func) { funcs[f.ShortName()] = f })
+
+		names := make([]string, 0, len(funcs))
+		for name := range funcs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			e.collectFunc(funcs[name])
+		}
+
+		// stats of the scope are named <source>.<stat>, sources are sorted.
+		// functions were already collected and their names may contain dots.
+		var sources []string
+		stats := map[string]map[string]float64{}
+		scope.Stats(func(name string, val float64) {
+			if isFuncStat(funcs, name) {
+				return
+			}
+			source, stat := name, ""
+			if i := strings.IndexByte(name, '.'); i >= 0 {
+				source, stat = name[:i], name[i+1:]
+			}
+			if stats[source] == nil {
+				stats[source] = map[string]float64{}
+				sources = append(sources, source)
+			}
+			stats[source][stat] = val
+		})
+		for _, source := range sources {
+			e.collectSource(scope.Name(), source, stats[source])
+		}
+	})
+
+	return e
+}
+
+// write writes the families in the prometheus text exposition format.
+func (e *exposition) write(w io.Writer) error {
+	names := make([]string, 0, len(e.families))
+	for name := range e.families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	bw := bufio.NewWriter(w)
+	for _, name := range names {
+		f := e.families[name]
+		_, _ = bw.WriteString("# TYPE " + f.name + " " + f.typ + "\n")
+		for _, s := range f.samples {
+			_, _ = bw.WriteString(f.name + s.suffix)
+			if len(s.labels) > 0 {
+				_ = bw.WriteByte('{')
+				for i, l := range s.labels {
+					if i > 0 {
+						_ = bw.WriteByte(',')
+					}
+					_, _ = bw.WriteString(l.name + `="` + escapeLabelValue(l.value) + `"`)
+				}
+				_ = bw.WriteByte('}')
+			}
+			_, _ = bw.WriteString(" " + strconv.FormatFloat(s.value, 'g', -1, 64) + "\n")
+		}
+	}
+	return bw.Flush()
+}
+
+// prometheusHandler returns a handler exposing the metrics of the registry
+// with the prometheus text exposition format.
+// https://prometheus.io/docs/instrumenting/exposition_formats/
+func prometheusHandler(r *monkit.Registry) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		common := []label{{"version", version.Build.Version.String()}}
+		processLabels.mu.Lock()
+		for name, value := range processLabels.labels {
+			common = append(common, label{name, value})
+		}
+		processLabels.mu.Unlock()
+		sort.Slice(common, func(i, k int) bool { return common[i].name < common[k].name })
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		_ = collectExposition(r, common).write(w)
+	}
+}
+
+// isFuncStat returns whether the stat belongs to one of the functions.
+func isFuncStat(funcs map[string]*monkit.Func, name string) bool {
+	for i := 0; i < len(name); i++ {
+		if name[i] != '.' {
+			continue
+		}
+		if _, ok := funcs[name[:i]]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func hasExactly(stats map[string]float64, names ...string) bool {
+	if len(stats) != len(names) {
+		return false
+	}
+	for _, name := range names {
+		if _, ok := stats[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func copyLabels(labels []label) []label {
+	return append([]label(nil), labels...)
+}
+
+func escapeLabelValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
@@ -0,0 +1,121 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package process
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+)
+
+func TestPrometheus(t *testing.T) {
+	registry := monkit.NewRegistry()
+	mon := registry.ScopeNamed("storj.io/storj/test")
+
+	run := mon.FuncNamed("(*Service).Run")
+	for i := 0; i < 3; i++ {
+		var err error
+		run.Observe()(&err)
+	}
+	failed := errors.New("failed")
+	run.Observe()(&failed)
+
+	mon.Meter("upload_bytes").Mark(10)
+	mon.IntVal("piece_size").Observe(100)
+	mon.Counter("active_uploads").Inc(2)
+	mon.Gauge("temperature", func() float64 { return 1.5 })
+
+	setProcessLabel("instance_id", "node\"1")
+
+	recorder := httptest.NewRecorder()
+	prometheusHandler(registry)(recorder, httptest.NewRequest("GET", "/metrics", nil))
+
+	format := expfmt.ResponseFormat(recorder.Header())
+	require.Equal(t, expfmt.FmtText, format)
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(recorder.Body)
+	require.NoError(t, err)
+
+	for name, typ := range map[string]dto.MetricType{
+		"function_current":          dto.MetricType_GAUGE,
+		"function_successes_total":  dto.MetricType_COUNTER,
+		"function_panics_total":     dto.MetricType_COUNTER,
+		"function_errors_total":     dto.MetricType_COUNTER,
+		"function_duration_seconds": dto.MetricType_SUMMARY,
+		"upload_bytes_total":        dto.MetricType_COUNTER,
+		"upload_bytes_rate":         dto.MetricType_GAUGE,
+		"piece_size":                dto.MetricType_SUMMARY,
+		"active_uploads":            dto.MetricType_GAUGE,
+		"temperature":               dto.MetricType_GAUGE,
+	} {
+		family, ok := families[name]
+		if assert.True(t, ok, name) {
+			assert.Equal(t, typ, family.GetType(), name)
+		}
+	}
+
+	// no samples end up in families without a declared type
+	for name, family := range families {
+		assert.NotEqual(t, dto.MetricType_UNTYPED, family.GetType(), name)
+	}
+
+	labels := func(metric *dto.Metric) map[string]string {
+		labels := map[string]string{}
+		for _, pair := range metric.GetLabel() {
+			labels[pair.GetName()] = pair.GetValue()
+		}
+		return labels
+	}
+
+	successes := families["function_successes_total"].GetMetric()
+	require.Len(t, successes, 1)
+	assert.EqualValues(t, 3, successes[0].GetCounter().GetValue())
+	assert.Equal(t, "storj.io/storj/test", labels(successes[0])["scope"])
+	assert.Equal(t, "(*Service).Run", labels(successes[0])["name"])
+	assert.Equal(t, "node\"1", labels(successes[0])["instance_id"])
+	assert.Contains(t, labels(successes[0]), "version")
+
+	errorCounts := families["function_errors_total"].GetMetric()
+	require.Len(t, errorCounts, 1)
+	assert.EqualValues(t, 1, errorCounts[0].GetCounter().GetValue())
+	assert.NotEmpty(t, labels(errorCounts[0])["error"])
+
+	uploaded := families["upload_bytes_total"].GetMetric()
+	require.Len(t, uploaded, 1)
+	assert.EqualValues(t, 10, uploaded[0].GetCounter().GetValue())
+
+	durations := families["function_duration_seconds"].GetMetric()
+	require.Len(t, durations, 2)
+	for _, duration := range durations {
+		switch labels(duration)["result"] {
+		case "success":
+			assert.EqualValues(t, 3, duration.GetSummary().GetSampleCount())
+			assert.NotEmpty(t, duration.GetSummary().GetQuantile())
+		case "failure":
+			assert.EqualValues(t, 1, duration.GetSummary().GetSampleCount())
+		default:
+			t.Errorf("unexpected result label %q", labels(duration)["result"])
+		}
+	}
+
+	pieceSizes := families["piece_size"].GetMetric()
+	require.Len(t, pieceSizes, 1)
+	assert.EqualValues(t, 1, pieceSizes[0].GetSummary().GetSampleCount())
+	assert.EqualValues(t, 100, pieceSizes[0].GetSummary().GetSampleSum())
+
+	active := families["active_uploads"].GetMetric()
+	require.Len(t, active, 1)
+	assert.EqualValues(t, 2, active[0].GetGauge().GetValue())
+
+	temperature := families["temperature"].GetMetric()
+	require.Len(t, temperature, 1)
+	assert.EqualValues(t, 1.5, temperature[0].GetGauge().GetValue())
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package process
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/tracing"
+)
+
+var (
+	tracingSample            = flag.Float64("tracing.sample", 0, "the fraction of traces started by this process to sample, between 0 and 1")
+	tracingRemoteSampleLimit = flag.Int("tracing.remote-sample-limit", 10, "the maximum number of traces per second continued from other processes which are sampled on request of the caller")
+	tracingExporter          = flag.String("tracing.exporter", "", "where to export sampled traces to, either the url of a zipkin compatible collector (e.g. http://localhost:9411/api/v2/spans) or a file path")
+	tracingInterval          = flag.Duration("tracing.interval", 5*time.Second, "how frequently to export the spans of sampled traces")
+	tracingBufferSize        = flag.Int("tracing.buffer-size", 10000, "the maximum number of spans waiting for export")
+)
+
+// initTracing starts collecting the spans of sampled traces when an exporter
+// is configured. The returned function stops collecting and exports the
+// remaining spans.
+func initTracing(ctx context.Context, log *zap.Logger, r *monkit.Registry) (stop func(), err error) {
+	if *tracingExporter == "" {
+		return func() {}, nil
+	}
+
+	exporter, err := tracing.NewExporter(*tracingExporter)
+	if err != nil {
+		return nil, err
+	}
+
+	collector := tracing.NewCollector(log, r, exporter, tracing.Config{
+		Service:           *metricApp + *metricAppSuffix,
+		Sample:            *tracingSample,
+		RemoteSampleLimit: *tracingRemoteSampleLimit,
+		Interval:          *tracingInterval,
+		BufferSize:        *tracingBufferSize,
+	})
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = collector.Run(ctx)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+		if err := collector.Close(); err != nil {
+			log.Error("failed to export spans", zap.Error(err))
+		}
+	}, nil
+}
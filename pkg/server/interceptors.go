@@ -58,6 +58,18 @@ func CombineInterceptors(a, b grpc.UnaryServerInterceptor) grpc.UnaryServerInter
 	}
 }
 
+// CombineStreamInterceptors combines two StreamServerInterceptors so they act
+// as one (because grpc only allows you to pass one in).
+func CombineStreamInterceptors(a, b grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return a(srv, ss, info, func(asrv interface{}, ass grpc.ServerStream) error {
+			return b(asrv, ass, info, func(bsrv interface{}, bss grpc.ServerStream) error {
+				return handler(bsrv, bss)
+			})
+		})
+	}
+}
+
 type nodeRequestLog struct {
 	GRPCService string      `json:"grpc_service"`
 	GRPCMethod  string      `json:"grpc_method"`
@@ -14,6 +14,7 @@ import (
 
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/peertls/tlsopts"
+	"storj.io/storj/pkg/tracing"
 )
 
 // Service represents a specific gRPC method collection to be registered
@@ -52,10 +53,17 @@ func New(log *zap.Logger, opts *tlsopts.Options, publicAddr, privateAddr string,
 		identity: opts.Ident,
 	}
 
-	unaryInterceptor := server.logOnErrorUnaryInterceptor
+	unaryInterceptor := CombineInterceptors(
+		tracing.UnaryServerInterceptor(opts.Ident.ID),
+		server.logOnErrorUnaryInterceptor,
+	)
 	if interceptor != nil {
 		unaryInterceptor = CombineInterceptors(unaryInterceptor, interceptor)
 	}
+	streamInterceptor := CombineStreamInterceptors(
+		tracing.StreamServerInterceptor(opts.Ident.ID),
+		server.logOnErrorStreamInterceptor,
+	)
 
 	publicListener, err := net.Listen("tcp", publicAddr)
 	if err != nil {
@@ -64,7 +72,7 @@ func New(log *zap.Logger, opts *tlsopts.Options, publicAddr, privateAddr string,
 	server.public = public{
 		listener: publicListener,
 		grpc: grpc.NewServer(
-			grpc.StreamInterceptor(server.logOnErrorStreamInterceptor),
+			grpc.StreamInterceptor(streamInterceptor),
 			grpc.UnaryInterceptor(unaryInterceptor),
 			opts.ServerOption(),
 		),
@@ -0,0 +1,17 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storj
+
+// Compression specifies the compression applied to data before encryption
+type Compression byte
+
+const (
+	// CompressionNone indicates that data is stored as given.
+	CompressionNone = Compression(iota)
+	// CompressionGzip indicates that data is compressed with gzip in
+	// independently compressed blocks, so that ranges can be decompressed
+	// without reading the whole stream.
+	CompressionGzip
+)
+
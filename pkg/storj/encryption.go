@@ -39,11 +39,16 @@ const (
 	// EncNull indicates use of the NULL cipher; that is, no encryption is
 	// done. The ciphertext is equal to the plaintext.
 	EncNull
-	// EncAESGCM indicates use of AES128-GCM encryption.
+	// EncAESGCM indicates use of AES-GCM encryption. The keys are KeySize
+	// bytes long, so this is AES-256-GCM.
 	EncAESGCM
 	// EncSecretBox indicates use of XSalsa20-Poly1305 encryption, as provided
 	// by the NaCl cryptography library under the name "Secretbox".
 	EncSecretBox
+	// EncChaCha20Poly1305 indicates use of ChaCha20-Poly1305 encryption as
+	// specified in RFC 7539. It's faster than AES-GCM on hardware without
+	// AES instructions.
+	EncChaCha20Poly1305
 )
 
 // Constant definitions for key and nonce sizes
@@ -51,6 +51,8 @@ type CreateObject struct {
 
 	RedundancyScheme
 	EncryptionParameters
+
+	Compression Compression
 }
 
 // Object converts the CreateObject to an object with unitialized values
@@ -69,6 +71,7 @@ func (create CreateObject) Object(bucket Bucket, path Path) Object {
 
 			RedundancyScheme:     create.RedundancyScheme,
 			EncryptionParameters: create.EncryptionParameters,
+			Compression:          create.Compression,
 		},
 	}
 }
@@ -57,8 +57,12 @@ type ObjectInfo struct {
 type Stream struct {
 	// Size is the total size of the stream in bytes
 	Size int64
-	// Checksum is the checksum of the segment checksums
+	// Checksum is the SHA-256 checksum of the content, computed by the
+	// uplink during upload. It is empty for objects uploaded without one.
 	Checksum []byte
+	// MD5Checksum is the MD5 checksum of the content, kept for
+	// compatibility with S3 ETags.
+	MD5Checksum []byte
 
 	// SegmentCount is the number of segments
 	SegmentCount int64
@@ -70,6 +74,8 @@ type Stream struct {
 	RedundancyScheme
 	// EncryptionParameters specifies encryption strategy used for this stream
 	EncryptionParameters
+	// Compression specifies the compression applied before encryption
+	Compression Compression
 
 	LastSegment LastSegment // TODO: remove
 }
@@ -0,0 +1,221 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package tracing
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/storj"
+)
+
+// defaultInterval is used when no export interval is configured
+const defaultInterval = 5 * time.Second
+
+// Config defines how the spans of the sampled traces are collected.
+type Config struct {
+	// Service is the name of the process the spans are reported for.
+	Service string
+	// Sample is the fraction of the traces started in this process which are
+	// sampled.
+	Sample float64
+	// RemoteSampleLimit is the maximum number of traces per second continued
+	// from other processes which are sampled because the caller asked for it.
+	// Further traces are sampled like the ones started in this process.
+	RemoteSampleLimit int
+	// Interval is how frequently the collected spans are exported.
+	Interval time.Duration
+	// BufferSize is the maximum number of spans waiting for export. Further
+	// spans are dropped.
+	BufferSize int
+}
+
+// Collector collects the spans of sampled traces of a monkit registry and
+// exports them periodically.
+type Collector struct {
+	log      *zap.Logger
+	config   Config
+	exporter Exporter
+
+	Loop sync2.Cycle
+
+	mu      sync.Mutex
+	spans   []Span
+	dropped int
+	closed  bool
+
+	// remoteWindow is the start of the second remoteSampled traces were
+	// sampled in on request of the caller
+	remoteWindow  time.Time
+	remoteSampled int
+
+	cancel func()
+}
+
+// NewCollector creates a collector observing the traces of registry.
+func NewCollector(log *zap.Logger, registry *monkit.Registry, exporter Exporter, config Config) *Collector {
+	if config.Interval <= 0 {
+		config.Interval = defaultInterval
+	}
+	collector := &Collector{
+		log:      log,
+		config:   config,
+		exporter: exporter,
+		Loop:     *sync2.NewCycle(config.Interval),
+	}
+	collector.cancel = registry.ObserveTraces(collector.observeTrace)
+	return collector
+}
+
+// Run exports the collected spans every interval.
+func (collector *Collector) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return collector.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := collector.Flush(ctx); err != nil {
+			collector.log.Error("failed to export spans", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Flush exports the collected spans.
+func (collector *Collector) Flush(ctx context.Context) (err error) {
+	collector.mu.Lock()
+	spans, dropped := collector.spans, collector.dropped
+	collector.spans, collector.dropped = nil, 0
+	collector.mu.Unlock()
+
+	if dropped > 0 {
+		collector.log.Warn("span buffer full, dropped spans", zap.Int("dropped", dropped))
+	}
+	if len(spans) == 0 {
+		return nil
+	}
+	return Error.Wrap(collector.exporter.Export(ctx, spans))
+}
+
+// Close stops collecting spans and exports the remaining ones.
+func (collector *Collector) Close() error {
+	collector.cancel()
+	collector.Loop.Close()
+
+	collector.mu.Lock()
+	collector.closed = true
+	collector.mu.Unlock()
+
+	err := collector.Flush(context.Background())
+	if closer, ok := collector.exporter.(io.Closer); ok {
+		err = errs.Combine(err, closer.Close())
+	}
+	return err
+}
+
+// observeTrace decides whether a new trace is sampled and starts observing
+// the spans of sampled traces.
+func (collector *Collector) observeTrace(trace *monkit.Trace) {
+	sampled, ok := trace.Get(sampledTraceKey).(bool)
+	if !ok {
+		remote, requested := trace.Get(remoteSampledTraceKey).(bool)
+		switch {
+		case requested && !remote:
+			sampled = false
+		case requested && collector.allowRemoteSample():
+			sampled = true
+		default:
+			sampled = collector.config.Sample > 0 && rand.Float64() < collector.config.Sample
+		}
+		trace.Set(sampledTraceKey, sampled)
+	}
+	if sampled {
+		trace.ObserveSpans(spanObserver{collector})
+	}
+}
+
+// allowRemoteSample returns whether another trace can be sampled on request
+// of the caller within the RemoteSampleLimit of the current second.
+func (collector *Collector) allowRemoteSample() bool {
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(collector.remoteWindow) >= time.Second {
+		collector.remoteWindow = now
+		collector.remoteSampled = 0
+	}
+	if collector.remoteSampled >= collector.config.RemoteSampleLimit {
+		return false
+	}
+	collector.remoteSampled++
+	return true
+}
+
+// add adds a finished span to the buffer.
+func (collector *Collector) add(span Span) {
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	if collector.closed {
+		return
+	}
+	if collector.config.BufferSize > 0 && len(collector.spans) >= collector.config.BufferSize {
+		collector.dropped++
+		return
+	}
+	collector.spans = append(collector.spans, span)
+}
+
+// spanObserver records the finished spans of a trace
+type spanObserver struct {
+	collector *Collector
+}
+
+// Start implements monkit.SpanObserver
+func (observer spanObserver) Start(s *monkit.Span) {}
+
+// Finish implements monkit.SpanObserver
+func (observer spanObserver) Finish(s *monkit.Span, err error, panicked bool, finish time.Time) {
+	trace := s.Trace()
+	span := Span{
+		TraceID:  trace.Id(),
+		ID:       s.Id(),
+		Name:     s.Func().FullName(),
+		Service:  observer.collector.config.Service,
+		Start:    s.Start(),
+		Duration: finish.Sub(s.Start()),
+		Panicked: panicked,
+	}
+	if parent := s.Parent(); parent != nil {
+		span.ParentID = parent.Id()
+	} else if parentID, ok := trace.Get(remoteParentTraceKey).(int64); ok {
+		span.ParentID = parentID
+	}
+	if err != nil {
+		span.Error = err.Error()
+	}
+
+	tags := make(map[string]string)
+	if nodeID, ok := trace.Get(nodeTraceKey).(storj.NodeID); ok {
+		tags["node.id"] = nodeID.String()
+	}
+	for i, arg := range s.Args() {
+		tags["arg."+strconv.Itoa(i)] = arg
+	}
+	for _, annotation := range s.Annotations() {
+		tags[annotation.Name] = annotation.Value
+	}
+	if len(tags) > 0 {
+		span.Tags = tags
+	}
+
+	observer.collector.add(span)
+}
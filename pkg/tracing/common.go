@@ -0,0 +1,42 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package tracing
+
+import (
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+)
+
+var (
+	mon = monkit.Package()
+
+	// rpcScope is the scope of the spans created for incoming requests
+	rpcScope = monkit.ScopeNamed("grpc")
+
+	// Error is the default error class for tracing
+	Error = errs.Class("tracing error")
+)
+
+// gRPC metadata keys used to propagate traces
+const (
+	traceIDKey  = "storj-trace-id"
+	parentIDKey = "storj-parent-id"
+	sampledKey  = "storj-sampled"
+)
+
+// keys of the values stored in monkit traces
+type traceKey int
+
+const (
+	// sampledTraceKey holds whether the trace is sampled as a bool
+	sampledTraceKey traceKey = iota
+	// remoteSampledTraceKey holds whether the remote caller which started the
+	// trace asked for it to be sampled as a bool
+	remoteSampledTraceKey
+	// remoteParentTraceKey holds the id of the remote span which started the
+	// trace in this process as an int64
+	remoteParentTraceKey
+	// nodeTraceKey holds the id of the node handling the remote request
+	nodeTraceKey
+)
@@ -0,0 +1,199 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package tracing
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Exporter sends finished spans to a trace collector.
+type Exporter interface {
+	Export(ctx context.Context, spans []Span) error
+}
+
+// NewExporter creates an exporter for the destination, which is either the
+// url of a Zipkin compatible HTTP collector or the path of a file.
+func NewExporter(destination string) (Exporter, error) {
+	if strings.HasPrefix(destination, "http://") || strings.HasPrefix(destination, "https://") {
+		return NewZipkinExporter(destination), nil
+	}
+	return NewFileExporter(destination)
+}
+
+// ZipkinExporter exports spans with the Zipkin v2 JSON HTTP API, which is
+// also supported by Jaeger collectors.
+type ZipkinExporter struct {
+	url    string
+	client *http.Client
+}
+
+// NewZipkinExporter creates an exporter posting spans to url, such as
+// http://localhost:9411/api/v2/spans.
+func NewZipkinExporter(url string) *ZipkinExporter {
+	return &ZipkinExporter{
+		url:    url,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// zipkinSpan is a span in the Zipkin v2 JSON format
+type zipkinSpan struct {
+	TraceID       string            `json:"traceId"`
+	ID            string            `json:"id"`
+	ParentID      string            `json:"parentId,omitempty"`
+	Name          string            `json:"name"`
+	Timestamp     int64             `json:"timestamp"`
+	Duration      int64             `json:"duration"`
+	LocalEndpoint zipkinEndpoint    `json:"localEndpoint"`
+	Tags          map[string]string `json:"tags,omitempty"`
+}
+
+type zipkinEndpoint struct {
+	ServiceName string `json:"serviceName"`
+}
+
+// Export implements Exporter
+func (exporter *ZipkinExporter) Export(ctx context.Context, spans []Span) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	converted := make([]zipkinSpan, 0, len(spans))
+	for _, span := range spans {
+		zspan := zipkinSpan{
+			TraceID:       formatID(span.TraceID),
+			ID:            formatID(span.ID),
+			Name:          span.Name,
+			Timestamp:     span.Start.UnixNano() / int64(time.Microsecond),
+			Duration:      int64(span.Duration / time.Microsecond),
+			LocalEndpoint: zipkinEndpoint{ServiceName: span.Service},
+			Tags:          span.Tags,
+		}
+		if span.ParentID != 0 {
+			zspan.ParentID = formatID(span.ParentID)
+		}
+		if span.Error != "" || span.Panicked {
+			tags := make(map[string]string, len(span.Tags)+1)
+			for key, value := range span.Tags {
+				tags[key] = value
+			}
+			tags["error"] = span.Error
+			if span.Panicked {
+				tags["error"] = "panic"
+			}
+			zspan.Tags = tags
+		}
+		converted = append(converted, zspan)
+	}
+
+	body, err := json.Marshal(converted)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	req, err := http.NewRequest("POST", exporter.url, bytes.NewReader(body))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := exporter.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return Error.New("unexpected status %q: %s", resp.Status, bytes.TrimSpace(message))
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	return nil
+}
+
+// FileExporter appends spans to a file as JSON, one span per line.
+type FileExporter struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileExporter creates an exporter appending to the file at path.
+func NewFileExporter(path string) (*FileExporter, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &FileExporter{file: file}, nil
+}
+
+// Export implements Exporter
+func (exporter *FileExporter) Export(ctx context.Context, spans []Span) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exporter.mu.Lock()
+	defer exporter.mu.Unlock()
+
+	w := bufio.NewWriter(exporter.file)
+	encoder := json.NewEncoder(w)
+	for _, span := range spans {
+		if err := encoder.Encode(span); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return Error.Wrap(w.Flush())
+}
+
+// Close closes the file
+func (exporter *FileExporter) Close() error {
+	exporter.mu.Lock()
+	defer exporter.mu.Unlock()
+	return Error.Wrap(exporter.file.Close())
+}
+
+// MemoryExporter keeps the exported spans in memory. It is used in tests to
+// inspect traces.
+type MemoryExporter struct {
+	mu    sync.Mutex
+	spans []Span
+}
+
+// NewMemoryExporter creates an exporter keeping spans in memory.
+func NewMemoryExporter() *MemoryExporter {
+	return &MemoryExporter{}
+}
+
+// Export implements Exporter
+func (exporter *MemoryExporter) Export(ctx context.Context, spans []Span) error {
+	exporter.mu.Lock()
+	defer exporter.mu.Unlock()
+	exporter.spans = append(exporter.spans, spans...)
+	return nil
+}
+
+// Spans returns all exported spans.
+func (exporter *MemoryExporter) Spans() []Span {
+	exporter.mu.Lock()
+	defer exporter.mu.Unlock()
+	return append([]Span(nil), exporter.spans...)
+}
+
+// Trace returns the span trees of the trace. A trace which has been exported
+// completely has a single tree.
+func (exporter *MemoryExporter) Trace(traceID int64) []*Tree {
+	var spans []Span
+	for _, span := range exporter.Spans() {
+		if span.TraceID == traceID {
+			spans = append(spans, span)
+		}
+	}
+	return BuildTrees(spans)
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package tracing
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// StartTrace starts a new trace which is sampled regardless of the sample
+// rate of the collectors. It is used like a monkit task:
+//
+//	defer tracing.StartTrace(&ctx, "upload")(&err)
+func StartTrace(ctx *context.Context, name string) func(*error) {
+	trace := monkit.NewTrace(monkit.NewId())
+	trace.Set(sampledTraceKey, true)
+	return mon.FuncNamed(name).RemoteTrace(ctx, monkit.NewId(), trace)
+}
+
+// TraceID returns the id of the trace of the current span in ctx.
+func TraceID(ctx context.Context) (int64, bool) {
+	span := monkit.SpanFromCtx(ctx)
+	if span == nil {
+		return 0, false
+	}
+	return span.Trace().Id(), true
+}
+
+// UnaryClientInterceptor propagates the trace of the calling span.
+func UnaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(outgoingContext(ctx), method, req, reply, cc, opts...)
+}
+
+// StreamClientInterceptor propagates the trace of the calling span.
+func StreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(outgoingContext(ctx), desc, cc, method, opts...)
+}
+
+// UnaryServerInterceptor returns an interceptor which continues the traces of
+// incoming requests with a span named after the method. nodeID is the id of
+// the node handling the requests.
+func UnaryServerInterceptor(nodeID storj.NodeID) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		ctx, finish := incomingContext(ctx, info.FullMethod, nodeID)
+		defer finish(&err)
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns an interceptor which continues the traces of
+// incoming streams with a span named after the method. nodeID is the id of
+// the node handling the streams.
+func StreamServerInterceptor(nodeID storj.NodeID) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		ctx, finish := incomingContext(ss.Context(), info.FullMethod, nodeID)
+		defer finish(&err)
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// serverStream replaces the context of a server stream
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context of the stream
+func (stream *serverStream) Context() context.Context { return stream.ctx }
+
+// outgoingContext adds the trace of the current span to the outgoing metadata.
+func outgoingContext(ctx context.Context) context.Context {
+	span := monkit.SpanFromCtx(ctx)
+	if span == nil {
+		return ctx
+	}
+
+	trace := span.Trace()
+	pairs := []string{
+		traceIDKey, formatID(trace.Id()),
+		parentIDKey, formatID(span.Id()),
+	}
+	if sampled, ok := trace.Get(sampledTraceKey).(bool); ok {
+		pairs = append(pairs, sampledKey, strconv.FormatBool(sampled))
+	}
+	return metadata.AppendToOutgoingContext(ctx, pairs...)
+}
+
+// incomingContext starts a span continuing the trace of the incoming request.
+// Requests without a trace are left alone. Any client can ask for its trace
+// to be sampled, so the sampling decision of the caller is only recorded as a
+// request, which the collector grants up to a limit.
+func incomingContext(ctx context.Context, method string, nodeID storj.NodeID) (context.Context, func(*error)) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, func(*error) {}
+	}
+	traceID, ok := parseID(md.Get(traceIDKey))
+	if !ok {
+		return ctx, func(*error) {}
+	}
+	parentID, ok := parseID(md.Get(parentIDKey))
+	if !ok {
+		return ctx, func(*error) {}
+	}
+
+	trace := monkit.NewTrace(traceID)
+	trace.Set(remoteParentTraceKey, parentID)
+	if !nodeID.IsZero() {
+		trace.Set(nodeTraceKey, nodeID)
+	}
+	if values := md.Get(sampledKey); len(values) > 0 {
+		if sampled, err := strconv.ParseBool(values[0]); err == nil {
+			trace.Set(remoteSampledTraceKey, sampled)
+		}
+	}
+
+	finish := rpcScope.FuncNamed(strings.TrimPrefix(method, "/")).RemoteTrace(&ctx, monkit.NewId(), trace)
+	return ctx, finish
+}
+
+// formatID formats a trace or span id as 16 hex digits.
+func formatID(id int64) string {
+	s := strconv.FormatUint(uint64(id), 16)
+	return strings.Repeat("0", 16-len(s)) + s
+}
+
+// parseID parses the first value as a trace or span id.
+func parseID(values []string) (int64, bool) {
+	if len(values) == 0 {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(values[0], 16, 64)
+	if err != nil {
+		return 0, false
+	}
+	return int64(id), true
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package tracing
+
+import (
+	"sort"
+	"time"
+)
+
+// Span is a finished span of a sampled trace.
+type Span struct {
+	TraceID int64 `json:"traceID"`
+	ID      int64 `json:"id"`
+	// ParentID is the id of the parent span, which may be in another process.
+	// It is zero for the root span of a trace.
+	ParentID int64 `json:"parentID,omitempty"`
+
+	// Name is the full name of the monkit function, or grpc.<method> for the
+	// spans of incoming requests.
+	Name    string `json:"name"`
+	Service string `json:"service"`
+
+	Start    time.Time     `json:"start"`
+	Duration time.Duration `json:"duration"`
+
+	Error    string `json:"error,omitempty"`
+	Panicked bool   `json:"panicked,omitempty"`
+
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// Tree is a span with its child spans.
+type Tree struct {
+	Span     Span
+	Children []*Tree
+}
+
+// BuildTrees arranges the spans into trees. Spans whose parent is not part of
+// spans are the roots of the returned trees. Roots and children are ordered by
+// their start time.
+func BuildTrees(spans []Span) []*Tree {
+	trees := make(map[int64]*Tree, len(spans))
+	for _, span := range spans {
+		trees[span.ID] = &Tree{Span: span}
+	}
+
+	var roots []*Tree
+	for _, span := range spans {
+		tree := trees[span.ID]
+		if parent, ok := trees[span.ParentID]; ok && span.ParentID != span.ID {
+			parent.Children = append(parent.Children, tree)
+			continue
+		}
+		roots = append(roots, tree)
+	}
+
+	for _, tree := range trees {
+		sortTrees(tree.Children)
+	}
+	sortTrees(roots)
+	return roots
+}
+
+// Walk calls cb for the tree and all of its descendants, parents first.
+func (tree *Tree) Walk(cb func(tree *Tree)) {
+	cb(tree)
+	for _, child := range tree.Children {
+		child.Walk(cb)
+	}
+}
+
+// Find returns all descendants of the tree, including the tree itself,
+// with the given span name.
+func (tree *Tree) Find(name string) []*Tree {
+	var found []*Tree
+	tree.Walk(func(tree *Tree) {
+		if tree.Span.Name == name {
+			found = append(found, tree)
+		}
+	})
+	return found
+}
+
+func sortTrees(trees []*Tree) {
+	sort.SliceStable(trees, func(i, k int) bool {
+		return trees[i].Span.Start.Before(trees[k].Span.Start)
+	})
+}
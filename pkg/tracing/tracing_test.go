@@ -0,0 +1,284 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package tracing_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/tracing"
+)
+
+func TestCollector(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	registry := monkit.NewRegistry()
+	mon := registry.ScopeNamed("test")
+
+	exporter := tracing.NewMemoryExporter()
+	collector := tracing.NewCollector(zaptest.NewLogger(t), registry, exporter, tracing.Config{
+		Service: "test",
+		Sample:  1,
+	})
+
+	parent := func(ctx context.Context) (err error) {
+		defer mon.Task()(&ctx)(&err)
+		for i := 0; i < 2; i++ {
+			func(ctx context.Context) {
+				defer mon.TaskNamed("child")(&ctx, i)(nil)
+			}(ctx)
+		}
+		return nil
+	}
+	require.NoError(t, parent(context.Background()))
+
+	// spans of the default registry are not collected
+	var err error
+	func(ctx context.Context) {
+		defer monkit.Package().Task()(&ctx)(&err)
+	}(context.Background())
+
+	require.NoError(t, collector.Close())
+
+	spans := exporter.Spans()
+	require.Len(t, spans, 3)
+
+	trees := tracing.BuildTrees(spans)
+	require.Len(t, trees, 1)
+	root := trees[0]
+	assert.Zero(t, root.Span.ParentID)
+	assert.Equal(t, "test", root.Span.Service)
+	assert.True(t, strings.HasPrefix(root.Span.Name, "test."))
+	assert.True(t, strings.HasSuffix(root.Span.Name, "func1"))
+
+	require.Len(t, root.Children, 2)
+	for i, child := range root.Children {
+		assert.Equal(t, "test.child", child.Span.Name)
+		assert.Equal(t, root.Span.ID, child.Span.ParentID)
+		assert.Equal(t, root.Span.TraceID, child.Span.TraceID)
+		assert.Equal(t, string(rune('0'+i)), child.Span.Tags["arg.0"])
+	}
+	assert.Len(t, root.Find("test.child"), 2)
+}
+
+func TestCollectorSampling(t *testing.T) {
+	// traces started with StartTrace belong to the default registry
+	mon := monkit.Package()
+
+	exporter := tracing.NewMemoryExporter()
+	collector := tracing.NewCollector(zaptest.NewLogger(t), monkit.Default, exporter, tracing.Config{})
+
+	task := func(ctx context.Context) {
+		defer mon.Task()(&ctx)(nil)
+	}
+
+	// traces are not sampled by default
+	task(context.Background())
+
+	// unless they are started explicitly
+	ctx := context.Background()
+	finish := tracing.StartTrace(&ctx, "sampled")
+	traceID, ok := tracing.TraceID(ctx)
+	require.True(t, ok)
+	task(ctx)
+	finish(nil)
+
+	require.NoError(t, collector.Close())
+
+	for _, span := range exporter.Spans() {
+		assert.Equal(t, traceID, span.TraceID)
+	}
+
+	trees := exporter.Trace(traceID)
+	require.Len(t, trees, 1)
+	assert.Equal(t, "storj.io/storj/pkg/tracing.sampled", trees[0].Span.Name)
+	require.Len(t, trees[0].Children, 1)
+	assert.True(t, strings.HasSuffix(trees[0].Children[0].Span.Name, ".func1"))
+}
+
+func TestRemoteSampleLimit(t *testing.T) {
+	exporter := tracing.NewMemoryExporter()
+	collector := tracing.NewCollector(zaptest.NewLogger(t), monkit.Default, exporter, tracing.Config{
+		RemoteSampleLimit: 2,
+	})
+
+	interceptor := tracing.UnaryServerInterceptor(testrand.NodeID())
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Test/Method"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+
+	// every request asks for its trace to be sampled
+	for i := 1; i <= 5; i++ {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			"storj-trace-id", fmt.Sprintf("%016x", i),
+			"storj-parent-id", fmt.Sprintf("%016x", 100+i),
+			"storj-sampled", "true",
+		))
+		_, err := interceptor(ctx, nil, info, handler)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, collector.Close())
+
+	// only the limit of the requests are sampled
+	traces := map[int64]bool{}
+	for _, span := range exporter.Spans() {
+		assert.Equal(t, "grpc.test.Test/Method", span.Name)
+		traces[span.TraceID] = true
+	}
+	assert.Len(t, traces, 2)
+}
+
+func TestZipkinExporter(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	var received []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.NoError(t, json.Unmarshal(body, &received))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	exporter, err := tracing.NewExporter(server.URL + "/api/v2/spans")
+	require.NoError(t, err)
+
+	start := time.Unix(1500000000, 0)
+	err = exporter.Export(ctx, []tracing.Span{
+		{TraceID: 0xabc, ID: 1, Name: "root", Service: "uplink", Start: start, Duration: time.Second},
+		{TraceID: 0xabc, ID: 2, ParentID: 1, Name: "child", Service: "satellite", Start: start, Duration: time.Millisecond, Error: "failed"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, received, 2)
+	assert.Equal(t, "0000000000000abc", received[0]["traceId"])
+	assert.Equal(t, "0000000000000001", received[0]["id"])
+	assert.NotContains(t, received[0], "parentId")
+	assert.Equal(t, float64(1500000000000000), received[0]["timestamp"])
+	assert.Equal(t, float64(1000000), received[0]["duration"])
+	assert.Equal(t, map[string]interface{}{"serviceName": "uplink"}, received[0]["localEndpoint"])
+	assert.Equal(t, "0000000000000001", received[1]["parentId"])
+	assert.Equal(t, map[string]interface{}{"error": "failed"}, received[1]["tags"])
+
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer unavailable.Close()
+
+	err = tracing.NewZipkinExporter(unavailable.URL).Export(ctx, []tracing.Span{{TraceID: 1, ID: 1}})
+	assert.Error(t, err)
+}
+
+func TestFileExporter(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	path := filepath.Join(ctx.Dir(), "spans.json")
+	exporter, err := tracing.NewExporter(path)
+	require.NoError(t, err)
+
+	spans := []tracing.Span{
+		{TraceID: 1, ID: 1, Name: "root", Start: time.Unix(1500000000, 0).UTC()},
+		{TraceID: 1, ID: 2, ParentID: 1, Name: "child", Start: time.Unix(1500000001, 0).UTC(), Tags: map[string]string{"a": "b"}},
+	}
+	require.NoError(t, exporter.Export(ctx, spans[:1]))
+	require.NoError(t, exporter.Export(ctx, spans[1:]))
+	require.NoError(t, exporter.(*tracing.FileExporter).Close())
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	for i, line := range lines {
+		var span tracing.Span
+		require.NoError(t, json.Unmarshal([]byte(line), &span))
+		assert.Equal(t, spans[i], span)
+	}
+}
+
+func TestUploadTrace(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		storageNodes := map[string]bool{}
+		for _, node := range planet.StorageNodes {
+			storageNodes[node.ID().String()] = true
+		}
+
+		traced := context.Context(ctx)
+		finish := tracing.StartTrace(&traced, "upload")
+		traceID, ok := tracing.TraceID(traced)
+		require.True(t, ok)
+
+		err := planet.Uplinks[0].Upload(traced, planet.Satellites[0], "testbucket", "test/path", testrand.Bytes(10*memory.KiB))
+		finish(&err)
+		require.NoError(t, err)
+
+		// the storage nodes may finish their spans after the uplink is done
+		var trees []*tracing.Tree
+		var uploads []*tracing.Tree
+		for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); time.Sleep(50 * time.Millisecond) {
+			trees, err = planet.SpanTrees(ctx, traceID)
+			require.NoError(t, err)
+			if len(trees) == 1 {
+				uploads = trees[0].Find("grpc.piecestore.Piecestore/Upload")
+				if len(uploads) > 0 {
+					break
+				}
+			}
+		}
+
+		require.Len(t, trees, 1)
+		root := trees[0]
+		assert.Zero(t, root.Span.ParentID)
+		assert.True(t, strings.HasSuffix(root.Span.Name, ".upload"))
+
+		// the metainfo requests are handled by the satellite
+		var metainfo []*tracing.Tree
+		root.Walk(func(tree *tracing.Tree) {
+			if strings.HasPrefix(tree.Span.Name, "grpc.metainfo.Metainfo/") {
+				metainfo = append(metainfo, tree)
+			}
+		})
+		require.NotEmpty(t, metainfo)
+		for _, request := range metainfo {
+			assert.Equal(t, planet.Satellites[0].ID().String(), request.Span.Tags["node.id"])
+			assert.NotEmpty(t, request.Children)
+		}
+
+		// the piece uploads are handled by different storage nodes
+		require.NotEmpty(t, uploads)
+		uploaded := map[string]bool{}
+		for _, upload := range uploads {
+			nodeID := upload.Span.Tags["node.id"]
+			assert.True(t, storageNodes[nodeID], nodeID)
+			assert.False(t, uploaded[nodeID], nodeID)
+			uploaded[nodeID] = true
+
+			assert.NotEmpty(t, upload.Find("storj.io/storj/storagenode/piecestore.(*Endpoint).Upload"))
+		}
+	})
+}
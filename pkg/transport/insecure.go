@@ -7,6 +7,8 @@ import (
 	"context"
 
 	"google.golang.org/grpc"
+
+	"storj.io/storj/pkg/tracing"
 )
 
 // DialAddressInsecure returns an insecure grpc connection without tls to a node.
@@ -18,6 +20,8 @@ func DialAddressInsecure(ctx context.Context, address string, opts ...grpc.DialO
 	options := append([]grpc.DialOption{
 		grpc.WithInsecure(),
 		grpc.WithBlock(),
+		grpc.WithChainUnaryInterceptor(tracing.UnaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(tracing.StreamClientInterceptor),
 		grpc.FailOnNonTempDialError(true),
 	}, opts...)
 
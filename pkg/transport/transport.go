@@ -13,6 +13,7 @@ import (
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/peertls/tlsopts"
+	"storj.io/storj/pkg/tracing"
 )
 
 // Observer implements the ConnSuccess and ConnFailure methods
@@ -86,6 +87,8 @@ func (transport *Transport) DialNode(ctx context.Context, node *pb.Node, opts ..
 	options := append([]grpc.DialOption{
 		dialOption,
 		grpc.WithBlock(),
+		grpc.WithChainUnaryInterceptor(tracing.UnaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(tracing.StreamClientInterceptor),
 		grpc.FailOnNonTempDialError(true),
 		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
 			conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
@@ -124,6 +127,8 @@ func (transport *Transport) DialAddress(ctx context.Context, address string, opt
 	options := append([]grpc.DialOption{
 		transport.tlsOpts.DialUnverifiedIDOption(),
 		grpc.WithBlock(),
+		grpc.WithChainUnaryInterceptor(tracing.UnaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(tracing.StreamClientInterceptor),
 		grpc.FailOnNonTempDialError(true),
 		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
 			conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
@@ -0,0 +1,320 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package webdavfs
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"mime"
+	"os"
+	"path"
+	"time"
+
+	"github.com/zeebo/errs"
+	"golang.org/x/net/webdav"
+
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	_ webdav.File = (*readFile)(nil)
+	_ webdav.File = (*writeFile)(nil)
+	_ webdav.File = (*dirFile)(nil)
+
+	_ webdav.ContentTyper = (*objectInfo)(nil)
+	_ webdav.ETager       = (*objectInfo)(nil)
+)
+
+// readFile is an object opened for reading. The object is downloaded from
+// the current offset when it is read, so that reading after seeking only
+// downloads the requested range.
+type readFile struct {
+	ctx    context.Context
+	bucket *uplink.Bucket
+	info   *objectInfo
+
+	offset   int64
+	download io.ReadCloser
+}
+
+// Read reads the object's data at the current offset.
+func (file *readFile) Read(p []byte) (n int, err error) {
+	if file.offset >= file.info.Size() {
+		return 0, io.EOF
+	}
+
+	if file.download == nil {
+		size := file.info.Size() - file.offset
+		file.download, err = file.bucket.DownloadRange(file.ctx, file.info.meta.Path, file.offset, size)
+		if err != nil {
+			return 0, convertError(err)
+		}
+	}
+
+	n, err = file.download.Read(p)
+	file.offset += int64(n)
+	return n, err
+}
+
+// Seek sets the offset the object is read at next.
+func (file *readFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += file.offset
+	case io.SeekEnd:
+		offset += file.info.Size()
+	default:
+		return file.offset, Error.New("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return file.offset, Error.New("negative offset %d", offset)
+	}
+
+	if offset != file.offset && file.download != nil {
+		err := file.download.Close()
+		file.download = nil
+		if err != nil {
+			return file.offset, Error.Wrap(err)
+		}
+	}
+	file.offset = offset
+	return offset, nil
+}
+
+// Write returns an error, as the object was opened for reading.
+func (file *readFile) Write(p []byte) (int, error) {
+	return 0, os.ErrPermission
+}
+
+// Readdir returns an error, as objects aren't directories.
+func (file *readFile) Readdir(count int) ([]os.FileInfo, error) {
+	return nil, Error.New("not a directory")
+}
+
+// Stat returns information about the object.
+func (file *readFile) Stat() (os.FileInfo, error) {
+	return file.info, nil
+}
+
+// Close stops downloading the object.
+func (file *readFile) Close() error {
+	if file.download == nil {
+		return nil
+	}
+	err := file.download.Close()
+	file.download = nil
+	return Error.Wrap(err)
+}
+
+// writeFile is an object opened for writing. It is written to a local cache
+// file, which is uploaded as the object when it is closed.
+type writeFile struct {
+	ctx    context.Context
+	fs     *FileSystem
+	bucket *uplink.Bucket
+	name   string
+	cache  *os.File
+	opts   uplink.UploadOptions
+
+	// dirty is whether the object has to be uploaded when it's closed
+	dirty bool
+}
+
+// Read reads from the cache file.
+func (file *writeFile) Read(p []byte) (int, error) {
+	return file.cache.Read(p)
+}
+
+// Seek seeks within the cache file.
+func (file *writeFile) Seek(offset int64, whence int) (int64, error) {
+	return file.cache.Seek(offset, whence)
+}
+
+// Write writes to the cache file.
+func (file *writeFile) Write(p []byte) (int, error) {
+	file.dirty = true
+	return file.cache.Write(p)
+}
+
+// Readdir returns an error, as objects aren't directories.
+func (file *writeFile) Readdir(count int) ([]os.FileInfo, error) {
+	return nil, Error.New("not a directory")
+}
+
+// Stat returns information about the object as it will be uploaded.
+func (file *writeFile) Stat() (os.FileInfo, error) {
+	stat, err := file.cache.Stat()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, objectPath := splitName(file.name)
+	info := &objectInfo{}
+	info.meta.Bucket = file.bucket.Name
+	info.meta.Path = objectPath
+	info.meta.ContentType = file.opts.ContentType
+	info.meta.Modified = stat.ModTime()
+	info.meta.Size = stat.Size()
+	return info, nil
+}
+
+// Close uploads the cache file as the object, if it was written to, and
+// removes the cache file.
+func (file *writeFile) Close() (err error) {
+	defer mon.Task()(&file.ctx)(&err)
+
+	defer func() {
+		err = errs.Combine(err, file.cache.Close(), os.Remove(file.cache.Name()))
+	}()
+
+	if !file.dirty {
+		return nil
+	}
+
+	if _, err := file.cache.Seek(0, io.SeekStart); err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, objectPath := splitName(file.name)
+	if err := file.bucket.UploadObject(file.ctx, objectPath, file.cache, &file.opts); err != nil {
+		return convertError(err)
+	}
+
+	// the directories containing the object exist without being remembered now
+	file.fs.mu.Lock()
+	for dir := path.Dir(file.name); dir != "/"; dir = path.Dir(dir) {
+		delete(file.fs.dirs, dir)
+	}
+	file.fs.mu.Unlock()
+	return nil
+}
+
+// dirFile is an opened bucket or directory.
+type dirFile struct {
+	ctx  context.Context
+	fs   *FileSystem
+	name string
+	info os.FileInfo
+
+	listed  bool
+	entries []os.FileInfo
+}
+
+// Read returns an error, as directories can't be read.
+func (file *dirFile) Read(p []byte) (int, error) {
+	return 0, Error.New("is a directory")
+}
+
+// Seek returns an error, as directories can't be read.
+func (file *dirFile) Seek(offset int64, whence int) (int64, error) {
+	return 0, Error.New("is a directory")
+}
+
+// Write returns an error, as directories can't be written.
+func (file *dirFile) Write(p []byte) (int, error) {
+	return 0, Error.New("is a directory")
+}
+
+// Readdir returns the next count entries of the directory like
+// (*os.File).Readdir.
+func (file *dirFile) Readdir(count int) (_ []os.FileInfo, err error) {
+	if !file.listed {
+		file.entries, err = file.fs.readdir(file.ctx, file.name)
+		if err != nil {
+			return nil, err
+		}
+		file.listed = true
+	}
+
+	if count <= 0 {
+		entries := file.entries
+		file.entries = nil
+		return entries, nil
+	}
+	if len(file.entries) == 0 {
+		return nil, io.EOF
+	}
+	if count > len(file.entries) {
+		count = len(file.entries)
+	}
+	entries := file.entries[:count]
+	file.entries = file.entries[count:]
+	return entries, nil
+}
+
+// Stat returns information about the directory.
+func (file *dirFile) Stat() (os.FileInfo, error) {
+	return file.info, nil
+}
+
+// Close closes the directory.
+func (file *dirFile) Close() error {
+	return nil
+}
+
+// dirInfo describes a bucket or directory.
+type dirInfo struct {
+	name     string
+	modified time.Time
+}
+
+func (info *dirInfo) Name() string       { return info.name }
+func (info *dirInfo) Size() int64        { return 0 }
+func (info *dirInfo) Mode() os.FileMode  { return os.ModeDir | 0755 }
+func (info *dirInfo) ModTime() time.Time { return info.modified }
+func (info *dirInfo) IsDir() bool        { return true }
+func (info *dirInfo) Sys() interface{}   { return nil }
+
+// objectInfo describes an object.
+type objectInfo struct {
+	meta uplink.ObjectMeta
+}
+
+func (info *objectInfo) Name() string       { return path.Base(info.meta.Path) }
+func (info *objectInfo) Size() int64        { return info.meta.Size }
+func (info *objectInfo) Mode() os.FileMode  { return 0644 }
+func (info *objectInfo) ModTime() time.Time { return info.meta.Modified }
+func (info *objectInfo) IsDir() bool        { return false }
+func (info *objectInfo) Sys() interface{}   { return info.meta }
+
+// ContentType returns the content type of the object. It is guessed from the
+// extension for objects uploaded without one, so that listing a directory
+// doesn't download every object in it.
+func (info *objectInfo) ContentType(ctx context.Context) (string, error) {
+	if info.meta.ContentType != "" {
+		return info.meta.ContentType, nil
+	}
+	if ctype := mime.TypeByExtension(path.Ext(info.meta.Path)); ctype != "" {
+		return ctype, nil
+	}
+	return "application/octet-stream", nil
+}
+
+// ETag returns the MD5 checksum of the object, like the S3 gateway.
+func (info *objectInfo) ETag(ctx context.Context) (string, error) {
+	if len(info.meta.MD5Checksum) == 0 {
+		return "", webdav.ErrNotImplemented
+	}
+	return `"` + hex.EncodeToString(info.meta.MD5Checksum) + `"`, nil
+}
+
+// objectMeta returns the metadata of an object listed under prefix, whose
+// path is relative to prefix.
+func objectMeta(bucket, prefix string, object storj.Object) uplink.ObjectMeta {
+	return uplink.ObjectMeta{
+		Bucket:      bucket,
+		Path:        prefix + object.Path,
+		ContentType: object.ContentType,
+		Metadata:    object.Metadata,
+		Created:     object.Created,
+		Modified:    object.Modified,
+		Expires:     object.Expires,
+		Size:        object.Size,
+		Checksum:    object.Checksum,
+		MD5Checksum: object.MD5Checksum,
+	}
+}
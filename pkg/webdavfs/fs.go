@@ -0,0 +1,586 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package webdavfs exposes the buckets of a project as a WebDAV file system.
+package webdavfs
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"mime"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/net/webdav"
+	"gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the class of errors returned by this package
+	Error = errs.Class("webdavfs")
+)
+
+// Config specifies the file system configuration
+type Config struct {
+	// CacheDir is the directory where files are cached while they are
+	// written. They are uploaded when they are closed. An empty CacheDir
+	// uses the default directory for temporary files.
+	CacheDir string
+
+	// UploadOptions are the options objects are uploaded with. The content
+	// type is guessed from the extension of the object's path.
+	UploadOptions uplink.UploadOptions
+}
+
+// FileSystem implements webdav.FileSystem for the buckets of a project.
+// The root directory contains the buckets and the directories within the
+// buckets are the prefixes of the objects' paths.
+//
+// Objects can't be modified in place, so files opened for writing are
+// written to a local cache file, which is uploaded as the new object when it
+// is closed. Renaming copies objects through the file system.
+type FileSystem struct {
+	log     *zap.Logger
+	project *uplink.Project
+	access  *uplink.EncryptionAccess
+	config  Config
+
+	mu      sync.Mutex
+	buckets map[string]*uplink.Bucket
+	// dirs are the directories created with Mkdir within buckets. There is
+	// nothing to store for an empty prefix, so they only exist until the
+	// file system is closed.
+	dirs map[string]struct{}
+}
+
+var _ webdav.FileSystem = (*FileSystem)(nil)
+
+// New returns a file system for the buckets of project, which are opened
+// with access.
+func New(log *zap.Logger, project *uplink.Project, access *uplink.EncryptionAccess, config Config) *FileSystem {
+	return &FileSystem{
+		log:     log,
+		project: project,
+		access:  access,
+		config:  config,
+		buckets: make(map[string]*uplink.Bucket),
+		dirs:    make(map[string]struct{}),
+	}
+}
+
+// Close closes the buckets opened by the file system.
+func (fs *FileSystem) Close() error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	var group errs.Group
+	for name, bucket := range fs.buckets {
+		group.Add(bucket.Close())
+		delete(fs.buckets, name)
+	}
+	return group.Err()
+}
+
+// Mkdir creates a bucket in the root directory or a directory within a bucket.
+func (fs *FileSystem) Mkdir(ctx context.Context, name string, perm os.FileMode) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketName, objectPath := splitName(name)
+	if bucketName == "" {
+		return os.ErrExist
+	}
+
+	if _, err := fs.Stat(ctx, name); err == nil {
+		return os.ErrExist
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	if objectPath == "" {
+		_, err := fs.project.CreateBucket(ctx, bucketName, nil)
+		return convertError(err)
+	}
+
+	if parent, err := fs.Stat(ctx, path.Dir(clean(name))); err != nil {
+		return err
+	} else if !parent.IsDir() {
+		return os.ErrNotExist
+	}
+
+	fs.mu.Lock()
+	fs.dirs[clean(name)] = struct{}{}
+	fs.mu.Unlock()
+	return nil
+}
+
+// OpenFile opens the named bucket, directory or object. Objects opened for
+// writing are cached locally until they are closed.
+func (fs *FileSystem) OpenFile(ctx context.Context, name string, flag int, perm os.FileMode) (_ webdav.File, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	write := flag&(os.O_WRONLY|os.O_RDWR) != 0
+
+	bucketName, objectPath := splitName(name)
+	if objectPath == "" {
+		if write {
+			return nil, os.ErrPermission
+		}
+		info, err := fs.Stat(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return &dirFile{ctx: ctx, fs: fs, name: clean(name), info: info}, nil
+	}
+
+	if !write {
+		info, err := fs.Stat(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if info.IsDir() {
+			return &dirFile{ctx: ctx, fs: fs, name: clean(name), info: info}, nil
+		}
+
+		bucket, err := fs.bucket(ctx, bucketName)
+		if err != nil {
+			return nil, err
+		}
+		return &readFile{ctx: ctx, bucket: bucket, info: info.(*objectInfo)}, nil
+	}
+
+	return fs.openForWriting(ctx, name, flag)
+}
+
+// openForWriting opens the named object for writing through a local cache
+// file. Unless the object is truncated, its content is downloaded to the
+// cache file first, so that it can be partially overwritten.
+func (fs *FileSystem) openForWriting(ctx context.Context, name string, flag int) (_ webdav.File, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketName, objectPath := splitName(name)
+
+	if parent, err := fs.Stat(ctx, path.Dir(clean(name))); err != nil {
+		return nil, err
+	} else if !parent.IsDir() {
+		return nil, os.ErrNotExist
+	}
+
+	bucket, err := fs.bucket(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := fs.config.UploadOptions
+	opts.ContentType = mime.TypeByExtension(path.Ext(objectPath))
+
+	existing, err := fs.Stat(ctx, name)
+	switch {
+	case err == nil:
+		if existing.IsDir() {
+			return nil, os.ErrExist
+		}
+		if flag&os.O_EXCL != 0 && flag&os.O_CREATE != 0 {
+			return nil, os.ErrExist
+		}
+	case os.IsNotExist(err):
+		if flag&os.O_CREATE == 0 {
+			return nil, os.ErrNotExist
+		}
+		existing = nil
+	default:
+		return nil, err
+	}
+
+	cache, err := ioutil.TempFile(fs.config.CacheDir, "webdav-")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, cache.Close(), os.Remove(cache.Name()))
+		}
+	}()
+
+	file := &writeFile{
+		ctx:    ctx,
+		fs:     fs,
+		bucket: bucket,
+		name:   clean(name),
+		cache:  cache,
+		opts:   opts,
+		dirty:  existing == nil || flag&os.O_TRUNC != 0,
+	}
+
+	if existing != nil && flag&os.O_TRUNC == 0 {
+		meta := existing.(*objectInfo).meta
+		file.opts.ContentType = meta.ContentType
+		file.opts.Metadata = meta.Metadata
+		file.opts.Expires = meta.Expires
+
+		download, err := bucket.Download(ctx, objectPath)
+		if err != nil {
+			return nil, convertError(err)
+		}
+		_, err = io.Copy(cache, download)
+		err = errs.Combine(err, download.Close())
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		if flag&os.O_APPEND == 0 {
+			if _, err := cache.Seek(0, io.SeekStart); err != nil {
+				return nil, Error.Wrap(err)
+			}
+		}
+	}
+
+	return file, nil
+}
+
+// RemoveAll removes the named object or directory and everything in it.
+// Removing a bucket deletes all of its objects.
+func (fs *FileSystem) RemoveAll(ctx context.Context, name string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketName, objectPath := splitName(name)
+	if bucketName == "" {
+		return os.ErrPermission
+	}
+
+	bucket, err := fs.bucket(ctx, bucketName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	if objectPath != "" {
+		err := bucket.DeleteObject(ctx, objectPath)
+		if err != nil && !storj.ErrObjectNotFound.Has(err) {
+			return convertError(err)
+		}
+	}
+
+	err = fs.walk(ctx, bucket, objectPath, func(objectPath string) error {
+		return bucket.DeleteObject(ctx, objectPath)
+	})
+	if err != nil {
+		return convertError(err)
+	}
+
+	fs.forgetDirs(clean(name))
+
+	if objectPath == "" {
+		fs.mu.Lock()
+		delete(fs.buckets, bucketName)
+		fs.mu.Unlock()
+
+		err := errs.Combine(
+			bucket.Close(),
+			fs.project.DeleteBucket(ctx, bucketName),
+		)
+		return convertError(err)
+	}
+	return nil
+}
+
+// Rename moves the named object or directory within or between buckets.
+// The objects are copied to their new paths and then deleted.
+func (fs *FileSystem) Rename(ctx context.Context, oldName, newName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	oldBucketName, oldPath := splitName(oldName)
+	newBucketName, newPath := splitName(newName)
+	if oldPath == "" || newPath == "" {
+		// buckets can't be renamed
+		return os.ErrPermission
+	}
+
+	info, err := fs.Stat(ctx, oldName)
+	if err != nil {
+		return err
+	}
+
+	oldBucket, err := fs.bucket(ctx, oldBucketName)
+	if err != nil {
+		return err
+	}
+	newBucket, err := fs.bucket(ctx, newBucketName)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		return fs.move(ctx, oldBucket, oldPath, newBucket, newPath)
+	}
+
+	err = fs.walk(ctx, oldBucket, oldPath, func(objectPath string) error {
+		return fs.move(ctx, oldBucket, objectPath, newBucket, newPath+strings.TrimPrefix(objectPath, oldPath))
+	})
+	if err != nil {
+		return err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	for dir := range fs.dirs {
+		if dir == clean(oldName) || strings.HasPrefix(dir, clean(oldName)+"/") {
+			delete(fs.dirs, dir)
+			fs.dirs[clean(newName)+strings.TrimPrefix(dir, clean(oldName))] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// move copies the object at oldPath in oldBucket to newPath in newBucket
+// and deletes it at oldPath.
+func (fs *FileSystem) move(ctx context.Context, oldBucket *uplink.Bucket, oldPath string, newBucket *uplink.Bucket, newPath string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	object, err := oldBucket.OpenObject(ctx, oldPath)
+	if err != nil {
+		return convertError(err)
+	}
+	defer func() { err = errs.Combine(err, object.Close()) }()
+
+	download, err := object.DownloadRange(ctx, 0, -1)
+	if err != nil {
+		return convertError(err)
+	}
+	defer func() { err = errs.Combine(err, download.Close()) }()
+
+	opts := fs.config.UploadOptions
+	opts.ContentType = object.Meta.ContentType
+	opts.Metadata = object.Meta.Metadata
+	opts.Expires = object.Meta.Expires
+
+	if err := newBucket.UploadObject(ctx, newPath, download, &opts); err != nil {
+		return convertError(err)
+	}
+	return convertError(oldBucket.DeleteObject(ctx, oldPath))
+}
+
+// Stat returns information about the named bucket, directory or object.
+func (fs *FileSystem) Stat(ctx context.Context, name string) (_ os.FileInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketName, objectPath := splitName(name)
+	if bucketName == "" {
+		return &dirInfo{name: "/"}, nil
+	}
+
+	if objectPath == "" {
+		bucket, _, err := fs.project.GetBucketInfo(ctx, bucketName)
+		if err != nil {
+			return nil, convertError(err)
+		}
+		return &dirInfo{name: bucketName, modified: bucket.Created}, nil
+	}
+
+	bucket, err := fs.bucket(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := bucket.OpenObject(ctx, objectPath)
+	if err == nil {
+		info := &objectInfo{meta: object.Meta}
+		return info, object.Close()
+	}
+	if !storj.ErrObjectNotFound.Has(err) {
+		return nil, convertError(err)
+	}
+
+	fs.mu.Lock()
+	_, ok := fs.dirs[clean(name)]
+	fs.mu.Unlock()
+	if ok {
+		return &dirInfo{name: path.Base(objectPath)}, nil
+	}
+
+	list, err := bucket.ListObjects(ctx, &uplink.ListOptions{
+		Direction: storj.After,
+		Prefix:    objectPath + "/",
+		Limit:     1,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if len(list.Items) > 0 {
+		return &dirInfo{name: path.Base(objectPath)}, nil
+	}
+	return nil, os.ErrNotExist
+}
+
+// readdir returns the buckets in the root directory or the objects and
+// directories in a directory within a bucket.
+func (fs *FileSystem) readdir(ctx context.Context, name string) (infos []os.FileInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketName, objectPath := splitName(name)
+	if bucketName == "" {
+		opts := uplink.BucketListOptions{Direction: storj.After}
+		for {
+			list, err := fs.project.ListBuckets(ctx, &opts)
+			if err != nil {
+				return nil, convertError(err)
+			}
+			for _, bucket := range list.Items {
+				infos = append(infos, &dirInfo{name: bucket.Name, modified: bucket.Created})
+			}
+			if !list.More {
+				return infos, nil
+			}
+			opts = opts.NextPage(list)
+		}
+	}
+
+	bucket, err := fs.bucket(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := ""
+	if objectPath != "" {
+		prefix = objectPath + "/"
+	}
+
+	seen := make(map[string]bool)
+	opts := uplink.ListOptions{Direction: storj.After, Prefix: prefix}
+	for {
+		list, err := bucket.ListObjects(ctx, &opts)
+		if err != nil {
+			return nil, convertError(err)
+		}
+		for _, object := range list.Items {
+			if object.IsPrefix {
+				dir := strings.TrimSuffix(object.Path, "/")
+				seen[dir] = true
+				infos = append(infos, &dirInfo{name: dir})
+				continue
+			}
+			infos = append(infos, &objectInfo{meta: objectMeta(bucketName, prefix, object)})
+		}
+		if !list.More || len(list.Items) == 0 {
+			break
+		}
+		opts.Cursor = list.Items[len(list.Items)-1].Path
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	for dir := range fs.dirs {
+		if path.Dir(dir) == clean(name) && !seen[path.Base(dir)] {
+			infos = append(infos, &dirInfo{name: path.Base(dir)})
+		}
+	}
+	return infos, nil
+}
+
+// walk calls fn for the path of every object under the directory dir of
+// bucket. An empty dir walks the whole bucket.
+func (fs *FileSystem) walk(ctx context.Context, bucket *uplink.Bucket, dir string, fn func(objectPath string) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	prefix := ""
+	if dir != "" {
+		prefix = dir + "/"
+	}
+
+	// collect the paths first, as fn may change the listing
+	var objectPaths []string
+	opts := uplink.ListOptions{Direction: storj.After, Prefix: prefix, Recursive: true}
+	for {
+		list, err := bucket.ListObjects(ctx, &opts)
+		if err != nil {
+			return err
+		}
+		for _, object := range list.Items {
+			objectPaths = append(objectPaths, prefix+object.Path)
+		}
+		if !list.More || len(list.Items) == 0 {
+			break
+		}
+		opts.Cursor = list.Items[len(list.Items)-1].Path
+	}
+
+	for _, objectPath := range objectPaths {
+		if err := fn(objectPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bucket returns the opened bucket with the given name.
+func (fs *FileSystem) bucket(ctx context.Context, name string) (_ *uplink.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fs.mu.Lock()
+	bucket, ok := fs.buckets[name]
+	fs.mu.Unlock()
+	if ok {
+		return bucket, nil
+	}
+
+	bucket, err = fs.project.OpenBucket(ctx, name, fs.access)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if existing, ok := fs.buckets[name]; ok {
+		return existing, bucket.Close()
+	}
+	fs.buckets[name] = bucket
+	return bucket, nil
+}
+
+// forgetDirs forgets the directories created with Mkdir at and under name.
+func (fs *FileSystem) forgetDirs(name string) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	for dir := range fs.dirs {
+		if dir == name || strings.HasPrefix(dir, name+"/") {
+			delete(fs.dirs, dir)
+		}
+	}
+}
+
+// clean returns name as an absolute path without a trailing slash.
+func clean(name string) string {
+	return path.Clean("/" + name)
+}
+
+// splitName splits name into the bucket name and the object path.
+func splitName(name string) (bucket, objectPath string) {
+	name = strings.TrimPrefix(clean(name), "/")
+	if i := strings.IndexByte(name, '/'); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return name, ""
+}
+
+// convertError converts not found errors to os.ErrNotExist, which the WebDAV
+// handler responds to with 404 Not Found.
+func convertError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if storj.ErrBucketNotFound.Has(err) || storj.ErrObjectNotFound.Has(err) {
+		return os.ErrNotExist
+	}
+	return Error.Wrap(err)
+}
@@ -0,0 +1,188 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package webdavfs_test
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/net/webdav"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/lib/uplink"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/webdavfs"
+)
+
+func TestFileSystem(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 5, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		err := planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "testbucket", "dir/foo.txt", []byte("FOO"))
+		require.NoError(t, err)
+
+		apiKey, err := uplink.ParseAPIKey(planet.Uplinks[0].APIKey[planet.Satellites[0].ID()])
+		require.NoError(t, err)
+
+		cfg := new(uplink.Config)
+		cfg.Volatile.Log = zaptest.NewLogger(t)
+		cfg.Volatile.TLS.SkipPeerCAWhitelist = true
+		up, err := uplink.NewUplink(ctx, cfg)
+		require.NoError(t, err)
+		defer ctx.Check(up.Close)
+
+		project, err := up.OpenProject(ctx, planet.Satellites[0].Addr(), apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(project.Close)
+
+		var opts uplink.UploadOptions
+		opts.Volatile.RedundancyScheme = storj.RedundancyScheme{
+			Algorithm:      storj.ReedSolomon,
+			ShareSize:      1 * memory.KiB.Int32(),
+			RequiredShares: 2,
+			RepairShares:   3,
+			OptimalShares:  4,
+			TotalShares:    5,
+		}
+
+		fs := webdavfs.New(zaptest.NewLogger(t), project, uplink.NewEncryptionAccessWithDefaultKey(storj.Key{}), webdavfs.Config{
+			CacheDir:      ctx.Dir("cache"),
+			UploadOptions: opts,
+		})
+		defer ctx.Check(fs.Close)
+
+		server := httptest.NewServer(&webdav.Handler{
+			FileSystem: fs,
+			LockSystem: webdav.NewMemLS(),
+		})
+		defer server.Close()
+
+		do := func(method, path string, body string, header ...string) (int, string) {
+			req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+			require.NoError(t, err)
+			for i := 0; i+1 < len(header); i += 2 {
+				req.Header.Set(header[i], header[i+1])
+			}
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, resp.Body.Close()) }()
+			data, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+			return resp.StatusCode, string(data)
+		}
+
+		{ // listings
+			status, body := do("PROPFIND", "/", "", "Depth", "1")
+			assert.Equal(t, http.StatusMultiStatus, status)
+			assert.Contains(t, body, "/testbucket/")
+
+			status, body = do("PROPFIND", "/testbucket/dir/", "", "Depth", "1")
+			assert.Equal(t, http.StatusMultiStatus, status)
+			assert.Contains(t, body, "/testbucket/dir/foo.txt")
+			assert.Contains(t, body, "<D:getcontentlength>3</D:getcontentlength>")
+
+			status, _ = do("PROPFIND", "/testbucket/missing/", "", "Depth", "1")
+			assert.Equal(t, http.StatusNotFound, status)
+		}
+
+		{ // downloads
+			status, body := do("GET", "/testbucket/dir/foo.txt", "")
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, "FOO", body)
+
+			status, body = do("GET", "/testbucket/dir/foo.txt", "", "Range", "bytes=1-1")
+			assert.Equal(t, http.StatusPartialContent, status)
+			assert.Equal(t, "O", body)
+
+			status, _ = do("GET", "/testbucket/dir/missing.txt", "")
+			assert.Equal(t, http.StatusNotFound, status)
+		}
+
+		{ // uploads and directories
+			status, _ := do("MKCOL", "/testbucket/empty", "")
+			assert.Equal(t, http.StatusCreated, status)
+
+			status, body := do("PROPFIND", "/testbucket/", "", "Depth", "1")
+			assert.Equal(t, http.StatusMultiStatus, status)
+			assert.Contains(t, body, "/testbucket/empty/")
+
+			status, _ = do("PUT", "/testbucket/empty/bar.txt", "BAR")
+			assert.Equal(t, http.StatusCreated, status)
+
+			status, body = do("GET", "/testbucket/empty/bar.txt", "")
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, "BAR", body)
+
+			status, _ = do("PUT", "/testbucket/missing/bar.txt", "BAR")
+			assert.Equal(t, http.StatusNotFound, status)
+		}
+
+		{ // partial writes
+			file, err := fs.OpenFile(ctx, "/testbucket/empty/bar.txt", os.O_RDWR, 0)
+			require.NoError(t, err)
+			_, err = file.Seek(1, io.SeekStart)
+			require.NoError(t, err)
+			_, err = file.Write([]byte("AZ"))
+			require.NoError(t, err)
+			require.NoError(t, file.Close())
+
+			status, body := do("GET", "/testbucket/empty/bar.txt", "")
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, "BAZ", body)
+		}
+
+		{ // moves
+			status, _ := do("MOVE", "/testbucket/empty/bar.txt", "", "Destination", server.URL+"/testbucket/dir/baz.txt")
+			assert.Equal(t, http.StatusCreated, status)
+
+			status, _ = do("GET", "/testbucket/empty/bar.txt", "")
+			assert.Equal(t, http.StatusNotFound, status)
+
+			status, body := do("GET", "/testbucket/dir/baz.txt", "")
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, "BAZ", body)
+
+			status, _ = do("MOVE", "/testbucket/dir", "", "Destination", server.URL+"/testbucket/moved")
+			assert.Equal(t, http.StatusCreated, status)
+
+			status, body = do("GET", "/testbucket/moved/foo.txt", "")
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, "FOO", body)
+		}
+
+		{ // deletes
+			status, _ := do("DELETE", "/testbucket/moved", "")
+			assert.Equal(t, http.StatusNoContent, status)
+
+			for _, path := range []string{"/testbucket/moved/foo.txt", "/testbucket/moved/baz.txt", "/testbucket/moved/"} {
+				status, _ = do("PROPFIND", path, "", "Depth", "0")
+				assert.Equal(t, http.StatusNotFound, status, path)
+			}
+		}
+
+		{ // buckets
+			status, _ := do("MKCOL", "/newbucket", "")
+			assert.Equal(t, http.StatusCreated, status)
+
+			status, _ = do("PUT", "/newbucket/object", "DATA")
+			assert.Equal(t, http.StatusCreated, status)
+
+			status, _ = do("DELETE", "/newbucket", "")
+			assert.Equal(t, http.StatusNoContent, status)
+
+			_, _, err := project.GetBucketInfo(ctx, "newbucket")
+			assert.True(t, storj.ErrBucketNotFound.Has(err))
+		}
+	})
+}
@@ -0,0 +1,173 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console"
+)
+
+// SignatureHeader is the webhook request header containing hex encoded
+// HMAC-SHA256 of the request body keyed with the project webhook secret
+const SignatureHeader = "X-Storj-Signature"
+
+// Alert describes a crossed project usage threshold
+type Alert struct {
+	ProjectID   uuid.UUID                `json:"projectId"`
+	ProjectName string                   `json:"projectName"`
+	Kind        console.ProjectAlertKind `json:"kind"`
+	Threshold   int                      `json:"threshold"`
+	Usage       int64                    `json:"usage"`
+	Limit       int64                    `json:"limit"`
+	PeriodStart time.Time                `json:"periodStart"`
+	CreatedAt   time.Time                `json:"createdAt"`
+}
+
+// UsageAlertEmail is mailservice template for project usage alert email
+type UsageAlertEmail struct {
+	UserName    string
+	ProjectName string
+	Kind        string
+	Threshold   int
+	Usage       string
+	Limit       string
+}
+
+// Template returns email template name
+func (*UsageAlertEmail) Template() string { return "UsageAlert" }
+
+// Subject gets email subject
+func (email *UsageAlertEmail) Subject() string {
+	return "Project " + email.ProjectName + " reached its " + email.Kind + " alert threshold"
+}
+
+// Sign returns hex encoded HMAC-SHA256 of body keyed with secret
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SendWebhook posts the alert as json to url, signing the body with secret
+func SendWebhook(ctx context.Context, client *http.Client, url string, secret []byte, alert Alert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(secret, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Error.New("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// nonPublicNetworks are the address ranges webhooks are never delivered to,
+// so that webhooks can't be used to reach the satellite's internal network
+var nonPublicNetworks = mustParseCIDRs(
+	"0.0.0.0/8",      // "this" network
+	"10.0.0.0/8",     // private
+	"100.64.0.0/10",  // shared address space
+	"127.0.0.0/8",    // loopback
+	"169.254.0.0/16", // link-local
+	"172.16.0.0/12",  // private
+	"192.0.0.0/24",   // protocol assignments
+	"192.168.0.0/16", // private
+	"198.18.0.0/15",  // benchmarking
+	"224.0.0.0/4",    // multicast
+	"240.0.0.0/4",    // reserved and broadcast
+	"::/128",         // unspecified
+	"::1/128",        // loopback
+	"64:ff9b::/96",   // IPv4/IPv6 translation
+	"fc00::/7",       // unique local
+	"fe80::/10",      // link-local
+	"ff00::/8",       // multicast
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// isPublicIP returns whether ip is a public unicast address
+func isPublicIP(ip net.IP) bool {
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkWebhookAddress refuses connections to non-public addresses. It is
+// called with the resolved address of every connection, including the ones
+// of redirects, so that the checked address is the one connected to.
+func checkWebhookAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return Error.New("webhook address %s is not public", host)
+	}
+	return nil
+}
+
+// newWebhookClient returns a client for delivering webhooks, which refuses to
+// connect to non-public addresses unless allowPrivate is set
+func newWebhookClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = checkWebhookAddress
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		// webhooks are not sent through proxies, which would connect to the
+		// webhook host without the address being checked
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     time.Minute,
+		},
+	}
+}
@@ -0,0 +1,365 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/post"
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/live"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/mailservice"
+)
+
+var (
+	// Error is the default usage alerts errs class
+	Error = errs.Class("usage alerts error")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for project usage alerts
+type Config struct {
+	Interval            time.Duration `help:"how frequently projects with changed usage are checked for alerts" releaseDefault:"1m" devDefault:"10s"`
+	DefaultThresholds   string        `help:"comma separated percentages of the project limits which trigger alerts for projects without alert settings" default:"80,100"`
+	WebhookTimeout      time.Duration `help:"timeout for delivering an alert webhook" default:"10s"`
+	WebhookAllowPrivate bool          `help:"allow delivering alert webhooks to loopback, link-local and private addresses" default:"false"`
+}
+
+// Service checks storage and bandwidth usage of projects against their alert
+// thresholds and sends each alert only once per billing period.
+//
+// architecture: Chore
+type Service struct {
+	log               *zap.Logger
+	consoleDB         console.DB
+	projectAccounting accounting.ProjectAccounting
+	liveAccounting    live.Service
+	mail              *mailservice.Service
+	maxAlphaUsage     memory.Size
+	defaultThresholds []int
+	client            *http.Client
+
+	mu      sync.Mutex
+	pending map[uuid.UUID]struct{}
+
+	Loop sync2.Cycle
+}
+
+// NewService creates a new usage alerts service
+func NewService(log *zap.Logger, config Config, consoleDB console.DB, projectAccounting accounting.ProjectAccounting, liveAccounting live.Service, mail *mailservice.Service, maxAlphaUsage memory.Size) (*Service, error) {
+	defaultThresholds, err := ParseThresholds(config.DefaultThresholds)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &Service{
+		log:               log,
+		consoleDB:         consoleDB,
+		projectAccounting: projectAccounting,
+		liveAccounting:    liveAccounting,
+		mail:              mail,
+		maxAlphaUsage:     maxAlphaUsage,
+		defaultThresholds: defaultThresholds,
+		client:            newWebhookClient(config.WebhookTimeout, config.WebhookAllowPrivate),
+		pending:           make(map[uuid.UUID]struct{}),
+
+		Loop: *sync2.NewCycle(config.Interval),
+	}, nil
+}
+
+// ProjectUsageChanged queues the project to be checked on the next cycle
+func (service *Service) ProjectUsageChanged(projectID uuid.UUID) {
+	service.mu.Lock()
+	service.pending[projectID] = struct{}{}
+	service.mu.Unlock()
+}
+
+// Run starts checking projects with changed usage
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		err := service.CheckPending(ctx)
+		if err != nil {
+			service.log.Error("checking usage alerts failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the service
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// CheckPending checks every project queued since the last check
+func (service *Service) CheckPending(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.mu.Lock()
+	pending := service.pending
+	service.pending = make(map[uuid.UUID]struct{})
+	service.mu.Unlock()
+
+	var group errs.Group
+	for projectID := range pending {
+		group.Add(service.CheckProject(ctx, projectID))
+	}
+	return group.Err()
+}
+
+// CheckProject sends alerts for every threshold the project usage has crossed
+// and which has not been alerted in the current billing period
+func (service *Service) CheckProject(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	storageThresholds, bandwidthThresholds := service.defaultThresholds, service.defaultThresholds
+	var webhookURL string
+	var webhookSecret []byte
+
+	settings, err := service.consoleDB.ProjectAlerts().GetSettings(ctx, projectID)
+	switch {
+	case err == nil:
+		storageThresholds, bandwidthThresholds = settings.StorageThresholds, settings.BandwidthThresholds
+		webhookURL, webhookSecret = settings.WebhookURL, settings.WebhookSecret
+	case console.ErrAlertSettingsNotFound.Has(err):
+	default:
+		return Error.Wrap(err)
+	}
+
+	if len(storageThresholds) == 0 && len(bandwidthThresholds) == 0 {
+		return nil
+	}
+
+	usage, err := service.getUsage(ctx, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	periodStart := BillingPeriodStart(time.Now())
+
+	var alerts []Alert
+	for _, check := range []struct {
+		kind       console.ProjectAlertKind
+		used       int64
+		thresholds []int
+	}{
+		{console.AlertStorage, usage.storage, storageThresholds},
+		{console.AlertBandwidth, usage.bandwidth, bandwidthThresholds},
+	} {
+		for _, threshold := range crossedThresholds(check.thresholds, check.used, usage.limit) {
+			// the alert is marked before delivery, so that concurrent checks
+			// don't send it twice, and it is cleared again when delivery fails
+			sent, err := service.consoleDB.ProjectAlerts().MarkSent(ctx, console.ProjectAlert{
+				ProjectID:   projectID,
+				Kind:        check.kind,
+				Threshold:   threshold,
+				PeriodStart: periodStart,
+			})
+			if err != nil {
+				return Error.Wrap(errs.Combine(err, service.clearSent(ctx, alerts)))
+			}
+			if !sent {
+				continue
+			}
+
+			alerts = append(alerts, Alert{
+				ProjectID:   projectID,
+				Kind:        check.kind,
+				Threshold:   threshold,
+				Usage:       check.used,
+				Limit:       usage.limit,
+				PeriodStart: periodStart,
+				CreatedAt:   time.Now().UTC(),
+			})
+		}
+	}
+
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	return service.send(ctx, alerts, webhookURL, webhookSecret)
+}
+
+// send delivers alerts to the project owner and to the webhook. Alerts which
+// fail to be delivered are cleared and the project is checked again.
+func (service *Service) send(ctx context.Context, alerts []Alert, webhookURL string, webhookSecret []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projectID := alerts[0].ProjectID
+
+	project, err := service.consoleDB.Projects().Get(ctx, projectID)
+	if err != nil {
+		service.ProjectUsageChanged(projectID)
+		return Error.Wrap(errs.Combine(err, service.clearSent(ctx, alerts)))
+	}
+
+	owner, err := service.consoleDB.Users().Get(ctx, project.OwnerID)
+	if err != nil {
+		service.ProjectUsageChanged(projectID)
+		return Error.Wrap(errs.Combine(err, service.clearSent(ctx, alerts)))
+	}
+
+	var group errs.Group
+	for _, alert := range alerts {
+		alert.ProjectName = project.Name
+
+		err := service.deliver(ctx, owner, alert, webhookURL, webhookSecret)
+		if err != nil {
+			group.Add(err)
+			group.Add(service.clearSent(ctx, []Alert{alert}))
+			service.ProjectUsageChanged(projectID)
+		}
+	}
+
+	return Error.Wrap(group.Err())
+}
+
+// deliver sends a single alert to the project owner and to the webhook
+func (service *Service) deliver(ctx context.Context, owner *console.User, alert Alert, webhookURL string, webhookSecret []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.log.Info("project usage alert",
+		zap.Stringer("project", alert.ProjectID),
+		zap.String("kind", string(alert.Kind)),
+		zap.Int("threshold", alert.Threshold))
+
+	if service.mail != nil {
+		err = service.mail.SendRendered(ctx,
+			[]post.Address{{Address: owner.Email, Name: owner.FullName}},
+			&UsageAlertEmail{
+				UserName:    owner.FullName,
+				ProjectName: alert.ProjectName,
+				Kind:        string(alert.Kind),
+				Threshold:   alert.Threshold,
+				Usage:       memory.Size(alert.Usage).String(),
+				Limit:       memory.Size(alert.Limit).String(),
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if webhookURL != "" {
+		return SendWebhook(ctx, service.client, webhookURL, webhookSecret, alert)
+	}
+	return nil
+}
+
+// clearSent removes the records of alerts which have not been delivered
+func (service *Service) clearSent(ctx context.Context, alerts []Alert) error {
+	var group errs.Group
+	for _, alert := range alerts {
+		group.Add(service.consoleDB.ProjectAlerts().ClearSent(ctx, console.ProjectAlert{
+			ProjectID:   alert.ProjectID,
+			Kind:        alert.Kind,
+			Threshold:   alert.Threshold,
+			PeriodStart: alert.PeriodStart,
+		}))
+	}
+	return group.Err()
+}
+
+// projectUsage contains raw usage and limit of a project
+type projectUsage struct {
+	storage   int64
+	bandwidth int64
+	limit     int64
+}
+
+// getUsage returns raw storage and bandwidth usage and the limit of the project
+// the same way as they are checked by accounting.ProjectUsage
+func (service *Service) getUsage(ctx context.Context, projectID uuid.UUID) (_ projectUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limit := service.maxAlphaUsage
+	projectLimit, err := service.projectAccounting.GetProjectUsageLimits(ctx, projectID)
+	if err != nil {
+		return projectUsage{}, err
+	}
+	if projectLimit > 0 {
+		limit = projectLimit
+	}
+
+	inline, remote, err := service.projectAccounting.GetStorageTotals(ctx, projectID)
+	if err != nil {
+		return projectUsage{}, err
+	}
+	liveInline, liveRemote, err := service.liveAccounting.GetProjectStorageUsage(ctx, projectID)
+	if err != nil {
+		return projectUsage{}, err
+	}
+
+	from := time.Now().AddDate(0, 0, -accounting.AverageDaysInMonth)
+	bandwidth, err := service.projectAccounting.GetAllocatedBandwidthTotal(ctx, projectID, from)
+	if err != nil {
+		return projectUsage{}, err
+	}
+
+	return projectUsage{
+		storage:   inline + remote + liveInline + liveRemote,
+		bandwidth: bandwidth,
+		limit:     limit.Int64() * accounting.ExpansionFactor,
+	}, nil
+}
+
+// crossedThresholds returns every threshold crossed by used amount
+func crossedThresholds(thresholds []int, used, limit int64) (crossed []int) {
+	if limit <= 0 {
+		return nil
+	}
+
+	percent := float64(used) * 100 / float64(limit)
+	for _, threshold := range thresholds {
+		if percent >= float64(threshold) {
+			crossed = append(crossed, threshold)
+		}
+	}
+	return crossed
+}
+
+// BillingPeriodStart returns the start of the billing period, i.e. the calendar month, of t
+func BillingPeriodStart(t time.Time) time.Time {
+	utc := t.UTC()
+	return time.Date(utc.Year(), utc.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// ParseThresholds parses comma separated percentages
+func ParseThresholds(value string) ([]int, error) {
+	var thresholds []int
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		threshold, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, err
+		}
+		if threshold <= 0 {
+			return nil, errs.New("invalid threshold %d", threshold)
+		}
+		thresholds = append(thresholds, threshold)
+	}
+	sort.Ints(thresholds)
+	return thresholds, nil
+}
@@ -0,0 +1,205 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/accounting/alerts"
+	"storj.io/storj/satellite/accounting/live"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestCheckProject(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		secret := []byte("secret")
+
+		var mu sync.Mutex
+		var received []alerts.Alert
+		var unavailable bool
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			fail := unavailable
+			mu.Unlock()
+			if fail {
+				http.Error(w, "unavailable", http.StatusServiceUnavailable)
+				return
+			}
+
+			body, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Equal(t, alerts.Sign(secret, body), r.Header.Get(alerts.SignatureHeader))
+
+			var alert alerts.Alert
+			require.NoError(t, json.Unmarshal(body, &alert))
+
+			mu.Lock()
+			received = append(received, alert)
+			mu.Unlock()
+		}))
+		defer server.Close()
+
+		user, err := db.Console().Users().Insert(ctx, &console.User{
+			FullName:     "Alert Owner",
+			Email:        "alerts@mail.test",
+			PasswordHash: []byte("123a123"),
+		})
+		require.NoError(t, err)
+
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{
+			Name:    "alerted",
+			OwnerID: user.ID,
+		})
+		require.NoError(t, err)
+
+		_, err = db.Console().ProjectAlerts().UpsertSettings(ctx, console.ProjectAlertSettings{
+			ProjectID:           project.ID,
+			StorageThresholds:   []int{50, 80, 100},
+			BandwidthThresholds: []int{80},
+			WebhookURL:          server.URL,
+			WebhookSecret:       secret,
+		})
+		require.NoError(t, err)
+
+		liveAccounting, err := live.New(zaptest.NewLogger(t), live.Config{})
+		require.NoError(t, err)
+
+		service, err := alerts.NewService(zaptest.NewLogger(t), alerts.Config{
+			Interval:            time.Hour,
+			DefaultThresholds:   "80,100",
+			WebhookTimeout:      10 * time.Second,
+			WebhookAllowPrivate: true,
+		}, db.Console(), db.ProjectAccounting(), liveAccounting, nil, memory.GB)
+		require.NoError(t, err)
+
+		// below every threshold
+		require.NoError(t, liveAccounting.AddProjectStorageUsage(ctx, project.ID, 0, memory.GB.Int64()))
+		require.NoError(t, service.CheckProject(ctx, project.ID))
+		assert.Len(t, received, 0)
+
+		// crossing 50% and 80% sends an alert for both thresholds
+		require.NoError(t, liveAccounting.AddProjectStorageUsage(ctx, project.ID, 0, memory.GB.Int64()))
+		require.NoError(t, liveAccounting.AddProjectStorageUsage(ctx, project.ID, 0, memory.GB.Int64()/2))
+		require.NoError(t, service.CheckProject(ctx, project.ID))
+		require.Len(t, received, 2)
+		for i, threshold := range []int{50, 80} {
+			assert.Equal(t, project.ID, received[i].ProjectID)
+			assert.Equal(t, "alerted", received[i].ProjectName)
+			assert.Equal(t, console.AlertStorage, received[i].Kind)
+			assert.Equal(t, threshold, received[i].Threshold)
+			assert.Equal(t, alerts.BillingPeriodStart(time.Now()), received[i].PeriodStart.UTC())
+		}
+
+		// the same alert is not sent again in the billing period
+		service.ProjectUsageChanged(project.ID)
+		require.NoError(t, service.CheckPending(ctx))
+		assert.Len(t, received, 2)
+
+		// an alert which failed to be delivered is sent on the next check
+		mu.Lock()
+		unavailable = true
+		mu.Unlock()
+
+		require.NoError(t, liveAccounting.AddProjectStorageUsage(ctx, project.ID, 0, memory.GB.Int64()))
+		require.Error(t, service.CheckProject(ctx, project.ID))
+		assert.Len(t, received, 2)
+
+		mu.Lock()
+		unavailable = false
+		mu.Unlock()
+
+		require.NoError(t, service.CheckPending(ctx))
+		require.Len(t, received, 3)
+		assert.Equal(t, 100, received[2].Threshold)
+	})
+}
+
+func TestWebhookPrivateAddress(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		var mu sync.Mutex
+		var requests int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			requests++
+			mu.Unlock()
+		}))
+		defer server.Close()
+
+		user, err := db.Console().Users().Insert(ctx, &console.User{
+			FullName:     "Alert Owner",
+			Email:        "alerts@mail.test",
+			PasswordHash: []byte("123a123"),
+		})
+		require.NoError(t, err)
+
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{
+			Name:    "alerted",
+			OwnerID: user.ID,
+		})
+		require.NoError(t, err)
+
+		// the address is checked after the host name is resolved
+		_, port, err := net.SplitHostPort(server.Listener.Addr().String())
+		require.NoError(t, err)
+
+		_, err = db.Console().ProjectAlerts().UpsertSettings(ctx, console.ProjectAlertSettings{
+			ProjectID:         project.ID,
+			StorageThresholds: []int{50},
+			WebhookURL:        "http://localhost:" + port + "/hook",
+			WebhookSecret:     []byte("secret"),
+		})
+		require.NoError(t, err)
+
+		liveAccounting, err := live.New(zaptest.NewLogger(t), live.Config{})
+		require.NoError(t, err)
+
+		service, err := alerts.NewService(zaptest.NewLogger(t), alerts.Config{
+			Interval:       time.Hour,
+			WebhookTimeout: 10 * time.Second,
+		}, db.Console(), db.ProjectAccounting(), liveAccounting, nil, memory.GB)
+		require.NoError(t, err)
+
+		require.NoError(t, liveAccounting.AddProjectStorageUsage(ctx, project.ID, 0, 2*memory.GB.Int64()))
+		err = service.CheckProject(ctx, project.ID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is not public")
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, 0, requests)
+	})
+}
+
+func TestParseThresholds(t *testing.T) {
+	thresholds, err := alerts.ParseThresholds("100, 80,,90")
+	require.NoError(t, err)
+	assert.Equal(t, []int{80, 90, 100}, thresholds)
+
+	_, err = alerts.ParseThresholds("80,x")
+	assert.Error(t, err)
+
+	_, err = alerts.ParseThresholds("-1")
+	assert.Error(t, err)
+}
@@ -28,19 +28,26 @@ var (
 	ErrProjectUsage = errs.Class("project usage error")
 )
 
+// UsageNotifier is notified about projects whose storage or bandwidth usage may have changed
+type UsageNotifier interface {
+	ProjectUsageChanged(projectID uuid.UUID)
+}
+
 // ProjectUsage defines project usage
 type ProjectUsage struct {
 	projectAccountingDB ProjectAccounting
 	liveAccounting      live.Service
 	maxAlphaUsage       memory.Size
+	notifier            UsageNotifier
 }
 
-// NewProjectUsage created new instance of project usage service
-func NewProjectUsage(projectAccountingDB ProjectAccounting, liveAccounting live.Service, maxAlphaUsage memory.Size) *ProjectUsage {
+// NewProjectUsage created new instance of project usage service, notifier is optional
+func NewProjectUsage(projectAccountingDB ProjectAccounting, liveAccounting live.Service, maxAlphaUsage memory.Size, notifier UsageNotifier) *ProjectUsage {
 	return &ProjectUsage{
 		projectAccountingDB: projectAccountingDB,
 		liveAccounting:      liveAccounting,
 		maxAlphaUsage:       maxAlphaUsage,
+		notifier:            notifier,
 	}
 }
 
@@ -51,6 +58,9 @@ func NewProjectUsage(projectAccountingDB ProjectAccounting, liveAccounting live.
 func (usage *ProjectUsage) ExceedsBandwidthUsage(ctx context.Context, projectID uuid.UUID, bucketID []byte) (_ bool, limit memory.Size, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	// bandwidth is allocated after this check, so let the notifier know about it
+	usage.notify(projectID)
+
 	var group errgroup.Group
 	var bandwidthGetTotal int64
 	limit = usage.maxAlphaUsage
@@ -139,5 +149,19 @@ func (usage *ProjectUsage) getProjectStorageTotals(ctx context.Context, projectI
 // and remoteSpaceUsed bytes of remote space usage.
 func (usage *ProjectUsage) AddProjectStorageUsage(ctx context.Context, projectID uuid.UUID, inlineSpaceUsed, remoteSpaceUsed int64) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	return usage.liveAccounting.AddProjectStorageUsage(ctx, projectID, inlineSpaceUsed, remoteSpaceUsed)
+
+	err = usage.liveAccounting.AddProjectStorageUsage(ctx, projectID, inlineSpaceUsed, remoteSpaceUsed)
+	if err != nil {
+		return err
+	}
+
+	usage.notify(projectID)
+	return nil
+}
+
+// notify lets the notifier know that project usage has changed
+func (usage *ProjectUsage) notify(projectID uuid.UUID) {
+	if usage.notifier != nil {
+		usage.notifier.ProjectUsageChanged(projectID)
+	}
 }
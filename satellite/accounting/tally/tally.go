@@ -36,10 +36,11 @@ type Service struct {
 	storagenodeAccountingDB accounting.StoragenodeAccounting
 	projectAccountingDB     accounting.ProjectAccounting
 	liveAccounting          live.Service
+	usageNotifier           accounting.UsageNotifier
 }
 
-// New creates a new tally Service
-func New(logger *zap.Logger, sdb accounting.StoragenodeAccounting, pdb accounting.ProjectAccounting, liveAccounting live.Service, metainfo *metainfo.Service, overlay *overlay.Service, limit int, interval time.Duration) *Service {
+// New creates a new tally Service, usageNotifier is optional
+func New(logger *zap.Logger, sdb accounting.StoragenodeAccounting, pdb accounting.ProjectAccounting, liveAccounting live.Service, usageNotifier accounting.UsageNotifier, metainfo *metainfo.Service, overlay *overlay.Service, limit int, interval time.Duration) *Service {
 	return &Service{
 		logger:                  logger,
 		metainfo:                metainfo,
@@ -49,6 +50,7 @@ func New(logger *zap.Logger, sdb accounting.StoragenodeAccounting, pdb accountin
 		storagenodeAccountingDB: sdb,
 		projectAccountingDB:     pdb,
 		liveAccounting:          liveAccounting,
+		usageNotifier:           usageNotifier,
 	}
 }
 
@@ -97,12 +99,32 @@ func (t *Service) Tally(ctx context.Context) (err error) {
 			_, err = t.projectAccountingDB.SaveTallies(ctx, latestTally, bucketData)
 			if err != nil {
 				errBucketInfo = errs.New("Saving bucket storage data failed")
+			} else {
+				t.notifyProjects(bucketData)
 			}
 		}
 	}
 	return errs.Combine(errAtRest, errBucketInfo)
 }
 
+// notifyProjects lets the usage notifier know about every tallied project
+func (t *Service) notifyProjects(bucketData map[string]*accounting.BucketTally) {
+	if t.usageNotifier == nil {
+		return
+	}
+
+	notified := make(map[uuid.UUID]struct{})
+	for _, tally := range bucketData {
+		var projectID uuid.UUID
+		copy(projectID[:], tally.ProjectID)
+		if _, ok := notified[projectID]; ok {
+			continue
+		}
+		notified[projectID] = struct{}{}
+		t.usageNotifier.ProjectUsageChanged(projectID)
+	}
+}
+
 // CalculateAtRestData iterates through the pieces on metainfo and calculates
 // the amount of at-rest data stored in each bucket and on each respective node
 func (t *Service) CalculateAtRestData(ctx context.Context) (latestTally time.Time, nodeData map[storj.NodeID]float64, bucketTallies map[string]*accounting.BucketTally, err error) {
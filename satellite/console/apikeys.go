@@ -24,6 +24,8 @@ type APIKeys interface {
 	Update(ctx context.Context, key APIKeyInfo) error
 	// Delete deletes APIKeyInfo from store
 	Delete(ctx context.Context, id uuid.UUID) error
+	// AddUsage adds usage deltas to the stored usage of api keys
+	AddUsage(ctx context.Context, usages []APIKeyUsage) error
 }
 
 // APIKeyInfo describing api key model in the database
@@ -34,4 +36,20 @@ type APIKeyInfo struct {
 	Name      string    `json:"name"`
 	Secret    []byte    `json:"-"`
 	CreatedAt time.Time `json:"createdAt"`
+
+	// usage is filled only when listing keys of a project,
+	// LastUsedAt is zero when the key has never been used
+	LastUsedAt      time.Time `json:"lastUsedAt"`
+	RequestCount    int64     `json:"requestCount"`
+	BytesUploaded   int64     `json:"bytesUploaded"`
+	BytesDownloaded int64     `json:"bytesDownloaded"`
+}
+
+// APIKeyUsage describes usage of an api key since the last flush
+type APIKeyUsage struct {
+	APIKeyID        uuid.UUID
+	LastUsedAt      time.Time
+	RequestCount    int64
+	BytesUploaded   int64
+	BytesDownloaded int64
 }
@@ -6,8 +6,11 @@ package console_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/pkg/macaroon"
@@ -89,6 +92,37 @@ func TestApiKeysRepository(t *testing.T) {
 			assert.NoError(t, err)
 		})
 
+		t.Run("AddUsage success", func(t *testing.T) {
+			keys, err := apikeys.GetByProjectID(ctx, project.ID)
+			require.NoError(t, err)
+			require.Len(t, keys, 10)
+			assert.True(t, keys[1].LastUsedAt.IsZero())
+
+			unknownID, err := uuid.New()
+			require.NoError(t, err)
+
+			first := time.Now().Add(-time.Minute)
+			err = apikeys.AddUsage(ctx, []console.APIKeyUsage{
+				{APIKeyID: keys[1].ID, LastUsedAt: first, RequestCount: 2, BytesUploaded: 100},
+				{APIKeyID: *unknownID, LastUsedAt: first, RequestCount: 1},
+			})
+			require.NoError(t, err)
+
+			last := time.Now()
+			err = apikeys.AddUsage(ctx, []console.APIKeyUsage{
+				{APIKeyID: keys[1].ID, LastUsedAt: last, RequestCount: 3, BytesDownloaded: 50},
+			})
+			require.NoError(t, err)
+
+			keys, err = apikeys.GetByProjectID(ctx, project.ID)
+			require.NoError(t, err)
+			assert.WithinDuration(t, last, keys[1].LastUsedAt, time.Second)
+			assert.EqualValues(t, 5, keys[1].RequestCount)
+			assert.EqualValues(t, 100, keys[1].BytesUploaded)
+			assert.EqualValues(t, 50, keys[1].BytesDownloaded)
+			assert.True(t, keys[2].LastUsedAt.IsZero())
+		})
+
 		t.Run("Delete success", func(t *testing.T) {
 			keys, err := apikeys.GetByProjectID(ctx, project.ID)
 			assert.NotNil(t, keys)
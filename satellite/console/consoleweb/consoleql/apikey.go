@@ -4,6 +4,8 @@
 package consoleql
 
 import (
+	"time"
+
 	"github.com/graphql-go/graphql"
 
 	"storj.io/storj/satellite/console"
@@ -17,6 +19,14 @@ const (
 	CreateAPIKeyType = "graphqlCreateAPIKey"
 	// FieldKey is field name for the actual key in createAPIKey
 	FieldKey = "key"
+	// FieldLastUsedAt is field name for the last time api key was used
+	FieldLastUsedAt = "lastUsedAt"
+	// FieldRequestCount is field name for number of requests made with api key
+	FieldRequestCount = "requestCount"
+	// FieldBytesUploaded is field name for bytes uploaded with api key
+	FieldBytesUploaded = "bytesUploaded"
+	// FieldBytesDownloaded is field name for bytes downloaded with api key
+	FieldBytesDownloaded = "bytesDownloaded"
 )
 
 // graphqlAPIKeyInfo creates satellite.APIKeyInfo graphql object
@@ -39,6 +49,32 @@ func graphqlAPIKeyInfo() *graphql.Object {
 			FieldPartnerID: &graphql.Field{
 				Type: graphql.String,
 			},
+			FieldLastUsedAt: &graphql.Field{
+				Type: graphql.DateTime,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					var lastUsedAt time.Time
+					switch info := p.Source.(type) {
+					case console.APIKeyInfo:
+						lastUsedAt = info.LastUsedAt
+					case *console.APIKeyInfo:
+						lastUsedAt = info.LastUsedAt
+					}
+
+					if lastUsedAt.IsZero() {
+						return nil, nil
+					}
+					return lastUsedAt, nil
+				},
+			},
+			FieldRequestCount: &graphql.Field{
+				Type: graphql.Float,
+			},
+			FieldBytesUploaded: &graphql.Field{
+				Type: graphql.Float,
+			},
+			FieldBytesDownloaded: &graphql.Field{
+				Type: graphql.Float,
+			},
 		},
 	})
 }
@@ -31,6 +31,8 @@ const (
 	DeleteProjectMutation = "deleteProject"
 	// UpdateProjectDescriptionMutation is a mutation name for project updating
 	UpdateProjectDescriptionMutation = "updateProjectDescription"
+	// UpdateProjectAlertsMutation is a mutation name for project usage alert settings updating
+	UpdateProjectAlertsMutation = "updateProjectAlerts"
 
 	// AddProjectMembersMutation is a mutation name for adding new project members
 	AddProjectMembersMutation = "addProjectMembers"
@@ -41,6 +43,8 @@ const (
 	CreateAPIKeyMutation = "createAPIKey"
 	// DeleteAPIKeysMutation is a mutation name for api key deleting
 	DeleteAPIKeysMutation = "deleteAPIKeys"
+	// RevokeAPIKeyMutation is a mutation name for revoking a restricted api key
+	RevokeAPIKeyMutation = "revokeAPIKey"
 
 	// AddPaymentMethodMutation is mutation name for adding new payment method
 	AddPaymentMethodMutation = "addPaymentMethod"
@@ -274,6 +278,37 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					return service.UpdateProject(p.Context, *projectID, description)
 				},
 			},
+			// updates project usage alert settings
+			UpdateProjectAlertsMutation: &graphql.Field{
+				Type: types.projectAlerts,
+				Args: graphql.FieldConfigArgument{
+					FieldProjectID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					FieldStorageThresholds: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.NewList(graphql.Int)),
+					},
+					FieldBandwidthThresholds: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.NewList(graphql.Int)),
+					},
+					FieldWebhookURL: &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					inputID := p.Args[FieldProjectID].(string)
+					projectID, err := uuid.Parse(inputID)
+					if err != nil {
+						return nil, err
+					}
+
+					storageThresholds := toThresholds(p.Args[FieldStorageThresholds])
+					bandwidthThresholds := toThresholds(p.Args[FieldBandwidthThresholds])
+					webhookURL, _ := p.Args[FieldWebhookURL].(string)
+
+					return service.UpdateProjectAlertSettings(p.Context, *projectID, storageThresholds, bandwidthThresholds, webhookURL)
+				},
+			},
 			// add user as member of given project
 			AddProjectMembersMutation: &graphql.Field{
 				Type: types.project,
@@ -434,6 +469,19 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					return keys, nil
 				},
 			},
+			// revokes restricted api key and keys derived from it
+			RevokeAPIKeyMutation: &graphql.Field{
+				Type: types.apiKeyInfo,
+				Args: graphql.FieldConfigArgument{
+					FieldKey: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					key, _ := p.Args[FieldKey].(string)
+					return service.RevokeAPIKey(p.Context, key)
+				},
+			},
 			AddPaymentMethodMutation: &graphql.Field{
 				Type: graphql.Boolean,
 				Args: graphql.FieldConfigArgument{
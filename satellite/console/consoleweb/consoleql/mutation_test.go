@@ -20,11 +20,13 @@ import (
 	"storj.io/storj/internal/post"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb/consoleql"
 	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/localpayments"
 	"storj.io/storj/satellite/rewards"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
@@ -56,6 +58,7 @@ func TestGrapqhlMutation(t *testing.T) {
 			db.Console(),
 			db.Rewards(),
 			localpayments.NewService(nil),
+			payments.PriceTable{},
 			console.TestPasswordCost,
 		)
 		require.NoError(t, err)
@@ -411,6 +414,24 @@ func TestGrapqhlMutation(t *testing.T) {
 			assert.Equal(t, "", proj[consoleql.FieldDescription])
 		})
 
+		t.Run("Update project alerts mutation", func(t *testing.T) {
+			query := fmt.Sprintf(
+				"mutation {updateProjectAlerts(projectID:\"%s\",storageThresholds:[80,100],bandwidthThresholds:[90],webhookURL:\"%s\"){storageThresholds,bandwidthThresholds,webhookURL,webhookSecret}}",
+				project.ID.String(),
+				"https://example.test/hook",
+			)
+
+			result := testQuery(t, query)
+
+			data := result.(map[string]interface{})
+			settings := data[consoleql.UpdateProjectAlertsMutation].(map[string]interface{})
+
+			assert.Equal(t, []interface{}{80, 100}, settings[consoleql.FieldStorageThresholds])
+			assert.Equal(t, []interface{}{90}, settings[consoleql.FieldBandwidthThresholds])
+			assert.Equal(t, "https://example.test/hook", settings[consoleql.FieldWebhookURL])
+			assert.Len(t, settings[consoleql.FieldWebhookSecret], 64)
+		})
+
 		regTokenUser1, err := service.CreateRegToken(ctx, 1)
 		require.NoError(t, err)
 
@@ -508,7 +529,7 @@ func TestGrapqhlMutation(t *testing.T) {
 			assert.Equal(t, rootUser.ID.String(), rootMember[consoleql.FieldID])
 		})
 
-		var keyID string
+		var keyID, serializedKey string
 		t.Run("Create api key mutation", func(t *testing.T) {
 			keyName := "key1"
 			query := fmt.Sprintf(
@@ -532,6 +553,34 @@ func TestGrapqhlMutation(t *testing.T) {
 			assert.Equal(t, rootUser.PartnerID.String(), keyInfo[consoleql.FieldPartnerID])
 
 			keyID = keyInfo[consoleql.FieldID].(string)
+			serializedKey = key
+		})
+
+		t.Run("Revoke api key mutation", func(t *testing.T) {
+			key, err := macaroon.ParseAPIKey(serializedKey)
+			require.NoError(t, err)
+
+			restricted, err := key.Restrict(macaroon.Caveat{DisallowDeletes: true})
+			require.NoError(t, err)
+
+			query := fmt.Sprintf(
+				"mutation {revokeAPIKey(key:\"%s\"){id,projectID}}",
+				restricted.Serialize(),
+			)
+
+			result := testQuery(t, query)
+			data := result.(map[string]interface{})
+			keyInfo := data[consoleql.RevokeAPIKeyMutation].(map[string]interface{})
+
+			assert.Equal(t, keyID, keyInfo[consoleql.FieldID])
+			assert.Equal(t, project.ID.String(), keyInfo[consoleql.FieldProjectID])
+
+			revoked, err := db.Console().Revocations().GetByProjectID(ctx, project.ID)
+			require.NoError(t, err)
+			assert.Equal(t, [][]byte{restricted.Tail()}, revoked)
+
+			_, err = service.RevokeAPIKey(authCtx, serializedKey)
+			assert.Error(t, err)
 		})
 
 		t.Run("Delete api key mutation", func(t *testing.T) {
@@ -181,6 +181,14 @@ func graphqlProject(service *console.Service, types *TypeCreator) *graphql.Objec
 					return service.GetProjectUsage(p.Context, project.ID, since, before)
 				},
 			},
+			FieldAlertSettings: &graphql.Field{
+				Type: types.projectAlerts,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					project, _ := p.Source.(*console.Project)
+
+					return service.GetProjectAlertSettings(p.Context, project.ID)
+				},
+			},
 			FieldBucketUsages: &graphql.Field{
 				Type: types.bucketUsagePage,
 				Args: graphql.FieldConfigArgument{
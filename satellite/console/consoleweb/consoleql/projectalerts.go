@@ -0,0 +1,73 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql
+
+import (
+	"encoding/hex"
+
+	"github.com/graphql-go/graphql"
+
+	"storj.io/storj/satellite/console"
+)
+
+const (
+	// ProjectAlertSettingsType is a graphql type name for project usage alert settings
+	ProjectAlertSettingsType = "projectAlertSettings"
+	// FieldAlertSettings is a field name for project usage alert settings
+	FieldAlertSettings = "alertSettings"
+	// FieldStorageThresholds is a field name for storage alert thresholds
+	FieldStorageThresholds = "storageThresholds"
+	// FieldBandwidthThresholds is a field name for bandwidth alert thresholds
+	FieldBandwidthThresholds = "bandwidthThresholds"
+	// FieldWebhookURL is a field name for alert webhook url
+	FieldWebhookURL = "webhookURL"
+	// FieldWebhookSecret is a field name for alert webhook signing secret
+	FieldWebhookSecret = "webhookSecret"
+	// FieldUpdatedAt is a field name for updated at timestamp
+	FieldUpdatedAt = "updatedAt"
+)
+
+// graphqlProjectAlertSettings creates project alert settings graphql type
+func graphqlProjectAlertSettings() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: ProjectAlertSettingsType,
+		Fields: graphql.Fields{
+			FieldStorageThresholds: &graphql.Field{
+				Type: graphql.NewList(graphql.Int),
+			},
+			FieldBandwidthThresholds: &graphql.Field{
+				Type: graphql.NewList(graphql.Int),
+			},
+			FieldWebhookURL: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldWebhookSecret: &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					// the secret is only given to the project owner
+					settings, _ := p.Source.(*console.ProjectAlertSettings)
+					if len(settings.WebhookSecret) == 0 {
+						return nil, nil
+					}
+					return hex.EncodeToString(settings.WebhookSecret), nil
+				},
+			},
+			FieldUpdatedAt: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+		},
+	})
+}
+
+// toThresholds converts graphql list argument to thresholds
+func toThresholds(arg interface{}) []int {
+	values, _ := arg.([]interface{})
+
+	thresholds := make([]int, 0, len(values))
+	for _, value := range values {
+		threshold, _ := value.(int)
+		thresholds = append(thresholds, threshold)
+	}
+	return thresholds
+}
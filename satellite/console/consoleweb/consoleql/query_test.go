@@ -20,6 +20,7 @@ import (
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb/consoleql"
 	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/localpayments"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
@@ -37,6 +38,7 @@ func TestGraphqlQuery(t *testing.T) {
 			db.Console(),
 			db.Rewards(),
 			localpayments.NewService(nil),
+			payments.PriceTable{},
 			console.TestPasswordCost,
 		)
 		require.NoError(t, err)
@@ -299,9 +301,14 @@ func TestGraphqlQuery(t *testing.T) {
 		keyInfo2, _, err := service.CreateAPIKey(authCtx, createdProject.ID, "key2")
 		require.NoError(t, err)
 
+		err = db.Console().APIKeys().AddUsage(ctx, []console.APIKeyUsage{
+			{APIKeyID: keyInfo1.ID, LastUsedAt: time.Now(), RequestCount: 3, BytesUploaded: 10, BytesDownloaded: 20},
+		})
+		require.NoError(t, err)
+
 		t.Run("Project query api keys", func(t *testing.T) {
 			query := fmt.Sprintf(
-				"query {project(id:\"%s\"){apiKeys{name,id,createdAt,projectID}}}",
+				"query {project(id:\"%s\"){apiKeys{name,id,createdAt,projectID,lastUsedAt,requestCount,bytesUploaded,bytesDownloaded}}}",
 				createdProject.ID.String(),
 			)
 
@@ -334,9 +341,15 @@ func TestGraphqlQuery(t *testing.T) {
 				case keyInfo1.ID.String():
 					foundKey1 = true
 					testAPIKey(t, key, keyInfo1)
+					assert.NotNil(t, key[consoleql.FieldLastUsedAt])
+					assert.EqualValues(t, 3, key[consoleql.FieldRequestCount])
+					assert.EqualValues(t, 10, key[consoleql.FieldBytesUploaded])
+					assert.EqualValues(t, 20, key[consoleql.FieldBytesDownloaded])
 				case keyInfo2.ID.String():
 					foundKey2 = true
 					testAPIKey(t, key, keyInfo2)
+					assert.Nil(t, key[consoleql.FieldLastUsedAt])
+					assert.EqualValues(t, 0, key[consoleql.FieldRequestCount])
 				}
 			}
 
@@ -23,6 +23,7 @@ type TypeCreator struct {
 	creditUsage       *graphql.Object
 	project           *graphql.Object
 	projectUsage      *graphql.Object
+	projectAlerts     *graphql.Object
 	bucketUsage       *graphql.Object
 	bucketUsagePage   *graphql.Object
 	paymentMethod     *graphql.Object
@@ -81,6 +82,11 @@ func (c *TypeCreator) Create(log *zap.Logger, service *console.Service, mailServ
 		return err
 	}
 
+	c.projectAlerts = graphqlProjectAlertSettings()
+	if err := c.projectAlerts.Error(); err != nil {
+		return err
+	}
+
 	c.bucketUsage = graphqlBucketUsage()
 	if err := c.bucketUsage.Error(); err != nil {
 		return err
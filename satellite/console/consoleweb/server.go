@@ -28,6 +28,7 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb/consoleql"
 	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/payments"
 )
 
 const (
@@ -54,6 +55,8 @@ type Config struct {
 	ExternalAddress string `help:"external endpoint of the satellite if hosted" default:""`
 	StripeKey       string `help:"stripe api key" default:""`
 
+	Prices payments.PriceTable
+
 	// TODO: remove after Vanguard release
 	AuthToken       string `help:"auth token needed for access to registration token creation endpoint" default:""`
 	AuthTokenSecret string `help:"secret used to sign auth tokens" releaseDefault:"" devDefault:"my-suppa-secret-key"`
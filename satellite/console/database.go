@@ -35,6 +35,12 @@ type DB interface {
 	ProjectPayments() ProjectPayments
 	// ProjectInvoiceStamps is a getter for ProjectInvoiceStamps repository
 	ProjectInvoiceStamps() ProjectInvoiceStamps
+	// ProjectInvoiceCharges is a getter for ProjectInvoiceCharges repository
+	ProjectInvoiceCharges() ProjectInvoiceCharges
+	// ProjectAlerts is a getter for ProjectAlerts repository
+	ProjectAlerts() ProjectAlerts
+	// Revocations is a getter for Revocations repository
+	Revocations() Revocations
 
 	// BeginTransaction is a method for opening transaction
 	BeginTx(ctx context.Context) (DBTx, error)
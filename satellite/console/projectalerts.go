@@ -0,0 +1,89 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+)
+
+// ErrAlertSettingsNotFound is returned when project has no alert settings
+var ErrAlertSettingsNotFound = errs.Class("project alert settings not found")
+
+// ProjectAlerts exposes methods to manage project usage alert settings and sent alerts
+type ProjectAlerts interface {
+	// GetSettings returns alert settings of the project
+	GetSettings(ctx context.Context, projectID uuid.UUID) (*ProjectAlertSettings, error)
+	// UpsertSettings creates or updates alert settings of the project
+	UpsertSettings(ctx context.Context, settings ProjectAlertSettings) (*ProjectAlertSettings, error)
+	// MarkSent records the alert, it returns false when the alert has been already recorded
+	MarkSent(ctx context.Context, alert ProjectAlert) (bool, error)
+	// ClearSent removes the alert record, so that the alert can be sent again
+	ClearSent(ctx context.Context, alert ProjectAlert) error
+}
+
+// ProjectAlertKind is the kind of usage the alert is about
+type ProjectAlertKind string
+
+const (
+	// AlertStorage is an alert about project storage usage
+	AlertStorage ProjectAlertKind = "storage"
+	// AlertBandwidth is an alert about project egress usage
+	AlertBandwidth ProjectAlertKind = "bandwidth"
+)
+
+// ProjectAlertSettings contains project usage alert settings.
+// Thresholds are percentages of project storage and egress limits.
+type ProjectAlertSettings struct {
+	ProjectID uuid.UUID
+
+	StorageThresholds   []int
+	BandwidthThresholds []int
+
+	// WebhookURL is optional, alerts are always sent via email
+	WebhookURL    string
+	WebhookSecret []byte
+
+	UpdatedAt time.Time
+}
+
+// ProjectAlert describes an alert sent for project in billing period
+type ProjectAlert struct {
+	ProjectID   uuid.UUID
+	Kind        ProjectAlertKind
+	Threshold   int
+	PeriodStart time.Time
+}
+
+// maxAlertThreshold is the highest allowed alert threshold percentage
+const maxAlertThreshold = 1000
+
+// validateAlertSettings checks alert thresholds and webhook url
+func validateAlertSettings(storageThresholds, bandwidthThresholds []int, webhookURL string) error {
+	var errs validationErrors
+
+	for _, thresholds := range [][]int{storageThresholds, bandwidthThresholds} {
+		for _, threshold := range thresholds {
+			if threshold <= 0 || threshold > maxAlertThreshold {
+				errs.Add("alert threshold must be between 1 and %d percent", maxAlertThreshold)
+				return errs.Combine()
+			}
+		}
+	}
+
+	if webhookURL != "" {
+		u, err := url.Parse(webhookURL)
+		if err != nil {
+			errs.AddWrap(err)
+		} else if u.Scheme != "http" && u.Scheme != "https" {
+			errs.Add("webhook url must use http or https")
+		}
+	}
+
+	return errs.Combine()
+}
@@ -0,0 +1,33 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// ProjectInvoiceCharges exposes methods to manage ProjectInvoiceCharge table in database
+type ProjectInvoiceCharges interface {
+	// Create records credits charged for a project invoice that is not created yet
+	Create(ctx context.Context, charge ProjectInvoiceCharge) error
+	// Get returns the pending charge of the project for the billing period, sql.ErrNoRows when there is none
+	Get(ctx context.Context, projectID uuid.UUID, startDate time.Time) (*ProjectInvoiceCharge, error)
+	// Delete removes the pending charge once the invoice stamp has been created
+	Delete(ctx context.Context, projectID uuid.UUID, startDate time.Time) error
+}
+
+// ProjectInvoiceCharge stores credits that were charged for a project invoice
+// before the invoice was created on payments service side. It is kept until
+// the invoice stamp is created, so a retry reuses the charge instead of
+// charging credits again
+type ProjectInvoiceCharge struct {
+	ProjectID uuid.UUID
+	StartDate time.Time
+	Credits   int64
+
+	CreatedAt time.Time
+}
@@ -0,0 +1,20 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// Revocations exposes methods to manage revoked api keys.
+// Revocations are stored by macaroon tail, so revoking a restricted key
+// revokes every key derived from it, while its ancestors stay valid.
+type Revocations interface {
+	// Revoke stores the tail of a key derived from the given api key as revoked
+	Revoke(ctx context.Context, tail []byte, apiKeyID uuid.UUID) error
+	// GetByProjectID returns the revoked tails of all api keys of the project
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([][]byte, error)
+}
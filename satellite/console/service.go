@@ -5,6 +5,7 @@ package console
 
 import (
 	"context"
+	"crypto/rand"
 	"crypto/subtle"
 	"database/sql"
 	"fmt"
@@ -61,6 +62,7 @@ type Service struct {
 
 	log     *zap.Logger
 	pm      payments.Service
+	prices  payments.PriceTable
 	store   DB
 	rewards rewards.DB
 
@@ -68,7 +70,7 @@ type Service struct {
 }
 
 // NewService returns new instance of Service
-func NewService(log *zap.Logger, signer Signer, store DB, rewards rewards.DB, pm payments.Service, passwordCost int) (*Service, error) {
+func NewService(log *zap.Logger, signer Signer, store DB, rewards rewards.DB, pm payments.Service, prices payments.PriceTable, passwordCost int) (*Service, error) {
 	if signer == nil {
 		return nil, errs.New("signer can't be nil")
 	}
@@ -88,6 +90,7 @@ func NewService(log *zap.Logger, signer Signer, store DB, rewards rewards.DB, pm
 		store:        store,
 		rewards:      rewards,
 		pm:           pm,
+		prices:       prices,
 		passwordCost: passwordCost,
 	}, nil
 }
@@ -799,6 +802,98 @@ func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, descri
 	return project, nil
 }
 
+// GetProjectAlertSettings returns usage alert settings of the project, nil is returned
+// when the project has no alert settings. Only the project owner gets the webhook secret
+func (s *Service) GetProjectAlertSettings(ctx context.Context, projectID uuid.UUID) (settings *ProjectAlertSettings, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	settings, err = s.store.ProjectAlerts().GetSettings(ctx, projectID)
+	if ErrAlertSettingsNotFound.Has(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	if isMember.project.OwnerID != auth.User.ID {
+		settings.WebhookSecret = nil
+	}
+
+	return settings, nil
+}
+
+// UpdateProjectAlertSettings sets usage alert thresholds and webhook url of the project.
+// A new webhook secret is generated whenever the webhook url changes.
+// Only the project owner can change the webhook url and gets the webhook secret
+func (s *Service) UpdateProjectAlertSettings(ctx context.Context, projectID uuid.UUID, storageThresholds, bandwidthThresholds []int, webhookURL string) (settings *ProjectAlertSettings, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isMember, err := s.isProjectMember(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+	isOwner := isMember.project.OwnerID == auth.User.ID
+
+	if err = validateAlertSettings(storageThresholds, bandwidthThresholds, webhookURL); err != nil {
+		return nil, err
+	}
+
+	current, err := s.store.ProjectAlerts().GetSettings(ctx, projectID)
+	if err != nil && !ErrAlertSettingsNotFound.Has(err) {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	var currentWebhookURL string
+	if current != nil {
+		currentWebhookURL = current.WebhookURL
+	}
+	if webhookURL != currentWebhookURL && !isOwner {
+		return nil, ErrUnauthorized.New(unauthorizedErrMsg)
+	}
+
+	var secret []byte
+	switch {
+	case webhookURL == "":
+	case current != nil && current.WebhookURL == webhookURL && len(current.WebhookSecret) > 0:
+		secret = current.WebhookSecret
+	default:
+		secret = make([]byte, 32)
+		if _, err = rand.Read(secret); err != nil {
+			return nil, errs.New(internalErrMsg)
+		}
+	}
+
+	settings, err = s.store.ProjectAlerts().UpsertSettings(ctx, ProjectAlertSettings{
+		ProjectID:           projectID,
+		StorageThresholds:   storageThresholds,
+		BandwidthThresholds: bandwidthThresholds,
+		WebhookURL:          webhookURL,
+		WebhookSecret:       secret,
+	})
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	if !isOwner {
+		settings.WebhookSecret = nil
+	}
+
+	return settings, nil
+}
+
 // AddProjectMembers adds users by email to given project
 func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string) (users []*User, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1049,6 +1144,47 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 	return nil
 }
 
+// RevokeAPIKey revokes the given serialized api key and every key derived
+// from it, returning the info of the project api key it was derived from.
+// Unrestricted api keys can't be revoked and should be deleted instead.
+func (s *Service) RevokeAPIKey(ctx context.Context, serialized string) (_ *APIKeyInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := macaroon.ParseAPIKey(serialized)
+	if err != nil {
+		return nil, errs.New("invalid api key")
+	}
+
+	info, err := s.store.APIKeys().GetByHead(ctx, key.Head())
+	if err != nil {
+		return nil, errs.New(unauthorizedErrMsg)
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, info.ProjectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	tails, err := key.Tails(info.Secret)
+	if err != nil {
+		return nil, errs.New("invalid api key")
+	}
+	if len(tails) == 1 {
+		return nil, errs.New("unrestricted api keys must be deleted instead of revoked")
+	}
+
+	err = s.store.Revocations().Revoke(ctx, key.Tail(), info.ID)
+	if err != nil {
+		return nil, errs.New(internalErrMsg)
+	}
+
+	return info, nil
+}
+
 // GetAPIKeysInfoByProjectID retrieves all api keys for a given project
 func (s *Service) GetAPIKeysInfoByProjectID(ctx context.Context, projectID uuid.UUID) (info []APIKeyInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1158,54 +1294,182 @@ func (s *Service) CreateMonthlyProjectInvoices(ctx context.Context, date time.Ti
 			continue
 		}
 
-		paymentInfo, err := s.store.ProjectPayments().GetDefaultByProjectID(ctx, proj.ID)
-		if err != nil {
-			invoiceError.Add(err)
-			continue
-		}
+		_, err = s.CreateProjectInvoice(ctx, proj, startDate, endDate)
+		invoiceError.Add(err)
+	}
 
-		payerInfo, err := s.store.UserPayments().Get(ctx, paymentInfo.PayerID)
-		if err != nil {
-			invoiceError.Add(err)
-			continue
-		}
+	return invoiceError.Err()
+}
 
-		totals, err := s.store.UsageRollups().GetProjectTotal(ctx, proj.ID, startDate, endDate)
+// CreateProjectInvoice prices project usage for the period using the price table, applies
+// available credits of the payer, creates an invoice and stores the project invoice stamp
+func (s *Service) CreateProjectInvoice(ctx context.Context, proj Project, startDate, endDate time.Time) (_ *payments.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	paymentInfo, err := s.store.ProjectPayments().GetDefaultByProjectID(ctx, proj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	payerInfo, err := s.store.UserPayments().Get(ctx, paymentInfo.PayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, err := s.store.UsageRollups().GetProjectTotal(ctx, proj.ID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	lineItems := s.prices.LineItems(payments.ProjectUsage{
+		StorageGBHours: totals.Storage,
+		EgressGB:       totals.Egress,
+		ObjectHours:    totals.ObjectCount,
+	})
+
+	// credits are charged and recorded as a pending charge before the invoice is created,
+	// a retry after a failure reuses the recorded charge instead of charging credits again
+	credits, err := s.chargeProjectInvoiceCredits(ctx, proj.ID, paymentInfo.PayerID, payments.TotalAmount(lineItems), startDate)
+	if err != nil {
+		return nil, err
+	}
+	if credits > 0 {
+		lineItems = append(lineItems, payments.LineItem{
+			Key:      payments.LineItemCredits,
+			Quantity: 1,
+			Amount:   -credits,
+		})
+	}
+
+	// payments service is called outside of any transaction, the idempotency key
+	// makes sure a retry returns the invoice created by a previous attempt
+	inv, err := s.pm.CreateProjectInvoice(ctx,
+		payments.CreateProjectInvoiceParams{
+			ProjectName:     proj.Name,
+			CustomerID:      payerInfo.CustomerID,
+			PaymentMethodID: paymentInfo.PaymentMethodID,
+			Storage:         totals.Storage,
+			Egress:          totals.Egress,
+			ObjectCount:     totals.ObjectCount,
+			LineItems:       lineItems,
+			StartDate:       startDate,
+			EndDate:         endDate,
+			IdempotencyKey:  projectInvoiceIdempotencyKey(proj.ID, startDate),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
 		if err != nil {
-			invoiceError.Add(err)
-			continue
+			err = errs.Combine(err, tx.Rollback())
+			return
 		}
 
-		inv, err := s.pm.CreateProjectInvoice(ctx,
-			payments.CreateProjectInvoiceParams{
-				ProjectName:     proj.Name,
-				CustomerID:      payerInfo.CustomerID,
-				PaymentMethodID: paymentInfo.PaymentMethodID,
-				Storage:         totals.Storage,
-				Egress:          totals.Egress,
-				ObjectCount:     totals.ObjectCount,
-				StartDate:       startDate,
-				EndDate:         endDate,
-			},
-		)
+		err = tx.Commit()
+	}()
+
+	_, err = tx.ProjectInvoiceStamps().Create(ctx,
+		ProjectInvoiceStamp{
+			ProjectID: proj.ID,
+			InvoiceID: inv.ID,
+			StartDate: startDate,
+			EndDate:   endDate,
+			CreatedAt: inv.CreatedAt,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.ProjectInvoiceCharges().Delete(ctx, proj.ID, startDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+// chargeProjectInvoiceCredits charges payer credits for the project invoice of the period
+// and records the charge, when the charge has been already recorded by a previous attempt
+// the recorded amount is returned without charging credits again
+func (s *Service) chargeProjectInvoiceCredits(ctx context.Context, projectID, payerID uuid.UUID, amount int64, startDate time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tx, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
 		if err != nil {
-			invoiceError.Add(err)
-			continue
+			err = errs.Combine(err, tx.Rollback())
+			return
 		}
 
-		_, err = s.store.ProjectInvoiceStamps().Create(ctx,
-			ProjectInvoiceStamp{
-				ProjectID: proj.ID,
-				InvoiceID: inv.ID,
-				StartDate: startDate,
-				EndDate:   endDate,
-				CreatedAt: inv.CreatedAt,
-			},
-		)
-		invoiceError.Add(err)
+		err = tx.Commit()
+	}()
+
+	charge, err := tx.ProjectInvoiceCharges().Get(ctx, projectID, startDate)
+	switch {
+	case err == nil:
+		return charge.Credits, nil
+	case err != sql.ErrNoRows:
+		return 0, err
 	}
 
-	return invoiceError.Err()
+	credits, err := s.applyCredits(ctx, tx, payerID, amount, startDate)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.ProjectInvoiceCharges().Create(ctx, ProjectInvoiceCharge{
+		ProjectID: projectID,
+		StartDate: startDate,
+		Credits:   credits,
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return credits, nil
+}
+
+// projectInvoiceIdempotencyKey returns the key identifying the invoice of the project for the period
+func projectInvoiceIdempotencyKey(projectID uuid.UUID, startDate time.Time) string {
+	return "project-invoice-" + projectID.String() + "-" + startDate.UTC().Format("2006-01")
+}
+
+// applyCredits charges available user credits for the amount in cents in the
+// transaction and returns how many cents were covered by credits
+func (s *Service) applyCredits(ctx context.Context, tx DBTx, userID uuid.UUID, amount int64, billingStartDate time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if amount <= 0 {
+		return 0, nil
+	}
+
+	usage, err := tx.UserCredits().GetCreditUsage(ctx, userID, billingStartDate)
+	if err != nil {
+		return 0, err
+	}
+	if usage.AvailableCredits.Cents() <= 0 {
+		return 0, nil
+	}
+
+	remaining, err := tx.UserCredits().UpdateAvailableCredits(ctx, int(amount), userID, billingStartDate)
+	if err != nil {
+		return 0, err
+	}
+
+	return amount - int64(remaining), nil
 }
 
 // Authorize validates token from context and returns authorized Authorization
@@ -0,0 +1,279 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/currency"
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/payments"
+	"storj.io/storj/satellite/payments/localpayments"
+	"storj.io/storj/satellite/rewards"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestCreateProjectInvoice(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		consoleDB := db.Console()
+		pm := localpayments.NewService(nil)
+
+		newService := func(pm payments.Service) *console.Service {
+			service, err := console.NewService(
+				zaptest.NewLogger(t),
+				&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
+				consoleDB,
+				db.Rewards(),
+				pm,
+				payments.PriceTable{
+					StorageGBMonth: 0.01,
+					EgressGB:       0.045,
+					ObjectMonth:    0.001,
+				},
+				console.TestPasswordCost,
+			)
+			require.NoError(t, err)
+			return service
+		}
+		service := newService(pm)
+
+		user, referrer, activeOffer, _ := setupData(ctx, t, db)
+
+		// user has 100 cents of credits available
+		err := consoleDB.UserCredits().Create(ctx, console.CreateCredit{
+			OfferInfo: rewards.RedeemOffer{
+				RedeemableCap: activeOffer.RedeemableCap,
+				Status:        activeOffer.Status,
+				Type:          activeOffer.Type,
+			},
+			UserID:        user.ID,
+			OfferID:       activeOffer.ID,
+			ReferredBy:    &referrer.ID,
+			Type:          console.Invitee,
+			CreditsEarned: currency.Cents(100),
+			ExpiresAt:     time.Now().UTC().AddDate(0, 2, 0),
+		})
+		require.NoError(t, err)
+
+		userPayment, err := consoleDB.UserPayments().Create(ctx, console.UserPayment{
+			UserID:     user.ID,
+			CustomerID: testrand.Bytes(8),
+		})
+		require.NoError(t, err)
+
+		proj, err := consoleDB.Projects().Insert(ctx, &console.Project{
+			Name:    "invoiced",
+			OwnerID: user.ID,
+		})
+		require.NoError(t, err)
+
+		_, err = consoleDB.ProjectPayments().Create(ctx, console.ProjectPayment{
+			ProjectID:       proj.ID,
+			PayerID:         userPayment.UserID,
+			PaymentMethodID: testrand.Bytes(8),
+			IsDefault:       true,
+		})
+		require.NoError(t, err)
+
+		now := time.Now().UTC().Truncate(time.Hour)
+		startDate := now.Add(-31 * 24 * time.Hour)
+		endDate := now
+
+		bucketName := "bucket"
+		last := now.Add(-time.Hour)
+		first := last.Add(-payments.HoursPerMonth * time.Hour)
+
+		// 10GB stored for a month together with 100 objects
+		for _, intervalStart := range []time.Time{first, last} {
+			err = db.ProjectAccounting().CreateStorageTally(ctx, accounting.BucketStorageTally{
+				BucketName:    bucketName,
+				ProjectID:     proj.ID,
+				IntervalStart: intervalStart,
+				ObjectCount:   100,
+				RemoteBytes:   10 * memory.GB.Int64(),
+			})
+			require.NoError(t, err)
+		}
+
+		// 100GB of egress
+		err = db.Orders().UpdateBucketBandwidthSettle(ctx, proj.ID, []byte(bucketName), pb.PieceAction_GET, 100*memory.GB.Int64(), last)
+		require.NoError(t, err)
+
+		// credits are charged once and kept as a pending charge when the payment provider fails
+		_, err = newService(failingPayments{pm}).CreateProjectInvoice(ctx, *proj, startDate, endDate)
+		require.Error(t, err)
+
+		usage, err := consoleDB.UserCredits().GetCreditUsage(ctx, user.ID, startDate)
+		require.NoError(t, err)
+		assert.Equal(t, currency.Cents(0), usage.AvailableCredits)
+		assert.Equal(t, currency.Cents(100), usage.UsedCredits)
+
+		charge, err := consoleDB.ProjectInvoiceCharges().Get(ctx, proj.ID, startDate)
+		require.NoError(t, err)
+		assert.EqualValues(t, 100, charge.Credits)
+
+		_, err = consoleDB.ProjectInvoiceStamps().GetByProjectIDStartDate(ctx, proj.ID, startDate)
+		require.Error(t, err)
+
+		// the invoice is created, but the response is lost
+		lost := &lostPayments{Service: pm}
+		_, err = newService(lost).CreateProjectInvoice(ctx, *proj, startDate, endDate)
+		require.Error(t, err)
+		require.NotNil(t, lost.invoice)
+
+		inv, err := service.CreateProjectInvoice(ctx, *proj, startDate, endDate)
+		require.NoError(t, err)
+
+		assert.Equal(t, []payments.LineItem{
+			{Key: payments.LineItemStorage, Quantity: 10, Amount: 10},
+			{Key: payments.LineItemEgress, Quantity: 100, Amount: 450},
+			{Key: payments.LineItemObjectCount, Quantity: 100, Amount: 10},
+			{Key: payments.LineItemCredits, Quantity: 1, Amount: -100},
+		}, inv.LineItems)
+		assert.EqualValues(t, 370, inv.Amount)
+
+		// retry returns the invoice created by the previous attempt
+		assert.Equal(t, lost.invoice.ID, inv.ID)
+
+		stored, err := pm.GetInvoice(ctx, inv.ID)
+		require.NoError(t, err)
+		assert.Equal(t, inv.Amount, stored.Amount)
+
+		stamp, err := consoleDB.ProjectInvoiceStamps().GetByProjectIDStartDate(ctx, proj.ID, startDate)
+		require.NoError(t, err)
+		assert.Equal(t, inv.ID, stamp.InvoiceID)
+
+		_, err = consoleDB.ProjectInvoiceCharges().Get(ctx, proj.ID, startDate)
+		require.Error(t, err)
+
+		// credits are not charged again by retries
+		usage, err = consoleDB.UserCredits().GetCreditUsage(ctx, user.ID, startDate)
+		require.NoError(t, err)
+		assert.Equal(t, currency.Cents(0), usage.AvailableCredits)
+		assert.Equal(t, currency.Cents(100), usage.UsedCredits)
+	})
+}
+
+func TestProjectAlertSettingsWebhookOwner(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		consoleDB := db.Console()
+		service, err := console.NewService(
+			zaptest.NewLogger(t),
+			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
+			consoleDB,
+			db.Rewards(),
+			localpayments.NewService(nil),
+			payments.PriceTable{},
+			console.TestPasswordCost,
+		)
+		require.NoError(t, err)
+
+		owner, err := consoleDB.Users().Insert(ctx, &console.User{
+			FullName:     "Owner",
+			Email:        "owner@mail.test",
+			PasswordHash: testrand.Bytes(8),
+			Status:       console.Active,
+		})
+		require.NoError(t, err)
+
+		member, err := consoleDB.Users().Insert(ctx, &console.User{
+			FullName:     "Member",
+			Email:        "member@mail.test",
+			PasswordHash: testrand.Bytes(8),
+			Status:       console.Active,
+		})
+		require.NoError(t, err)
+
+		proj, err := consoleDB.Projects().Insert(ctx, &console.Project{
+			Name:    "alerts",
+			OwnerID: owner.ID,
+		})
+		require.NoError(t, err)
+
+		for _, user := range []*console.User{owner, member} {
+			_, err = consoleDB.ProjectMembers().Insert(ctx, user.ID, proj.ID)
+			require.NoError(t, err)
+		}
+
+		ownerCtx := console.WithAuth(ctx, console.Authorization{User: *owner})
+		memberCtx := console.WithAuth(ctx, console.Authorization{User: *member})
+
+		// only the owner can set the webhook
+		_, err = service.UpdateProjectAlertSettings(memberCtx, proj.ID, []int{80}, []int{80}, "https://example.test/hook")
+		require.True(t, console.ErrUnauthorized.Has(err))
+
+		settings, err := service.UpdateProjectAlertSettings(ownerCtx, proj.ID, []int{80}, []int{80}, "https://example.test/hook")
+		require.NoError(t, err)
+		secret := settings.WebhookSecret
+		require.Len(t, secret, 32)
+
+		// members can change thresholds while keeping the webhook
+		settings, err = service.UpdateProjectAlertSettings(memberCtx, proj.ID, []int{90}, []int{90}, "https://example.test/hook")
+		require.NoError(t, err)
+		assert.Equal(t, []int{90}, settings.StorageThresholds)
+		assert.Empty(t, settings.WebhookSecret)
+
+		// but they can neither change nor remove it
+		_, err = service.UpdateProjectAlertSettings(memberCtx, proj.ID, []int{90}, []int{90}, "https://attacker.test/hook")
+		require.True(t, console.ErrUnauthorized.Has(err))
+		_, err = service.UpdateProjectAlertSettings(memberCtx, proj.ID, []int{90}, []int{90}, "")
+		require.True(t, console.ErrUnauthorized.Has(err))
+
+		// and only the owner reads the secret
+		settings, err = service.GetProjectAlertSettings(memberCtx, proj.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.test/hook", settings.WebhookURL)
+		assert.Empty(t, settings.WebhookSecret)
+
+		settings, err = service.GetProjectAlertSettings(ownerCtx, proj.ID)
+		require.NoError(t, err)
+		assert.Equal(t, secret, settings.WebhookSecret)
+	})
+}
+
+// failingPayments is a payment service which fails to create invoices
+type failingPayments struct {
+	payments.Service
+}
+
+// CreateProjectInvoice implements payments.Service
+func (failingPayments) CreateProjectInvoice(ctx context.Context, params payments.CreateProjectInvoiceParams) (*payments.Invoice, error) {
+	return nil, errs.New("payment provider unavailable")
+}
+
+// lostPayments is a payment service which creates invoices, but fails to respond
+type lostPayments struct {
+	payments.Service
+	invoice *payments.Invoice
+}
+
+// CreateProjectInvoice implements payments.Service
+func (pm *lostPayments) CreateProjectInvoice(ctx context.Context, params payments.CreateProjectInvoiceParams) (*payments.Invoice, error) {
+	inv, err := pm.Service.CreateProjectInvoice(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	pm.invoice = inv
+	return nil, errs.New("connection reset")
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+)
+
+func TestCheckIn(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		DisableKademlia: true,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		assert.Nil(t, planet.Bootstrap)
+		assert.Nil(t, satellite.Kademlia.Service)
+
+		for _, node := range planet.StorageNodes {
+			assert.Nil(t, node.Kademlia.Service)
+
+			checkIn, ok := node.Contact.Service.CheckIns()[satellite.ID()]
+			require.True(t, ok)
+			assert.True(t, checkIn.Success, checkIn.Error)
+			assert.False(t, node.Contact.Service.LastPinged().IsZero())
+
+			dossier, err := satellite.Overlay.Service.Get(ctx, node.ID())
+			require.NoError(t, err)
+			assert.Equal(t, node.Addr(), dossier.Address.Address)
+			assert.Equal(t, node.Local().Operator.Email, dossier.Operator.Email)
+			assert.Equal(t, node.Local().Version.Version, dossier.Version.Version)
+		}
+
+		data := testrand.Bytes(10 * memory.KiB)
+		err := planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "test/path", data)
+		require.NoError(t, err)
+
+		downloaded, err := planet.Uplinks[0].Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+		assert.Equal(t, data, downloaded)
+
+		// the satellite can't ping back a node at another address
+		err = satellite.Contact.Service.PingBack(ctx, planet.StorageNodes[0].ID(), planet.StorageNodes[1].Addr())
+		assert.Error(t, err)
+	})
+}
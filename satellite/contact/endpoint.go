@@ -0,0 +1,87 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+)
+
+// Endpoint implements the Node service storage nodes check in with
+type Endpoint struct {
+	log     *zap.Logger
+	service *Service
+}
+
+// NewEndpoint creates a new contact endpoint
+func NewEndpoint(log *zap.Logger, service *Service) *Endpoint {
+	return &Endpoint{
+		log:     log,
+		service: service,
+	}
+}
+
+// CheckIn pings back the node at the address it checked in with. The address,
+// capacity and version of the node are recorded in the overlay if the node
+// is reachable.
+func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (_ *pb.CheckInResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	nodeID := peer.ID
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing address")
+	}
+
+	err = endpoint.service.PingBack(ctx, nodeID, req.Address)
+	if err != nil {
+		endpoint.log.Debug("failed to ping back node", zap.Stringer("node", nodeID), zap.String("address", req.Address), zap.Error(err))
+		return &pb.CheckInResponse{
+			PingNodeSuccess:  false,
+			PingErrorMessage: err.Error(),
+		}, nil
+	}
+
+	overlay := endpoint.service.overlay
+	err = overlay.Put(ctx, nodeID, pb.Node{
+		Id: nodeID,
+		Address: &pb.NodeAddress{
+			Transport: pb.NodeTransport_TCP_TLS_GRPC,
+			Address:   req.Address,
+		},
+	})
+	if err != nil {
+		endpoint.log.Error("failed to update node address", zap.Stringer("node", nodeID), zap.Error(err))
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	_, err = overlay.UpdateNodeInfo(ctx, nodeID, &pb.InfoResponse{
+		Type:     pb.NodeType_STORAGE,
+		Operator: req.Operator,
+		Capacity: req.Capacity,
+		Version:  req.Version,
+	})
+	if err != nil {
+		endpoint.log.Error("failed to update node info", zap.Stringer("node", nodeID), zap.Error(err))
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	_, err = overlay.UpdateUptime(ctx, nodeID, true)
+	if err != nil {
+		endpoint.log.Error("failed to update node uptime", zap.Stringer("node", nodeID), zap.Error(err))
+		return nil, status.Error(codes.Internal, Error.Wrap(err).Error())
+	}
+
+	return &pb.CheckInResponse{PingNodeSuccess: true}, nil
+}
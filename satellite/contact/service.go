@@ -0,0 +1,74 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/overlay"
+)
+
+var (
+	// Error is the default error class for the contact service
+	Error = errs.Class("contact")
+
+	mon = monkit.Package()
+)
+
+// Service is the contact service between the satellite and the storage
+// nodes checking in with it.
+type Service struct {
+	log       *zap.Logger
+	self      overlay.NodeDossier
+	overlay   *overlay.Service
+	transport transport.Client
+}
+
+// NewService creates a new contact service. The transport shouldn't have
+// observers, as failed ping backs must not update the overlay.
+func NewService(log *zap.Logger, self overlay.NodeDossier, overlay *overlay.Service, transport transport.Client) *Service {
+	return &Service{
+		log:       log,
+		self:      self,
+		overlay:   overlay,
+		transport: transport,
+	}
+}
+
+// Local returns the satellite node information
+func (service *Service) Local() overlay.NodeDossier {
+	return service.self
+}
+
+// PingBack dials the node at the address it checked in with and pings it.
+// Dialing verifies that the node at the address has the private key of
+// nodeID.
+func (service *Service) PingBack(ctx context.Context, nodeID storj.NodeID, address string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := service.transport.DialNode(ctx, &pb.Node{
+		Id: nodeID,
+		Address: &pb.NodeAddress{
+			Transport: pb.NodeTransport_TCP_TLS_GRPC,
+			Address:   address,
+		},
+	})
+	if err != nil {
+		return Error.New("failed to dial %s: %v", address, err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	_, err = pb.NewContactClient(conn).PingNode(ctx, &pb.ContactPingRequest{})
+	if err != nil {
+		return Error.New("failed to ping %s: %v", address, err)
+	}
+	return nil
+}
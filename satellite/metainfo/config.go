@@ -33,12 +33,14 @@ type RSConfig struct {
 
 // Config is a configuration struct that is everything you need to start a metainfo
 type Config struct {
-	DatabaseURL          string      `help:"the database connection string to use" releaseDefault:"postgres://" devDefault:"bolt://$CONFDIR/pointerdb.db"`
-	MinRemoteSegmentSize memory.Size `default:"1240" help:"minimum remote segment size"`
-	MaxInlineSegmentSize memory.Size `default:"8000" help:"maximum inline segment size"`
-	Overlay              bool        `default:"true" help:"toggle flag if overlay is enabled"`
-	RS                   RSConfig    `help:"redundancy scheme configuration"`
-	Loop                 LoopConfig  `help:"metainfo loop configuration"`
+	DatabaseURL          string           `help:"the database connection string to use" releaseDefault:"postgres://" devDefault:"bolt://$CONFDIR/pointerdb.db"`
+	MinRemoteSegmentSize memory.Size      `default:"1240" help:"minimum remote segment size"`
+	MaxInlineSegmentSize memory.Size      `default:"8000" help:"maximum inline segment size"`
+	Overlay              bool             `default:"true" help:"toggle flag if overlay is enabled"`
+	RS                   RSConfig         `help:"redundancy scheme configuration"`
+	Loop                 LoopConfig       `help:"metainfo loop configuration"`
+	KeyUsage             KeyUsageConfig   `help:"api key usage tracking configuration"`
+	Revocation           RevocationConfig `help:"api key revocation configuration"`
 }
 
 // NewStore returns database for storing pointer data
@@ -0,0 +1,152 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/satellite/console"
+)
+
+// KeyUsageConfig contains configurable values for api key usage tracking
+type KeyUsageConfig struct {
+	FlushInterval time.Duration `help:"how often api key usage is flushed to the database" releaseDefault:"1m" devDefault:"10s"`
+}
+
+// KeyUsageDB stores api key usage
+type KeyUsageDB interface {
+	// AddUsage adds usage deltas to the stored usage of api keys
+	AddUsage(ctx context.Context, usages []console.APIKeyUsage) error
+}
+
+// KeyUsageTracker aggregates api key usage in memory and periodically
+// flushes it to the database in a single batch.
+//
+// architecture: Chore
+type KeyUsageTracker struct {
+	log *zap.Logger
+	db  KeyUsageDB
+
+	mu      sync.Mutex
+	pending map[uuid.UUID]*console.APIKeyUsage
+
+	Loop sync2.Cycle
+}
+
+// NewKeyUsageTracker creates a new api key usage tracker
+func NewKeyUsageTracker(log *zap.Logger, db KeyUsageDB, config KeyUsageConfig) *KeyUsageTracker {
+	return &KeyUsageTracker{
+		log:     log,
+		db:      db,
+		pending: make(map[uuid.UUID]*console.APIKeyUsage),
+
+		Loop: *sync2.NewCycle(config.FlushInterval),
+	}
+}
+
+// Run periodically flushes aggregated usage
+func (tracker *KeyUsageTracker) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return tracker.Loop.Run(ctx, func(ctx context.Context) error {
+		err := tracker.Flush(ctx)
+		if err != nil {
+			tracker.log.Error("flushing api key usage failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the tracker and flushes remaining usage
+func (tracker *KeyUsageTracker) Close() error {
+	tracker.Loop.Close()
+	return tracker.Flush(context.TODO())
+}
+
+// RecordRequest records a request authorized by the key
+func (tracker *KeyUsageTracker) RecordRequest(keyID uuid.UUID) {
+	tracker.add(keyID, 1, 0, 0)
+}
+
+// RecordUpload records bytes uploaded with the key
+func (tracker *KeyUsageTracker) RecordUpload(keyID uuid.UUID, bytes int64) {
+	tracker.add(keyID, 0, bytes, 0)
+}
+
+// RecordDownload records bytes downloaded with the key
+func (tracker *KeyUsageTracker) RecordDownload(keyID uuid.UUID, bytes int64) {
+	tracker.add(keyID, 0, 0, bytes)
+}
+
+// add adds usage to the pending usage of the key, it's a no-op on nil tracker
+func (tracker *KeyUsageTracker) add(keyID uuid.UUID, requests, uploaded, downloaded int64) {
+	if tracker == nil {
+		return
+	}
+
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	usage, ok := tracker.pending[keyID]
+	if !ok {
+		usage = &console.APIKeyUsage{APIKeyID: keyID}
+		tracker.pending[keyID] = usage
+	}
+
+	usage.LastUsedAt = time.Now()
+	usage.RequestCount += requests
+	usage.BytesUploaded += uploaded
+	usage.BytesDownloaded += downloaded
+}
+
+// Flush writes aggregated usage to the database
+func (tracker *KeyUsageTracker) Flush(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tracker.mu.Lock()
+	pending := tracker.pending
+	tracker.pending = make(map[uuid.UUID]*console.APIKeyUsage)
+	tracker.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	usages := make([]console.APIKeyUsage, 0, len(pending))
+	for _, usage := range pending {
+		usages = append(usages, *usage)
+	}
+
+	err = tracker.db.AddUsage(ctx, usages)
+	if err != nil {
+		// keep the usage for the next flush
+		tracker.mu.Lock()
+		for _, usage := range usages {
+			tracker.restore(usage)
+		}
+		tracker.mu.Unlock()
+		return Error.Wrap(err)
+	}
+
+	mon.IntVal("api_key_usage_flushed").Observe(int64(len(usages)))
+	return nil
+}
+
+// restore merges unflushed usage back into the pending usage, mu must be held
+func (tracker *KeyUsageTracker) restore(usage console.APIKeyUsage) {
+	current, ok := tracker.pending[usage.APIKeyID]
+	if !ok {
+		tracker.pending[usage.APIKeyID] = &usage
+		return
+	}
+
+	current.RequestCount += usage.RequestCount
+	current.BytesUploaded += usage.BytesUploaded
+	current.BytesDownloaded += usage.BytesDownloaded
+}
@@ -4,6 +4,7 @@
 package metainfo
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"errors"
@@ -54,6 +55,7 @@ type APIKeys interface {
 // Revocations is the revocations store methods used by the endpoint
 type Revocations interface {
 	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([][]byte, error)
+	Revoke(ctx context.Context, tail []byte, apiKeyID uuid.UUID) error
 }
 
 // Containment is a copy/paste of containment interface to avoid import cycle error
@@ -71,6 +73,8 @@ type Endpoint struct {
 	projectUsage     *accounting.ProjectUsage
 	containment      Containment
 	apiKeys          APIKeys
+	revocations      *RevocationCache
+	keyUsage         *KeyUsageTracker
 	createRequests   *createRequests
 	requiredRSConfig RSConfig
 	satellite        signing.Signer
@@ -78,7 +82,7 @@ type Endpoint struct {
 
 // NewEndpoint creates new metainfo endpoint instance
 func NewEndpoint(log *zap.Logger, metainfo *Service, orders *orders.Service, cache *overlay.Service, partnerinfo attribution.DB,
-	containment Containment, apiKeys APIKeys, projectUsage *accounting.ProjectUsage, rsConfig RSConfig, satellite signing.Signer) *Endpoint {
+	containment Containment, apiKeys APIKeys, revocations *RevocationCache, keyUsage *KeyUsageTracker, projectUsage *accounting.ProjectUsage, rsConfig RSConfig, satellite signing.Signer) *Endpoint {
 	// TODO do something with too many params
 	return &Endpoint{
 		log:              log,
@@ -88,6 +92,8 @@ func NewEndpoint(log *zap.Logger, metainfo *Service, orders *orders.Service, cac
 		partnerinfo:      partnerinfo,
 		containment:      containment,
 		apiKeys:          apiKeys,
+		revocations:      revocations,
+		keyUsage:         keyUsage,
 		projectUsage:     projectUsage,
 		createRequests:   newCreateRequests(),
 		requiredRSConfig: rsConfig,
@@ -247,6 +253,15 @@ func (endpoint *Endpoint) CommitSegmentOld(ctx context.Context, req *pb.SegmentC
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	uploadAction, err := endpoint.commitSegmentAction(ctx, keyInfo.ProjectID, req)
+	if err != nil {
+		return nil, err
+	}
+	err = endpoint.validateUpload(ctx, keyInfo, uploadAction)
+	if err != nil {
+		return nil, err
+	}
+
 	exceeded, limit, err := endpoint.projectUsage.ExceedsStorageUsage(ctx, keyInfo.ProjectID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -285,6 +300,7 @@ func (endpoint *Endpoint) CommitSegmentOld(ctx context.Context, req *pb.SegmentC
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	endpoint.keyUsage.RecordUpload(keyInfo.ID, req.Pointer.SegmentSize)
 
 	if req.Pointer.Type == pb.Pointer_INLINE {
 		// TODO or maybe use pointer.SegmentSize ??
@@ -342,6 +358,7 @@ func (endpoint *Endpoint) DownloadSegmentOld(ctx context.Context, req *pb.Segmen
 	if err != nil {
 		return nil, err
 	}
+	endpoint.keyUsage.RecordDownload(keyInfo.ID, pointer.SegmentSize)
 
 	if pointer.Type == pb.Pointer_INLINE {
 		// TODO or maybe use pointer.SegmentSize ??
@@ -558,6 +575,63 @@ func (endpoint *Endpoint) SetAttributionOld(ctx context.Context, req *pb.SetAttr
 	return &pb.SetAttributionResponseOld{}, err
 }
 
+// MoveSegmentOld moves a segment to a new path and replaces its metadata
+// without touching its pieces. It's used for re-encrypting paths and
+// re-wrapping content keys when rotating encryption keys.
+func (endpoint *Endpoint) MoveSegmentOld(ctx context.Context, req *pb.SegmentMoveRequestOld) (resp *pb.SegmentMoveResponseOld, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	var keyInfo *console.APIKeyInfo
+	for _, action := range []macaroon.Action{
+		{Op: macaroon.ActionRead, Bucket: req.Bucket, EncryptedPath: req.Path, Time: now},
+		{Op: macaroon.ActionDelete, Bucket: req.Bucket, EncryptedPath: req.Path, Time: now},
+		{Op: macaroon.ActionWrite, Bucket: req.Bucket, EncryptedPath: req.NewPath, Time: now},
+	} {
+		keyInfo, err = endpoint.validateAuth(ctx, action)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+	}
+
+	err = endpoint.validateBucket(ctx, req.Bucket)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	path, err := CreatePath(ctx, keyInfo.ProjectID, req.Segment, req.Bucket, req.Path)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	newPath, err := CreatePath(ctx, keyInfo.ProjectID, req.Segment, req.Bucket, req.NewPath)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	uploadAction, err := endpoint.moveSegmentAction(ctx, keyInfo.ProjectID, req)
+	if err != nil {
+		return nil, err
+	}
+	err = endpoint.validateUpload(ctx, keyInfo, uploadAction)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.metainfo.Move(ctx, path, newPath, req.Metadata)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if storage.ErrValueChanged.Has(err) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.SegmentMoveResponseOld{}, nil
+}
+
 // bytesToUUID is used to convert []byte to UUID
 func bytesToUUID(data []byte) (uuid.UUID, error) {
 	var id uuid.UUID
@@ -589,6 +663,62 @@ func (endpoint *Endpoint) ProjectInfo(ctx context.Context, req *pb.ProjectInfoRe
 	}, nil
 }
 
+// RevokeAPIKey revokes the given api key and every key derived from it.
+// The request must be authorized by the revoked key itself or by one of
+// its ancestors.
+func (endpoint *Endpoint) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (_ *pb.RevokeAPIKeyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := endpoint.validateAuth(ctx, macaroon.Action{
+		Op:   macaroon.ActionRevoke,
+		Time: time.Now(),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	keyData, _ := auth.GetAPIKey(ctx)
+	caller, err := macaroon.ParseAPIKey(string(keyData))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	target, err := macaroon.ParseRawAPIKey(req.ApiKey)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid API key")
+	}
+
+	if !bytes.Equal(target.Head(), caller.Head()) {
+		return nil, status.Error(codes.PermissionDenied, "API key is not derived from the same key")
+	}
+
+	tails, err := target.Tails(keyInfo.Secret)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid API key")
+	}
+	if len(tails) == 1 {
+		return nil, status.Error(codes.InvalidArgument, "unrestricted API keys must be deleted instead of revoked")
+	}
+
+	derived := false
+	for _, tail := range tails {
+		if bytes.Equal(tail, caller.Tail()) {
+			derived = true
+			break
+		}
+	}
+	if !derived {
+		return nil, status.Error(codes.PermissionDenied, "API key is not derived from the requesting key")
+	}
+
+	err = endpoint.revocations.Revoke(ctx, keyInfo.ProjectID, target.Tail(), keyInfo.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.RevokeAPIKeyResponse{}, nil
+}
+
 // GetBucket returns a bucket
 func (endpoint *Endpoint) GetBucket(ctx context.Context, req *pb.BucketGetRequest) (resp *pb.BucketGetResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -727,8 +857,9 @@ func (endpoint *Endpoint) DeleteBucket(ctx context.Context, req *pb.BucketDelete
 func (endpoint *Endpoint) ListBuckets(ctx context.Context, req *pb.BucketListRequest) (resp *pb.BucketListResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 	action := macaroon.Action{
-		Op:   macaroon.ActionRead,
-		Time: time.Now(),
+		Op:       macaroon.ActionRead,
+		Time:     time.Now(),
+		ClientIP: clientIP(ctx),
 	}
 	keyInfo, err := endpoint.validateAuth(ctx, action)
 	if err != nil {
@@ -936,6 +1067,20 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	exists, err := endpoint.isOverwrite(ctx, keyInfo.ProjectID, req.Bucket, req.EncryptedPath)
+	if err != nil {
+		return nil, err
+	}
+	err = endpoint.validateUpload(ctx, keyInfo, macaroon.Action{
+		Bucket:        req.Bucket,
+		EncryptedPath: req.EncryptedPath,
+		Time:          time.Now(),
+		Overwrite:     exists,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	// take bucket RS values if not set in request
 	pbRS := req.RedundancyScheme
 	if pbRS.Type == 0 {
@@ -1017,6 +1162,7 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 	}
 
 	segmentIndex := int64(0)
+	objectSize := int64(0)
 	var lastSegmentPointer *pb.Pointer
 	var lastSegmentPath string
 	for {
@@ -1035,12 +1181,29 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 
 		lastSegmentPointer = pointer
 		lastSegmentPath = path
+		objectSize += pointer.SegmentSize
 		segmentIndex++
 	}
 	if lastSegmentPointer == nil {
 		return nil, status.Errorf(codes.NotFound, "unable to find object: %q/%q", streamID.Bucket, streamID.EncryptedPath)
 	}
 
+	exists, err := endpoint.isOverwrite(ctx, keyInfo.ProjectID, streamID.Bucket, streamID.EncryptedPath)
+	if err != nil {
+		return nil, err
+	}
+	err = endpoint.validateUpload(ctx, keyInfo, macaroon.Action{
+		Bucket:        streamID.Bucket,
+		EncryptedPath: streamID.EncryptedPath,
+		Time:          time.Now(),
+		ObjectSize:    objectSize,
+		SegmentCount:  segmentIndex,
+		Overwrite:     exists,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	lastSegmentPointer.Metadata = req.EncryptedMetadata
 
 	err = endpoint.metainfo.Delete(ctx, lastSegmentPath)
@@ -1284,6 +1447,11 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 		return nil, status.Error(codes.InvalidArgument, "segment index must be greater then 0")
 	}
 
+	err = endpoint.validateSegmentUpload(ctx, keyInfo, streamID, req.Position.Index, 0)
+	if err != nil {
+		return nil, err
+	}
+
 	exceeded, limit, err := endpoint.projectUsage.ExceedsStorageUsage(ctx, keyInfo.ProjectID)
 	if err != nil {
 		endpoint.log.Error("retrieving project storage totals", zap.Error(err))
@@ -1354,6 +1522,11 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 		return nil, status.Error(codes.Unauthenticated, err.Error())
 	}
 
+	err = endpoint.validateSegmentUpload(ctx, keyInfo, streamID, segmentID.Index, req.SizeEncryptedData)
+	if err != nil {
+		return nil, err
+	}
+
 	pieces := make([]*pb.RemotePiece, len(req.UploadResult))
 	for i, result := range req.UploadResult {
 		pieces[i] = &pb.RemotePiece{
@@ -1445,6 +1618,7 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	endpoint.keyUsage.RecordUpload(keyInfo.ID, pointer.SegmentSize)
 
 	return &pb.SegmentCommitResponse{}, nil
 }
@@ -1472,6 +1646,11 @@ func (endpoint *Endpoint) MakeInlineSegment(ctx context.Context, req *pb.Segment
 		return nil, status.Error(codes.InvalidArgument, "segment index must be greater then 0")
 	}
 
+	err = endpoint.validateSegmentUpload(ctx, keyInfo, streamID, req.Position.Index, int64(len(req.EncryptedInlineData)))
+	if err != nil {
+		return nil, err
+	}
+
 	path, err := CreatePath(ctx, keyInfo.ProjectID, int64(req.Position.Index), streamID.Bucket, streamID.EncryptedPath)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -1508,6 +1687,7 @@ func (endpoint *Endpoint) MakeInlineSegment(ctx context.Context, req *pb.Segment
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	endpoint.keyUsage.RecordUpload(keyInfo.ID, pointer.SegmentSize)
 
 	err = endpoint.orders.UpdatePutInlineOrder(ctx, keyInfo.ProjectID, streamID.Bucket, inlineUsed)
 	if err != nil {
@@ -1780,6 +1960,7 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 	if err != nil {
 		return nil, err
 	}
+	endpoint.keyUsage.RecordDownload(keyInfo.ID, pointer.SegmentSize)
 
 	segmentID, err := endpoint.packSegmentID(ctx, &pb.SatSegmentID{})
 
@@ -1869,6 +2050,130 @@ func (endpoint *Endpoint) getPointer(ctx context.Context, projectID uuid.UUID, s
 	return pointer, path, nil
 }
 
+// objectExists returns whether the object has a committed last segment
+func (endpoint *Endpoint) objectExists(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	_, _, err = endpoint.getPointer(ctx, projectID, -1, bucket, encryptedPath)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// isOverwrite returns whether the upload overwrites an existing object. The
+// object is only looked up when the api key disallows overwrites, otherwise
+// the upload is treated as a new object.
+func (endpoint *Endpoint) isOverwrite(ctx context.Context, projectID uuid.UUID, bucket, encryptedPath []byte) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	keyData, ok := auth.GetAPIKey(ctx)
+	if !ok {
+		return false, status.Error(codes.Unauthenticated, "Missing API credentials")
+	}
+
+	key, err := macaroon.ParseAPIKey(string(keyData))
+	if err != nil {
+		return false, status.Error(codes.InvalidArgument, "Invalid API credentials")
+	}
+
+	disallowed, err := key.DisallowsOverwrites()
+	if err != nil {
+		return false, status.Error(codes.InvalidArgument, "Invalid API credentials")
+	}
+	if !disallowed {
+		return false, nil
+	}
+
+	return endpoint.objectExists(ctx, projectID, bucket, encryptedPath)
+}
+
+// commitSegmentAction returns the write action describing the object being
+// uploaded, as far as it's known when committing a segment with the old api
+func (endpoint *Endpoint) commitSegmentAction(ctx context.Context, projectID uuid.UUID, req *pb.SegmentCommitRequestOld) (action macaroon.Action, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	action = macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.Path,
+		Time:          time.Now(),
+	}
+
+	if req.Segment >= 0 {
+		// all segments except the last one have the same size
+		action.SegmentCount = req.Segment + 1
+		action.ObjectSize = action.SegmentCount * req.Pointer.GetSegmentSize()
+		return action, nil
+	}
+
+	// the old api doesn't require the metadata of the last segment to be
+	// a stream meta, in which case the number of segments is unknown
+	streamMeta := &pb.StreamMeta{}
+	if err := proto.Unmarshal(req.Pointer.GetMetadata(), streamMeta); err != nil {
+		streamMeta = &pb.StreamMeta{}
+	}
+
+	action.SegmentCount = streamMeta.NumberOfSegments
+	action.ObjectSize = req.Pointer.GetSegmentSize()
+	if streamMeta.NumberOfSegments > 1 {
+		first, _, err := endpoint.getPointer(ctx, projectID, 0, req.Bucket, req.Path)
+		if err != nil {
+			return action, err
+		}
+		action.ObjectSize += (streamMeta.NumberOfSegments - 1) * first.SegmentSize
+	}
+
+	action.Overwrite, err = endpoint.isOverwrite(ctx, projectID, req.Bucket, req.Path)
+	return action, err
+}
+
+// moveSegmentAction returns the write action describing the object being moved
+// to the new path. The segments of an object are moved one by one and the last
+// segment is moved last, so the first segment may already be at the new path.
+func (endpoint *Endpoint) moveSegmentAction(ctx context.Context, projectID uuid.UUID, req *pb.SegmentMoveRequestOld) (action macaroon.Action, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	action = macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        req.Bucket,
+		EncryptedPath: req.NewPath,
+		Time:          time.Now(),
+	}
+
+	last, _, err := endpoint.getPointer(ctx, projectID, -1, req.Bucket, req.Path)
+	if err != nil {
+		return action, err
+	}
+
+	streamMeta := &pb.StreamMeta{}
+	if err := proto.Unmarshal(last.GetMetadata(), streamMeta); err != nil {
+		streamMeta = &pb.StreamMeta{}
+	}
+
+	action.SegmentCount = streamMeta.NumberOfSegments
+	action.ObjectSize = last.SegmentSize
+	if streamMeta.NumberOfSegments > 1 {
+		// all segments except the last one have the same size
+		segmentIndex := req.Segment
+		if segmentIndex < 0 {
+			segmentIndex = 0
+		}
+		segment, _, err := endpoint.getPointer(ctx, projectID, segmentIndex, req.Bucket, req.Path)
+		if status.Code(err) == codes.NotFound {
+			segment, _, err = endpoint.getPointer(ctx, projectID, segmentIndex, req.Bucket, req.NewPath)
+		}
+		if err != nil {
+			return action, err
+		}
+		action.ObjectSize += (streamMeta.NumberOfSegments - 1) * segment.SegmentSize
+	}
+
+	action.Overwrite, err = endpoint.isOverwrite(ctx, projectID, req.Bucket, req.NewPath)
+	return action, err
+}
+
 // sortLimits sorts order limits and fill missing ones with nil values
 func sortLimits(limits []*pb.AddressedOrderLimit, pointer *pb.Pointer) []*pb.AddressedOrderLimit {
 	sorted := make([]*pb.AddressedOrderLimit, pointer.GetRemote().GetRedundancy().GetTotal())
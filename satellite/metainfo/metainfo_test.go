@@ -1531,3 +1531,334 @@ func TestBatch(t *testing.T) {
 		}
 	})
 }
+
+func TestAPIKeyUsage(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+
+		data := testrand.Bytes(10 * memory.KiB)
+		err := uplink.Upload(ctx, satellite, "usage-bucket", "file-object", data)
+		require.NoError(t, err)
+
+		downloaded, err := uplink.Download(ctx, satellite, "usage-bucket", "file-object")
+		require.NoError(t, err)
+		require.Equal(t, data, downloaded)
+
+		require.NoError(t, satellite.Metainfo.KeyUsage.Flush(ctx))
+
+		projects, err := satellite.DB.Console().Projects().GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, projects, 1)
+
+		keys, err := satellite.DB.Console().APIKeys().GetByProjectID(ctx, projects[0].ID)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+
+		assert.WithinDuration(t, time.Now(), keys[0].LastUsedAt, time.Minute)
+		assert.True(t, keys[0].RequestCount > 0)
+		assert.True(t, keys[0].BytesUploaded >= int64(len(data)))
+		assert.True(t, keys[0].BytesDownloaded >= int64(len(data)))
+	})
+}
+
+func TestUploadRestrictedAPIKey(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+
+		err := uplink.Upload(ctx, satellite, "testbucket", "test/path", testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+
+		key, err := macaroon.ParseAPIKey(uplink.APIKey[satellite.ID()])
+		require.NoError(t, err)
+
+		// commits an inline last segment of an object with the given number of segments
+		commitLast := func(client *metainfo.Client, path string, size int, segments int64) error {
+			streamMeta, err := proto.Marshal(&pb.StreamMeta{NumberOfSegments: segments})
+			require.NoError(t, err)
+
+			_, err = client.CommitSegment(ctx, "testbucket", path, -1, &pb.Pointer{
+				Type:          pb.Pointer_INLINE,
+				InlineSegment: testrand.Bytes(memory.Size(size)),
+				SegmentSize:   int64(size),
+				Metadata:      streamMeta,
+			}, nil)
+			return err
+		}
+
+		unrestricted, err := uplink.DialMetainfo(ctx, satellite, key.Serialize())
+		require.NoError(t, err)
+		defer ctx.Check(unrestricted.Close)
+		require.NoError(t, commitLast(unrestricted, "existing", 10, 1))
+
+		// the caveats checked once the upload is known are reported as
+		// permission denied, the others are already rejected by the key check
+		for i, test := range []struct {
+			Caveat   macaroon.Caveat
+			Path     string
+			Size     int
+			Segments int64
+			Allowed  bool
+			Code     codes.Code
+		}{
+			{Caveat: macaroon.Caveat{DisallowOverwrites: true}, Path: "existing", Size: 10, Segments: 1, Code: codes.PermissionDenied},
+			{Caveat: macaroon.Caveat{DisallowOverwrites: true}, Path: "new-object", Size: 10, Segments: 1, Allowed: true},
+			{Caveat: macaroon.Caveat{MaxObjectSize: 100}, Path: "too-large", Size: 200, Segments: 1, Code: codes.PermissionDenied},
+			{Caveat: macaroon.Caveat{MaxObjectSize: 100}, Path: "small", Size: 50, Segments: 1, Allowed: true},
+			{Caveat: macaroon.Caveat{MaxSegments: 1}, Path: "too-many-segments", Size: 10, Segments: 2, Code: codes.PermissionDenied},
+			{Caveat: macaroon.Caveat{AllowedIpRanges: []string{"10.0.0.0/8"}}, Path: "other-network", Size: 10, Segments: 1, Code: codes.Unauthenticated},
+			{Caveat: macaroon.Caveat{AllowedIpRanges: []string{"127.0.0.0/8", "::1"}}, Path: "local-network", Size: 10, Segments: 1, Allowed: true},
+		} {
+			restrictedKey, err := key.Restrict(test.Caveat)
+			require.NoError(t, err)
+
+			client, err := uplink.DialMetainfo(ctx, satellite, restrictedKey.Serialize())
+			require.NoError(t, err)
+			defer ctx.Check(client.Close)
+
+			if test.Segments > 1 {
+				_, err = client.CommitSegment(ctx, "testbucket", test.Path, 0, &pb.Pointer{
+					Type:          pb.Pointer_INLINE,
+					InlineSegment: testrand.Bytes(memory.Size(test.Size)),
+					SegmentSize:   int64(test.Size),
+				}, nil)
+				require.NoError(t, err, "test #%d", i+1)
+			}
+
+			err = commitLast(client, test.Path, test.Size, test.Segments)
+			if test.Allowed {
+				require.NoError(t, err, "test #%d", i+1)
+			} else {
+				require.Error(t, err, "test #%d", i+1)
+				assert.Equal(t, test.Code, status.Code(errs.Unwrap(err)), "test #%d", i+1)
+			}
+		}
+	})
+}
+
+func TestSegmentUploadRestrictedAPIKey(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+
+		err := uplink.Upload(ctx, satellite, "testbucket", "test/path", testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+
+		key, err := macaroon.ParseAPIKey(uplink.APIKey[satellite.ID()])
+		require.NoError(t, err)
+
+		unrestricted, err := uplink.DialMetainfo(ctx, satellite, key.Serialize())
+		require.NoError(t, err)
+		defer ctx.Check(unrestricted.Close)
+
+		// the first segment of the object is written with the unrestricted key
+		streamID, err := unrestricted.BeginObject(ctx, metainfo.BeginObjectParams{
+			Bucket:        []byte("testbucket"),
+			EncryptedPath: []byte("object"),
+			Redundancy: storj.RedundancyScheme{
+				Algorithm:      storj.ReedSolomon,
+				ShareSize:      256,
+				RequiredShares: 1,
+				RepairShares:   1,
+				OptimalShares:  3,
+				TotalShares:    4,
+			},
+			ExpiresAt: time.Now().UTC().Add(24 * time.Hour),
+		})
+		require.NoError(t, err)
+
+		err = unrestricted.MakeInlineSegment(ctx, metainfo.MakeInlineSegmentParams{
+			StreamID:            streamID,
+			Position:            storj.SegmentPosition{Index: 0},
+			EncryptedInlineData: testrand.Bytes(memory.KiB),
+		})
+		require.NoError(t, err)
+
+		dial := func(caveat macaroon.Caveat) *metainfo.Client {
+			restrictedKey, err := key.Restrict(caveat)
+			require.NoError(t, err)
+
+			client, err := uplink.DialMetainfo(ctx, satellite, restrictedKey.Serialize())
+			require.NoError(t, err)
+			return client
+		}
+
+		// the written segments count towards the object size before the object is committed
+		small := dial(macaroon.Caveat{MaxObjectSize: 1000})
+		defer ctx.Check(small.Close)
+
+		_, _, _, err = small.BeginSegment(ctx, metainfo.BeginSegmentParams{
+			StreamID:     streamID,
+			Position:     storj.SegmentPosition{Index: 1},
+			MaxOderLimit: memory.MiB.Int64(),
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(errs.Unwrap(err)))
+
+		err = small.MakeInlineSegment(ctx, metainfo.MakeInlineSegmentParams{
+			StreamID:            streamID,
+			Position:            storj.SegmentPosition{Index: 1},
+			EncryptedInlineData: testrand.Bytes(1),
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(errs.Unwrap(err)))
+
+		// the size of the written segment is added to the object size
+		larger := dial(macaroon.Caveat{MaxObjectSize: 2000})
+		defer ctx.Check(larger.Close)
+
+		err = larger.MakeInlineSegment(ctx, metainfo.MakeInlineSegmentParams{
+			StreamID:            streamID,
+			Position:            storj.SegmentPosition{Index: 1},
+			EncryptedInlineData: testrand.Bytes(memory.KiB),
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(errs.Unwrap(err)))
+
+		err = larger.MakeInlineSegment(ctx, metainfo.MakeInlineSegmentParams{
+			StreamID:            streamID,
+			Position:            storj.SegmentPosition{Index: 1},
+			EncryptedInlineData: testrand.Bytes(512),
+		})
+		require.NoError(t, err)
+
+		// the segment index is checked against the maximum number of segments
+		segments := dial(macaroon.Caveat{MaxSegments: 2})
+		defer ctx.Check(segments.Close)
+
+		_, _, _, err = segments.BeginSegment(ctx, metainfo.BeginSegmentParams{
+			StreamID:     streamID,
+			Position:     storj.SegmentPosition{Index: 2},
+			MaxOderLimit: memory.MiB.Int64(),
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(errs.Unwrap(err)))
+
+		err = segments.MakeInlineSegment(ctx, metainfo.MakeInlineSegmentParams{
+			StreamID:            streamID,
+			Position:            storj.SegmentPosition{Index: 2},
+			EncryptedInlineData: testrand.Bytes(1),
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(errs.Unwrap(err)))
+	})
+}
+
+func TestMoveSegmentRestrictedAPIKey(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+
+		err := uplink.Upload(ctx, satellite, "testbucket", "test/path", testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+
+		key, err := macaroon.ParseAPIKey(uplink.APIKey[satellite.ID()])
+		require.NoError(t, err)
+
+		unrestricted, err := uplink.DialMetainfo(ctx, satellite, key.Serialize())
+		require.NoError(t, err)
+		defer ctx.Check(unrestricted.Close)
+
+		streamMeta, err := proto.Marshal(&pb.StreamMeta{NumberOfSegments: 1})
+		require.NoError(t, err)
+		_, err = unrestricted.CommitSegment(ctx, "testbucket", "object", -1, &pb.Pointer{
+			Type:          pb.Pointer_INLINE,
+			InlineSegment: testrand.Bytes(200),
+			SegmentSize:   200,
+			Metadata:      streamMeta,
+		}, nil)
+		require.NoError(t, err)
+
+		// moving the object in place replaces it
+		for i, test := range []struct {
+			Caveat  macaroon.Caveat
+			NewPath string
+			Allowed bool
+		}{
+			{Caveat: macaroon.Caveat{MaxObjectSize: 100}, NewPath: "moved"},
+			{Caveat: macaroon.Caveat{MaxSegments: 1, DisallowOverwrites: true}, NewPath: "object"},
+			{Caveat: macaroon.Caveat{MaxObjectSize: 1000, DisallowOverwrites: true}, NewPath: "moved", Allowed: true},
+		} {
+			restrictedKey, err := key.Restrict(test.Caveat)
+			require.NoError(t, err)
+
+			client, err := uplink.DialMetainfo(ctx, satellite, restrictedKey.Serialize())
+			require.NoError(t, err)
+			defer ctx.Check(client.Close)
+
+			err = client.MoveSegment(ctx, "testbucket", "object", -1, test.NewPath, streamMeta)
+			if test.Allowed {
+				require.NoError(t, err, "test #%d", i+1)
+			} else {
+				require.Error(t, err, "test #%d", i+1)
+				assert.Equal(t, codes.PermissionDenied, status.Code(errs.Unwrap(err)), "test #%d", i+1)
+			}
+		}
+	})
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+
+		key, err := macaroon.ParseAPIKey(uplink.APIKey[satellite.ID()])
+		require.NoError(t, err)
+
+		restricted, err := key.Restrict(macaroon.Caveat{DisallowDeletes: true})
+		require.NoError(t, err)
+		derived, err := restricted.Restrict(macaroon.Caveat{DisallowWrites: true})
+		require.NoError(t, err)
+		sibling, err := key.Restrict(macaroon.Caveat{DisallowLists: true})
+		require.NoError(t, err)
+
+		dial := func(key *macaroon.APIKey) *metainfo.Client {
+			client, err := uplink.DialMetainfo(ctx, satellite, key.Serialize())
+			require.NoError(t, err)
+			return client
+		}
+
+		derivedClient := dial(derived)
+		defer ctx.Check(derivedClient.Close)
+		siblingClient := dial(sibling)
+		defer ctx.Check(siblingClient.Close)
+		unrestrictedClient := dial(key)
+		defer ctx.Check(unrestrictedClient.Close)
+
+		_, err = derivedClient.GetProjectInfo(ctx)
+		require.NoError(t, err)
+
+		// a key can't revoke its ancestors or unrelated keys
+		err = derivedClient.RevokeAPIKey(ctx, restricted.SerializeRaw())
+		require.Error(t, err)
+		err = siblingClient.RevokeAPIKey(ctx, restricted.SerializeRaw())
+		require.Error(t, err)
+
+		// unrestricted keys have to be deleted instead
+		err = unrestrictedClient.RevokeAPIKey(ctx, key.SerializeRaw())
+		require.Error(t, err)
+
+		err = unrestrictedClient.RevokeAPIKey(ctx, restricted.SerializeRaw())
+		require.NoError(t, err)
+
+		// the revoked key and keys derived from it are rejected,
+		// while the other keys keep working
+		_, err = derivedClient.GetProjectInfo(ctx)
+		require.Error(t, err)
+		_, err = siblingClient.GetProjectInfo(ctx)
+		require.NoError(t, err)
+		_, err = unrestrictedClient.GetProjectInfo(ctx)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// RevocationConfig contains configurable values for api key revocations
+type RevocationConfig struct {
+	CacheExpiration time.Duration `help:"how long revoked api keys of a project are cached before reloading them from the database" default:"1m"`
+}
+
+// RevocationCache keeps the revoked api key tails of projects in memory,
+// reloading them from the database once they expire.
+type RevocationCache struct {
+	db         Revocations
+	expiration time.Duration
+
+	mu       sync.Mutex
+	projects map[uuid.UUID]*revokedTails
+}
+
+// revokedTails are the cached revocations of a single project
+type revokedTails struct {
+	tails    [][]byte
+	loadedAt time.Time
+}
+
+// NewRevocationCache creates a new revocation cache, zero expiration disables caching
+func NewRevocationCache(db Revocations, config RevocationConfig) *RevocationCache {
+	return &RevocationCache{
+		db:         db,
+		expiration: config.CacheExpiration,
+		projects:   make(map[uuid.UUID]*revokedTails),
+	}
+}
+
+// Get returns the revoked api key tails of the project
+func (cache *RevocationCache) Get(ctx context.Context, projectID uuid.UUID) (_ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+
+	cache.mu.Lock()
+	cached, ok := cache.projects[projectID]
+	cache.mu.Unlock()
+
+	if ok && now.Sub(cached.loadedAt) < cache.expiration {
+		return cached.tails, nil
+	}
+
+	tails, err := cache.db.GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	cache.mu.Lock()
+	cache.projects[projectID] = &revokedTails{tails: tails, loadedAt: now}
+	cache.mu.Unlock()
+
+	return tails, nil
+}
+
+// Revoke stores the tail as revoked and drops the cached revocations of the project
+func (cache *RevocationCache) Revoke(ctx context.Context, projectID uuid.UUID, tail []byte, apiKeyID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = cache.db.Revoke(ctx, tail, apiKeyID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	cache.Invalidate(projectID)
+	return nil
+}
+
+// Invalidate drops the cached revocations of the project
+func (cache *RevocationCache) Invalidate(projectID uuid.UUID) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	delete(cache.projects, projectID)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/storj/pkg/macaroon"
@@ -239,6 +240,46 @@ func (s *Service) Delete(ctx context.Context, path string) (err error) {
 	return s.DB.Delete(ctx, []byte(path))
 }
 
+// Move moves the pointer under path to newPath and replaces its metadata,
+// keeping its pieces. The creation date of the pointer is kept as well.
+func (s *Service) Move(ctx context.Context, path, newPath string, metadata []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	oldPointerBytes, err := s.DB.Get(ctx, []byte(path))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	pointer := &pb.Pointer{}
+	err = proto.Unmarshal(oldPointerBytes, pointer)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	pointer.Metadata = metadata
+
+	newPointerBytes, err := proto.Marshal(pointer)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if path == newPath {
+		return Error.Wrap(s.DB.CompareAndSwap(ctx, []byte(path), oldPointerBytes, newPointerBytes))
+	}
+
+	err = s.DB.CompareAndSwap(ctx, []byte(newPath), nil, newPointerBytes)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// remove the old pointer only if it didn't change in the meantime,
+	// otherwise undo the move
+	err = s.DB.CompareAndSwap(ctx, []byte(path), oldPointerBytes, nil)
+	if err != nil {
+		return Error.Wrap(errs.Combine(err, s.DB.Delete(ctx, []byte(newPath))))
+	}
+	return nil
+}
+
 // Iterate iterates over items in db
 func (s *Service) Iterate(ctx context.Context, prefix string, first string, recurse bool, reverse bool, f func(context.Context, storage.Iterator) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -6,6 +6,7 @@ package metainfo
 import (
 	"bytes"
 	"context"
+	"net"
 	"regexp"
 	"sync"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
 	"storj.io/storj/pkg/auth"
@@ -22,6 +24,7 @@ import (
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/storage"
 )
 
 const (
@@ -140,16 +143,122 @@ func (endpoint *Endpoint) validateAuth(ctx context.Context, action macaroon.Acti
 		return nil, status.Error(codes.PermissionDenied, "Unauthorized API credentials")
 	}
 
-	// Revocations are currently handled by just deleting the key.
-	err = key.Check(ctx, keyInfo.Secret, action, nil)
+	if action.ClientIP == nil {
+		action.ClientIP = clientIP(ctx)
+	}
+
+	revoked, err := endpoint.revocations.Get(ctx, keyInfo.ProjectID)
+	if err != nil {
+		endpoint.log.Error("retrieving revocations failed", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Unable to check API credentials")
+	}
+
+	err = key.Check(ctx, keyInfo.Secret, action, revoked)
 	if err != nil {
 		endpoint.log.Debug("unauthorized request", zap.Error(err))
 		return nil, status.Error(codes.PermissionDenied, "Unauthorized API credentials")
 	}
 
+	endpoint.keyUsage.RecordRequest(keyInfo.ID)
+
 	return keyInfo, nil
 }
 
+// validateUpload checks the write action again once the size, the number of
+// segments and whether the object already exists are known, so that the
+// upload limiting caveats of the already validated key are enforced.
+func (endpoint *Endpoint) validateUpload(ctx context.Context, keyInfo *console.APIKeyInfo, action macaroon.Action) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	keyData, ok := auth.GetAPIKey(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "Missing API credentials")
+	}
+
+	key, err := macaroon.ParseAPIKey(string(keyData))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "Invalid API credentials")
+	}
+
+	action.Op = macaroon.ActionWrite
+	action.ClientIP = clientIP(ctx)
+
+	err = key.Check(ctx, keyInfo.Secret, action, nil)
+	if err != nil {
+		endpoint.log.Debug("unauthorized upload", zap.Error(err))
+		return status.Error(codes.PermissionDenied, "Upload not allowed by API credentials")
+	}
+	return nil
+}
+
+// validateSegmentUpload checks the upload limiting caveats of the key when the
+// segment at the index with the size is written. The object size is the size of
+// the already written segments of the object together with the segment size, the
+// written segments are only looked up when the key limits the object size.
+func (endpoint *Endpoint) validateSegmentUpload(ctx context.Context, keyInfo *console.APIKeyInfo, streamID *pb.SatStreamID, index int32, segmentSize int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	keyData, ok := auth.GetAPIKey(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "Missing API credentials")
+	}
+
+	key, err := macaroon.ParseAPIKey(string(keyData))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "Invalid API credentials")
+	}
+
+	maxObjectSize, err := key.MaxObjectSize()
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "Invalid API credentials")
+	}
+
+	objectSize := segmentSize
+	if maxObjectSize > 0 {
+		for segmentIndex := int64(0); segmentIndex < int64(index); segmentIndex++ {
+			path, err := CreatePath(ctx, keyInfo.ProjectID, segmentIndex, streamID.Bucket, streamID.EncryptedPath)
+			if err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+
+			pointer, err := endpoint.metainfo.Get(ctx, path)
+			if err != nil {
+				if storage.ErrKeyNotFound.Has(err) {
+					continue
+				}
+				return status.Error(codes.Internal, err.Error())
+			}
+			objectSize += pointer.SegmentSize
+
+			if objectSize > maxObjectSize {
+				break
+			}
+		}
+	}
+
+	return endpoint.validateUpload(ctx, keyInfo, macaroon.Action{
+		Bucket:        streamID.Bucket,
+		EncryptedPath: streamID.EncryptedPath,
+		Time:          time.Now(),
+		ObjectSize:    objectSize,
+		SegmentCount:  int64(index) + 1,
+	})
+}
+
+// clientIP returns the address of the peer the request came from
+func clientIP(ctx context.Context) net.IP {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil
+	}
+	if addr, ok := p.Addr.(*net.TCPAddr); ok {
+		return addr.IP
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return nil
+	}
+	return net.ParseIP(host)
+}
+
 func (endpoint *Endpoint) validateCreateSegment(ctx context.Context, req *pb.SegmentWriteRequestOld) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
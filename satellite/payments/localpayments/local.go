@@ -7,6 +7,7 @@ import (
 	"context"
 	"crypto/rand"
 	mathRand "math/rand"
+	"sync"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -42,6 +43,11 @@ type DB interface {
 // service is internal payments.Service implementation
 type service struct {
 	db DB
+
+	mu       sync.Mutex
+	invoices map[string]payments.Invoice
+	// idempotent maps idempotency keys to ids of invoices created with them
+	idempotent map[string]string
 }
 
 func (*service) AddPaymentMethod(ctx context.Context, params payments.AddPaymentMethodParams) (*payments.PaymentMethod, error) {
@@ -50,7 +56,11 @@ func (*service) AddPaymentMethod(ctx context.Context, params payments.AddPayment
 
 // NewService create new instance of local payments service
 func NewService(db DB) payments.Service {
-	return &service{db: db}
+	return &service{
+		db:         db,
+		invoices:   make(map[string]payments.Invoice),
+		idempotent: make(map[string]string),
+	}
 }
 
 // CreateCustomer creates new payments.Customer with random id to satisfy unique db constraint
@@ -93,19 +103,61 @@ func (*service) GetPaymentMethod(ctx context.Context, id []byte) (_ *payments.Pa
 	return paymentMethod(string(id), []byte("")), nil
 }
 
-// CreateProjectInvoice creates invoice from provided params
-func (*service) CreateProjectInvoice(ctx context.Context, params payments.CreateProjectInvoiceParams) (_ *payments.Invoice, err error) {
+// CreateProjectInvoice creates invoice from provided params and keeps it in memory,
+// a repeated request with the same idempotency key returns the already created invoice
+func (s *service) CreateProjectInvoice(ctx context.Context, params payments.CreateProjectInvoiceParams) (_ *payments.Invoice, err error) {
 	defer mon.Task()(&ctx)(&err)
-	// TODO: fill data
-	return &payments.Invoice{}, nil
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if id, ok := s.idempotent[params.IdempotencyKey]; ok && params.IdempotencyKey != "" {
+		inv := s.invoices[id]
+		return &inv, nil
+	}
+
+	lineItems := make([]payments.LineItem, len(params.LineItems))
+	copy(lineItems, params.LineItems)
+
+	inv := payments.Invoice{
+		ID:              []byte("in_" + randomString(24)),
+		PaymentMethodID: params.PaymentMethodID,
+		Amount:          payments.TotalAmount(lineItems),
+		Currency:        payments.CurrencyUSD,
+		LineItems:       lineItems,
+		CustomFields: []payments.CustomField{
+			{
+				Name:  "Billing period",
+				Value: params.StartDate.Format("01/02/2006") + " - " + params.EndDate.Format("01/02/2006"),
+			},
+			{
+				Name:  "Project Name",
+				Value: params.ProjectName,
+			},
+		},
+		CreatedAt: time.Now().UTC(),
+	}
+
+	s.invoices[string(inv.ID)] = inv
+	if params.IdempotencyKey != "" {
+		s.idempotent[params.IdempotencyKey] = string(inv.ID)
+	}
+
+	return &inv, nil
 }
 
-// GetInvoice retrieves invoice information from project invoice stamp by invoice id
-// and returns invoice
-func (*service) GetInvoice(ctx context.Context, id []byte) (_ *payments.Invoice, err error) {
+// GetInvoice returns invoice previously created by CreateProjectInvoice
+func (s *service) GetInvoice(ctx context.Context, id []byte) (_ *payments.Invoice, err error) {
 	defer mon.Task()(&ctx)(&err)
-	// TODO: get project invoice stamp by invoice id from the db and fill data
-	return &payments.Invoice{}, nil
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inv, ok := s.invoices[string(id)]
+	if !ok {
+		return nil, internalPaymentsErr.New("invoice %s not found", id)
+	}
+	return &inv, nil
 }
 
 // paymentMethod returns paymentMethod object which mocks stripe response
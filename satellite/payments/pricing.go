@@ -0,0 +1,70 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payments
+
+import (
+	"math"
+)
+
+// HoursPerMonth is the number of hours used to convert hourly usage into monthly usage.
+const HoursPerMonth = 24 * 30
+
+const (
+	// LineItemStorage is the key of the storage line item, measured in GB-months.
+	LineItemStorage = "Storage"
+	// LineItemEgress is the key of the egress line item, measured in GB.
+	LineItemEgress = "Egress"
+	// LineItemObjectCount is the key of the object count line item, measured in object-months.
+	LineItemObjectCount = "ObjectCount"
+	// LineItemCredits is the key of the line item with applied user credits.
+	LineItemCredits = "Credits"
+)
+
+// PriceTable contains prices in USD used to turn project usage into invoice line items.
+type PriceTable struct {
+	StorageGBMonth float64 `help:"price in USD for one GB-month of stored data" default:"0.01"`
+	EgressGB       float64 `help:"price in USD for one GB of egress" default:"0.045"`
+	ObjectMonth    float64 `help:"price in USD for one object-month" default:"0"`
+}
+
+// ProjectUsage contains project usage for a billing period.
+type ProjectUsage struct {
+	// StorageGBHours is the amount of stored data in GB-hours.
+	StorageGBHours float64
+	// EgressGB is the amount of egress in GB.
+	EgressGB float64
+	// ObjectHours is the number of stored objects in object-hours.
+	ObjectHours float64
+}
+
+// LineItems prices usage and returns storage, egress and object count line items.
+// Amounts are in cents.
+func (prices PriceTable) LineItems(usage ProjectUsage) []LineItem {
+	storage := usage.StorageGBHours / HoursPerMonth
+	objects := usage.ObjectHours / HoursPerMonth
+
+	return []LineItem{
+		priceLineItem(LineItemStorage, storage, prices.StorageGBMonth),
+		priceLineItem(LineItemEgress, usage.EgressGB, prices.EgressGB),
+		priceLineItem(LineItemObjectCount, objects, prices.ObjectMonth),
+	}
+}
+
+// priceLineItem creates line item for quantity with unit price in USD.
+func priceLineItem(key string, quantity float64, price float64) LineItem {
+	return LineItem{
+		Key:      key,
+		Quantity: int64(math.Round(quantity)),
+		Amount:   int64(math.Round(quantity * price * 100)),
+	}
+}
+
+// TotalAmount returns the sum of line item amounts.
+func TotalAmount(items []LineItem) int64 {
+	var total int64
+	for _, item := range items {
+		total += item.Amount
+	}
+	return total
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payments_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/satellite/payments"
+)
+
+func TestPriceTableLineItems(t *testing.T) {
+	prices := payments.PriceTable{
+		StorageGBMonth: 0.01,
+		EgressGB:       0.045,
+		ObjectMonth:    0.001,
+	}
+
+	items := prices.LineItems(payments.ProjectUsage{
+		StorageGBHours: 10 * payments.HoursPerMonth,
+		EgressGB:       100,
+		ObjectHours:    100 * payments.HoursPerMonth,
+	})
+
+	assert.Equal(t, []payments.LineItem{
+		{Key: payments.LineItemStorage, Quantity: 10, Amount: 10},
+		{Key: payments.LineItemEgress, Quantity: 100, Amount: 450},
+		{Key: payments.LineItemObjectCount, Quantity: 100, Amount: 10},
+	}, items)
+	assert.EqualValues(t, 470, payments.TotalAmount(items))
+
+	empty := prices.LineItems(payments.ProjectUsage{})
+	assert.EqualValues(t, 0, payments.TotalAmount(empty))
+}
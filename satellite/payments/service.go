@@ -73,8 +73,15 @@ type CreateProjectInvoiceParams struct {
 	Egress      float64
 	ObjectCount float64
 
+	// LineItems contains priced usage, amounts are in cents
+	LineItems []LineItem
+
 	StartDate time.Time
 	EndDate   time.Time
+
+	// IdempotencyKey identifies the invoice, a request repeated with the same key
+	// returns the invoice created by the first request instead of a new one
+	IdempotencyKey string
 }
 
 // Currency is type for allowed currency
@@ -233,10 +233,7 @@ func (s *service) GetPaymentMethod(ctx context.Context, id []byte) (_ *payments.
 }
 
 // CreateProjectInvoice creates new project invoice on stripe network from input params.
-// Included line items:
-// - Storage
-// - Egress
-// - ObjectsCount
+// Every line item from params is added to the invoice with its precalculated amount.
 // Created invoice has AutoAdvance property set to true, so it will be finalized
 // (no further editing) and attempted to be paid in 1 hour after creation
 func (s *service) CreateProjectInvoice(ctx context.Context, params payments.CreateProjectInvoiceParams) (_ *payments.Invoice, err error) {
@@ -245,37 +242,21 @@ func (s *service) CreateProjectInvoice(ctx context.Context, params payments.Crea
 	customerID := string(params.CustomerID)
 
 	// create line items
-	_, err = s.client.InvoiceItems.New(&stripe.InvoiceItemParams{
-		Customer:    stripe.String(customerID),
-		Description: stripe.String("Storage"),
-		Quantity:    stripe.Int64(int64(params.Storage)),
-		UnitAmount:  stripe.Int64(100),
-		Currency:    stripe.String(string(stripe.CurrencyUSD)),
-	})
-	if err != nil {
-		return nil, stripeErr.Wrap(err)
-	}
-
-	_, err = s.client.InvoiceItems.New(&stripe.InvoiceItemParams{
-		Customer:    stripe.String(customerID),
-		Description: stripe.String("Egress"),
-		Quantity:    stripe.Int64(int64(params.Egress)),
-		UnitAmount:  stripe.Int64(100),
-		Currency:    stripe.String(string(stripe.CurrencyUSD)),
-	})
-	if err != nil {
-		return nil, stripeErr.Wrap(err)
-	}
+	for i, item := range params.LineItems {
+		itemParams := &stripe.InvoiceItemParams{
+			Customer:    stripe.String(customerID),
+			Description: stripe.String(fmt.Sprintf("%s (%d)", item.Key, item.Quantity)),
+			Amount:      stripe.Int64(item.Amount),
+			Currency:    stripe.String(string(stripe.CurrencyUSD)),
+		}
+		if params.IdempotencyKey != "" {
+			itemParams.SetIdempotencyKey(fmt.Sprintf("%s-item-%d", params.IdempotencyKey, i))
+		}
 
-	_, err = s.client.InvoiceItems.New(&stripe.InvoiceItemParams{
-		Customer:    stripe.String(customerID),
-		Description: stripe.String("ObjectsCount"),
-		Quantity:    stripe.Int64(int64(params.ObjectCount)),
-		UnitAmount:  stripe.Int64(100),
-		Currency:    stripe.String(string(stripe.CurrencyUSD)),
-	})
-	if err != nil {
-		return nil, stripeErr.Wrap(err)
+		_, err = s.client.InvoiceItems.New(itemParams)
+		if err != nil {
+			return nil, stripeErr.Wrap(err)
+		}
 	}
 
 	// create invoice
@@ -295,6 +276,9 @@ func (s *service) CreateProjectInvoice(ctx context.Context, params payments.Crea
 		},
 		AutoAdvance: stripe.Bool(true),
 	}
+	if params.IdempotencyKey != "" {
+		invoiceParams.SetIdempotencyKey(params.IdempotencyKey)
+	}
 
 	inv, err := s.client.Invoices.New(invoiceParams)
 	if err != nil {
@@ -32,6 +32,7 @@ import (
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/alerts"
 	"storj.io/storj/satellite/accounting/live"
 	"storj.io/storj/satellite/accounting/rollup"
 	"storj.io/storj/satellite/accounting/tally"
@@ -40,6 +41,7 @@ import (
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/contact"
 	"storj.io/storj/satellite/dbcleanup"
 	"storj.io/storj/satellite/discovery"
 	"storj.io/storj/satellite/gc"
@@ -127,6 +129,7 @@ type Config struct {
 	Tally          tally.Config
 	Rollup         rollup.Config
 	LiveAccounting live.Config
+	UsageAlerts    alerts.Config
 
 	Mail    mailservice.Config
 	Console consoleweb.Config
@@ -166,15 +169,22 @@ type Peer struct {
 		Inspector *overlay.Inspector
 	}
 
+	Contact struct {
+		Service  *contact.Service
+		Endpoint *contact.Endpoint
+	}
+
 	Discovery struct {
 		Service *discovery.Discovery
 	}
 
 	Metainfo struct {
-		Database  storage.KeyValueStore // TODO: move into pointerDB
-		Service   *metainfo.Service
-		Endpoint2 *metainfo.Endpoint
-		Loop      *metainfo.Loop
+		Database    storage.KeyValueStore // TODO: move into pointerDB
+		Service     *metainfo.Service
+		Endpoint2   *metainfo.Endpoint
+		Loop        *metainfo.Loop
+		KeyUsage    *metainfo.KeyUsageTracker
+		Revocations *metainfo.RevocationCache
 	}
 
 	Inspector struct {
@@ -208,6 +218,7 @@ type Peer struct {
 		Tally        *tally.Service
 		Rollup       *rollup.Service
 		ProjectUsage *accounting.ProjectUsage
+		Alerts       *alerts.Service
 	}
 
 	LiveAccounting struct {
@@ -254,7 +265,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Log.Sugar().Debugf("Binary Version: %s with CommitHash %s, built at %s as Release %v",
 				versionInfo.Version.String(), versionInfo.CommitHash, versionInfo.Timestamp.String(), versionInfo.Release)
 		}
-		peer.Version = version.NewService(log.Named("version"), config.Version, versionInfo, "Satellite")
+		peer.Version = version.NewService(log.Named("version"), config.Version, versionInfo, peer.Identity.ID, "Satellite")
 	}
 
 	{ // setup listener and server
@@ -289,10 +300,15 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		pb.RegisterOverlayInspectorServer(peer.Server.PrivateGRPC(), peer.Overlay.Inspector)
 	}
 
-	{ // setup kademlia
-		log.Debug("Setting up Kademlia")
+	{ // setup contact
+		log.Debug("Setting up contact")
+		// ping backs must not be observed by the overlay
+		options, err := tlsopts.NewOptions(peer.Identity, config.Server.Config, revocationDB)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
 		config := config.Kademlia
-		// TODO: move this setup logic into kademlia package
 		if config.ExternalAddress == "" {
 			config.ExternalAddress = peer.Addr()
 		}
@@ -302,7 +318,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		self := &overlay.NodeDossier{
+		self := overlay.NodeDossier{
 			Node: pb.Node{
 				Id: peer.ID(),
 				Address: &pb.NodeAddress{
@@ -317,6 +333,17 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			Version: *pbVersion,
 		}
 
+		peer.Contact.Service = contact.NewService(peer.Log.Named("contact:service"), self, peer.Overlay.Service, transport.NewClient(options))
+		peer.Contact.Endpoint = contact.NewEndpoint(peer.Log.Named("contact:endpoint"), peer.Contact.Service)
+		pb.RegisterNodeServer(peer.Server.GRPC(), peer.Contact.Endpoint)
+	}
+
+	if !config.Kademlia.Disabled { // setup kademlia
+		log.Debug("Setting up Kademlia")
+		config := config.Kademlia
+		// TODO: move this setup logic into kademlia package
+		self := peer.Contact.Service.Local()
+
 		{ // setup routing table
 			// TODO: clean this up, should be part of database
 			log.Debug("Setting up routing table")
@@ -333,7 +360,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			}
 			peer.Kademlia.kdb, peer.Kademlia.ndb, peer.Kademlia.adb = dbs[0], dbs[1], dbs[2]
 
-			peer.Kademlia.RoutingTable, err = kademlia.NewRoutingTable(peer.Log.Named("routing"), self, peer.Kademlia.kdb, peer.Kademlia.ndb, peer.Kademlia.adb, &config.RoutingTableConfig)
+			peer.Kademlia.RoutingTable, err = kademlia.NewRoutingTable(peer.Log.Named("routing"), &self, peer.Kademlia.kdb, peer.Kademlia.ndb, peer.Kademlia.adb, &config.RoutingTableConfig)
 			if err != nil {
 				return nil, errs.Combine(err, peer.Close())
 			}
@@ -346,14 +373,14 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Kademlia.Endpoint = kademlia.NewEndpoint(peer.Log.Named("kademlia:endpoint"), peer.Kademlia.Service, peer.Kademlia.RoutingTable, nil)
+		peer.Kademlia.Endpoint = kademlia.NewEndpoint(peer.Log.Named("kademlia:endpoint"), peer.Kademlia.Service, peer.Kademlia.RoutingTable, nil, nil)
 		pb.RegisterNodesServer(peer.Server.GRPC(), peer.Kademlia.Endpoint)
 
 		peer.Kademlia.Inspector = kademlia.NewInspector(peer.Kademlia.Service, peer.Identity)
 		pb.RegisterKadInspectorServer(peer.Server.PrivateGRPC(), peer.Kademlia.Inspector)
 	}
 
-	{ // setup discovery
+	if !config.Kademlia.Disabled { // setup discovery
 		log.Debug("Setting up discovery")
 		config := config.Discovery
 		peer.Discovery.Service = discovery.New(peer.Log.Named("discovery"), peer.Overlay.Service, peer.Kademlia.Service, config)
@@ -370,6 +397,74 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		pb.RegisterVouchersServer(peer.Server.GRPC(), peer.Vouchers.Endpoint)
 	}
 
+	{ // setup mailservice
+		log.Debug("Setting up mail service")
+		// TODO(yar): test multiple satellites using same OAUTH credentials
+		mailConfig := config.Mail
+
+		// validate from mail address
+		from, err := mail.ParseAddress(mailConfig.From)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		// validate smtp server address
+		host, _, err := net.SplitHostPort(mailConfig.SMTPServerAddress)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		var sender mailservice.Sender
+		switch mailConfig.AuthType {
+		case "oauth2":
+			creds := oauth2.Credentials{
+				ClientID:     mailConfig.ClientID,
+				ClientSecret: mailConfig.ClientSecret,
+				TokenURI:     mailConfig.TokenURI,
+			}
+			token, err := oauth2.RefreshToken(context.TODO(), creds, mailConfig.RefreshToken)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+
+			sender = &post.SMTPSender{
+				From: *from,
+				Auth: &oauth2.Auth{
+					UserEmail: from.Address,
+					Storage:   oauth2.NewTokenStore(creds, *token),
+				},
+				ServerAddress: mailConfig.SMTPServerAddress,
+			}
+		case "plain":
+			sender = &post.SMTPSender{
+				From:          *from,
+				Auth:          smtp.PlainAuth("", mailConfig.Login, mailConfig.Password, host),
+				ServerAddress: mailConfig.SMTPServerAddress,
+			}
+		case "login":
+			sender = &post.SMTPSender{
+				From: *from,
+				Auth: post.LoginAuth{
+					Username: mailConfig.Login,
+					Password: mailConfig.Password,
+				},
+				ServerAddress: mailConfig.SMTPServerAddress,
+			}
+		default:
+			sender = &simulate.LinkClicker{}
+		}
+
+		peer.Mail.Service, err = mailservice.New(
+			peer.Log.Named("mail:service"),
+			sender,
+			mailConfig.TemplatePath,
+		)
+
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
 	{ // setup live accounting
 		log.Debug("Setting up live accounting")
 		config := config.LiveAccounting
@@ -380,12 +475,29 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		peer.LiveAccounting.Service = liveAccountingService
 	}
 
+	{ // setup accounting usage alerts
+		log.Debug("Setting up accounting usage alerts")
+		peer.Accounting.Alerts, err = alerts.NewService(
+			peer.Log.Named("usage-alerts"),
+			config.UsageAlerts,
+			peer.DB.Console(),
+			peer.DB.ProjectAccounting(),
+			peer.LiveAccounting.Service,
+			peer.Mail.Service,
+			config.Rollup.MaxAlphaUsage,
+		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
 	{ // setup accounting project usage
 		log.Debug("Setting up accounting project usage")
 		peer.Accounting.ProjectUsage = accounting.NewProjectUsage(
 			peer.DB.ProjectAccounting(),
 			peer.LiveAccounting.Service,
 			config.Rollup.MaxAlphaUsage,
+			peer.Accounting.Alerts,
 		)
 	}
 
@@ -426,6 +538,14 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.DB.Buckets(),
 		)
 		peer.Metainfo.Loop = metainfo.NewLoop(config.Metainfo.Loop, peer.Metainfo.Service)
+		peer.Metainfo.KeyUsage = metainfo.NewKeyUsageTracker(peer.Log.Named("metainfo:keyusage"),
+			peer.DB.Console().APIKeys(),
+			config.Metainfo.KeyUsage,
+		)
+		peer.Metainfo.Revocations = metainfo.NewRevocationCache(
+			peer.DB.Console().Revocations(),
+			config.Metainfo.Revocation,
+		)
 
 		peer.Metainfo.Endpoint2 = metainfo.NewEndpoint(
 			peer.Log.Named("metainfo:endpoint"),
@@ -435,6 +555,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.DB.Attribution(),
 			peer.DB.Containment(),
 			peer.DB.Console().APIKeys(),
+			peer.Metainfo.Revocations,
+			peer.Metainfo.KeyUsage,
 			peer.Accounting.ProjectUsage,
 			config.Metainfo.RS,
 			signing.SignerFromFullIdentity(peer.Identity),
@@ -514,7 +636,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 
 	{ // setup accounting
 		log.Debug("Setting up accounting")
-		peer.Accounting.Tally = tally.New(peer.Log.Named("tally"), peer.DB.StoragenodeAccounting(), peer.DB.ProjectAccounting(), peer.LiveAccounting.Service, peer.Metainfo.Service, peer.Overlay.Service, 0, config.Tally.Interval)
+		peer.Accounting.Tally = tally.New(peer.Log.Named("tally"), peer.DB.StoragenodeAccounting(), peer.DB.ProjectAccounting(), peer.LiveAccounting.Service, peer.Accounting.Alerts, peer.Metainfo.Service, peer.Overlay.Service, 0, config.Tally.Interval)
 		peer.Accounting.Rollup = rollup.New(peer.Log.Named("rollup"), peer.DB.StoragenodeAccounting(), config.Rollup.Interval, config.Rollup.DeleteTallies)
 	}
 
@@ -529,74 +651,6 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		pb.RegisterHealthInspectorServer(peer.Server.PrivateGRPC(), peer.Inspector.Endpoint)
 	}
 
-	{ // setup mailservice
-		log.Debug("Setting up mail service")
-		// TODO(yar): test multiple satellites using same OAUTH credentials
-		mailConfig := config.Mail
-
-		// validate from mail address
-		from, err := mail.ParseAddress(mailConfig.From)
-		if err != nil {
-			return nil, errs.Combine(err, peer.Close())
-		}
-
-		// validate smtp server address
-		host, _, err := net.SplitHostPort(mailConfig.SMTPServerAddress)
-		if err != nil {
-			return nil, errs.Combine(err, peer.Close())
-		}
-
-		var sender mailservice.Sender
-		switch mailConfig.AuthType {
-		case "oauth2":
-			creds := oauth2.Credentials{
-				ClientID:     mailConfig.ClientID,
-				ClientSecret: mailConfig.ClientSecret,
-				TokenURI:     mailConfig.TokenURI,
-			}
-			token, err := oauth2.RefreshToken(context.TODO(), creds, mailConfig.RefreshToken)
-			if err != nil {
-				return nil, errs.Combine(err, peer.Close())
-			}
-
-			sender = &post.SMTPSender{
-				From: *from,
-				Auth: &oauth2.Auth{
-					UserEmail: from.Address,
-					Storage:   oauth2.NewTokenStore(creds, *token),
-				},
-				ServerAddress: mailConfig.SMTPServerAddress,
-			}
-		case "plain":
-			sender = &post.SMTPSender{
-				From:          *from,
-				Auth:          smtp.PlainAuth("", mailConfig.Login, mailConfig.Password, host),
-				ServerAddress: mailConfig.SMTPServerAddress,
-			}
-		case "login":
-			sender = &post.SMTPSender{
-				From: *from,
-				Auth: post.LoginAuth{
-					Username: mailConfig.Login,
-					Password: mailConfig.Password,
-				},
-				ServerAddress: mailConfig.SMTPServerAddress,
-			}
-		default:
-			sender = &simulate.LinkClicker{}
-		}
-
-		peer.Mail.Service, err = mailservice.New(
-			peer.Log.Named("mail:service"),
-			sender,
-			mailConfig.TemplatePath,
-		)
-
-		if err != nil {
-			return nil, errs.Combine(err, peer.Close())
-		}
-	}
-
 	{ // setup console
 		log.Debug("Setting up console")
 		consoleConfig := config.Console
@@ -624,6 +678,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.DB.Console(),
 			peer.DB.Rewards(),
 			pmService,
+			consoleConfig.Prices,
 			consoleConfig.PasswordCost,
 		)
 
@@ -683,21 +738,26 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Version.Run(ctx))
 	})
-	group.Go(func() error {
-		return errs2.IgnoreCanceled(peer.Kademlia.Service.Bootstrap(ctx))
-	})
-	group.Go(func() error {
-		return errs2.IgnoreCanceled(peer.Kademlia.Service.Run(ctx))
-	})
-	group.Go(func() error {
-		return errs2.IgnoreCanceled(peer.Discovery.Service.Run(ctx))
-	})
+	if peer.Kademlia.Service != nil {
+		group.Go(func() error {
+			return errs2.IgnoreCanceled(peer.Kademlia.Service.Bootstrap(ctx))
+		})
+		group.Go(func() error {
+			return errs2.IgnoreCanceled(peer.Kademlia.Service.Run(ctx))
+		})
+		group.Go(func() error {
+			return errs2.IgnoreCanceled(peer.Discovery.Service.Run(ctx))
+		})
+	}
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Repair.Checker.Run(ctx))
 	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Metainfo.Loop.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Metainfo.KeyUsage.Run(ctx))
+	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Repair.Repairer.Run(ctx))
 	})
@@ -707,6 +767,9 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Accounting.Rollup.Run(ctx))
 	})
+	group.Go(func() error {
+		return errs2.IgnoreCanceled(peer.Accounting.Alerts.Run(ctx))
+	})
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Audit.Service.Run(ctx))
 	})
@@ -774,7 +837,13 @@ func (peer *Peer) Close() error {
 	if peer.Repair.Checker != nil {
 		errlist.Add(peer.Repair.Checker.Close())
 	}
+	if peer.Accounting.Alerts != nil {
+		errlist.Add(peer.Accounting.Alerts.Close())
+	}
 
+	if peer.Metainfo.KeyUsage != nil {
+		errlist.Add(peer.Metainfo.KeyUsage.Close())
+	}
 	if peer.Metainfo.Database != nil {
 		errlist.Add(peer.Metainfo.Database.Close())
 	}
@@ -808,7 +877,7 @@ func (peer *Peer) Close() error {
 func (peer *Peer) ID() storj.NodeID { return peer.Identity.ID }
 
 // Local returns the peer local node info.
-func (peer *Peer) Local() overlay.NodeDossier { return peer.Kademlia.RoutingTable.Local() }
+func (peer *Peer) Local() overlay.NodeDossier { return peer.Contact.Service.Local() }
 
 // Addr returns the public address.
 func (peer *Peer) Addr() string { return peer.Server.Addr().String() }
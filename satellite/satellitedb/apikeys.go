@@ -15,13 +15,14 @@ import (
 
 // apikeys is an implementation of satellite.APIKeys
 type apikeys struct {
-	db dbx.Methods
+	methods dbx.Methods
+	db      *dbx.DB
 }
 
 // GetByProjectID implements satellite.APIKeys ordered by name
 func (keys *apikeys) GetByProjectID(ctx context.Context, projectID uuid.UUID) (_ []console.APIKeyInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
-	dbKeys, err := keys.db.All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx, dbx.ApiKey_ProjectId(projectID[:]))
+	dbKeys, err := keys.methods.All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx, dbx.ApiKey_ProjectId(projectID[:]))
 	if err != nil {
 		return nil, err
 	}
@@ -43,13 +44,63 @@ func (keys *apikeys) GetByProjectID(ctx context.Context, projectID uuid.UUID) (_
 		return nil, err
 	}
 
+	usages, err := keys.getUsageByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range apiKeys {
+		usage, ok := usages[apiKeys[i].ID]
+		if !ok {
+			continue
+		}
+
+		apiKeys[i].LastUsedAt = usage.LastUsedAt
+		apiKeys[i].RequestCount = usage.RequestCount
+		apiKeys[i].BytesUploaded = usage.BytesUploaded
+		apiKeys[i].BytesDownloaded = usage.BytesDownloaded
+	}
+
 	return apiKeys, nil
 }
 
+// getUsageByProjectID returns stored usage of project api keys
+func (keys *apikeys) getUsageByProjectID(ctx context.Context, projectID uuid.UUID) (_ map[uuid.UUID]console.APIKeyUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := keys.db.QueryContext(ctx, keys.db.Rebind(`
+		SELECT u.api_key_id, u.last_used_at, u.request_count, u.bytes_uploaded, u.bytes_downloaded
+		FROM api_key_usages u
+		JOIN api_keys k ON k.id = u.api_key_id
+		WHERE k.project_id = ?`), projectID[:])
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	usages := make(map[uuid.UUID]console.APIKeyUsage)
+	for rows.Next() {
+		var id []byte
+		var usage console.APIKeyUsage
+		err = rows.Scan(&id, &usage.LastUsedAt, &usage.RequestCount, &usage.BytesUploaded, &usage.BytesDownloaded)
+		if err != nil {
+			return nil, err
+		}
+
+		usage.APIKeyID, err = bytesToUUID(id)
+		if err != nil {
+			return nil, err
+		}
+		usages[usage.APIKeyID] = usage
+	}
+
+	return usages, rows.Err()
+}
+
 // Get implements satellite.APIKeys
 func (keys *apikeys) Get(ctx context.Context, id uuid.UUID) (_ *console.APIKeyInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
-	dbKey, err := keys.db.Get_ApiKey_By_Id(ctx, dbx.ApiKey_Id(id[:]))
+	dbKey, err := keys.methods.Get_ApiKey_By_Id(ctx, dbx.ApiKey_Id(id[:]))
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +111,7 @@ func (keys *apikeys) Get(ctx context.Context, id uuid.UUID) (_ *console.APIKeyIn
 // GetByHead implements satellite.APIKeys
 func (keys *apikeys) GetByHead(ctx context.Context, head []byte) (_ *console.APIKeyInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
-	dbKey, err := keys.db.Get_ApiKey_By_Head(ctx, dbx.ApiKey_Head(head))
+	dbKey, err := keys.methods.Get_ApiKey_By_Head(ctx, dbx.ApiKey_Head(head))
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +132,7 @@ func (keys *apikeys) Create(ctx context.Context, head []byte, info console.APIKe
 		optional.PartnerId = dbx.ApiKey_PartnerId(info.PartnerID[:])
 	}
 
-	dbKey, err := keys.db.Create_ApiKey(
+	dbKey, err := keys.methods.Create_ApiKey(
 		ctx,
 		dbx.ApiKey_Id(id[:]),
 		dbx.ApiKey_ProjectId(info.ProjectID[:]),
@@ -101,7 +152,7 @@ func (keys *apikeys) Create(ctx context.Context, head []byte, info console.APIKe
 // Update implements satellite.APIKeys
 func (keys *apikeys) Update(ctx context.Context, key console.APIKeyInfo) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	_, err = keys.db.Update_ApiKey_By_Id(
+	_, err = keys.methods.Update_ApiKey_By_Id(
 		ctx,
 		dbx.ApiKey_Id(key.ID[:]),
 		dbx.ApiKey_Update_Fields{
@@ -115,10 +166,54 @@ func (keys *apikeys) Update(ctx context.Context, key console.APIKeyInfo) (err er
 // Delete implements satellite.APIKeys
 func (keys *apikeys) Delete(ctx context.Context, id uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	_, err = keys.db.Delete_ApiKey_By_Id(ctx, dbx.ApiKey_Id(id[:]))
+	_, err = keys.methods.Delete_ApiKey_By_Id(ctx, dbx.ApiKey_Id(id[:]))
 	return err
 }
 
+// AddUsage implements satellite.APIKeys, usage of deleted keys is ignored
+func (keys *apikeys) AddUsage(ctx context.Context, usages []console.APIKeyUsage) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(usages) == 0 {
+		return nil
+	}
+
+	tx, err := keys.db.Open(ctx)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+		} else {
+			err = errs.Combine(err, tx.Rollback())
+		}
+	}()
+
+	statement := keys.db.Rebind(`
+		INSERT INTO api_key_usages (api_key_id, last_used_at, request_count, bytes_uploaded, bytes_downloaded)
+		SELECT id, ?, ?, ?, ? FROM api_keys WHERE id = ?
+		ON CONFLICT(api_key_id)
+		DO UPDATE SET
+			last_used_at = ?,
+			request_count = api_key_usages.request_count + ?,
+			bytes_uploaded = api_key_usages.bytes_uploaded + ?,
+			bytes_downloaded = api_key_usages.bytes_downloaded + ?`)
+
+	for _, usage := range usages {
+		lastUsedAt := usage.LastUsedAt.UTC()
+		_, err = tx.Tx.ExecContext(ctx, statement,
+			lastUsedAt, usage.RequestCount, usage.BytesUploaded, usage.BytesDownloaded, usage.APIKeyID[:],
+			lastUsedAt, usage.RequestCount, usage.BytesUploaded, usage.BytesDownloaded,
+		)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
 // fromDBXAPIKey converts dbx.ApiKey to satellite.APIKeyInfo
 func fromDBXAPIKey(ctx context.Context, key *dbx.ApiKey) (_ *console.APIKeyInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -38,7 +38,7 @@ func (db *ConsoleDB) ProjectMembers() console.ProjectMembers {
 
 // APIKeys is a getter for APIKeys repository
 func (db *ConsoleDB) APIKeys() console.APIKeys {
-	return &apikeys{db.methods}
+	return &apikeys{db.methods, db.db}
 }
 
 // BucketUsage is a getter for accounting.BucketUsage repository
@@ -81,6 +81,21 @@ func (db *ConsoleDB) ProjectInvoiceStamps() console.ProjectInvoiceStamps {
 	return &projectinvoicestamps{db.methods}
 }
 
+// ProjectInvoiceCharges is a getter for console.ProjectInvoiceCharges repository
+func (db *ConsoleDB) ProjectInvoiceCharges() console.ProjectInvoiceCharges {
+	return &projectinvoicecharges{db.db, db.tx}
+}
+
+// ProjectAlerts is a getter for console.ProjectAlerts repository
+func (db *ConsoleDB) ProjectAlerts() console.ProjectAlerts {
+	return &projectalerts{db.db}
+}
+
+// Revocations is a getter for console.Revocations repository
+func (db *ConsoleDB) Revocations() console.Revocations {
+	return &revocations{db.db}
+}
+
 // BeginTx is a method for opening transaction
 func (db *ConsoleDB) BeginTx(ctx context.Context) (console.DBTx, error) {
 	if db.db == nil {
@@ -504,6 +504,30 @@ CREATE TABLE bucket_metainfos (
 	PRIMARY KEY ( id ),
 	UNIQUE ( name, project_id )
 );
+CREATE TABLE project_alert_settings (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	storage_thresholds text NOT NULL,
+	bandwidth_thresholds text NOT NULL,
+	webhook_url text NOT NULL,
+	webhook_secret bytea NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_alerts (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	kind text NOT NULL,
+	threshold integer NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, kind, threshold, period_start )
+);
+CREATE TABLE project_invoice_charges (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	start_date timestamp with time zone NOT NULL,
+	credits bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, start_date )
+);
 CREATE TABLE project_invoice_stamps (
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	invoice_id bytea NOT NULL,
@@ -543,6 +567,20 @@ CREATE TABLE user_payments (
 	PRIMARY KEY ( user_id ),
 	UNIQUE ( customer_id )
 );
+CREATE TABLE api_key_revocations (
+	revoked bytea NOT NULL,
+	api_key_id bytea NOT NULL REFERENCES api_keys( id ) ON DELETE CASCADE,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( revoked )
+);
+CREATE TABLE api_key_usages (
+	api_key_id bytea NOT NULL REFERENCES api_keys( id ) ON DELETE CASCADE,
+	last_used_at timestamp with time zone NOT NULL,
+	request_count bigint NOT NULL,
+	bytes_uploaded bigint NOT NULL,
+	bytes_downloaded bigint NOT NULL,
+	PRIMARY KEY ( api_key_id )
+);
 CREATE TABLE project_payments (
 	id bytea NOT NULL,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
@@ -854,6 +892,30 @@ CREATE TABLE bucket_metainfos (
 	PRIMARY KEY ( id ),
 	UNIQUE ( name, project_id )
 );
+CREATE TABLE project_alert_settings (
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	storage_thresholds TEXT NOT NULL,
+	bandwidth_thresholds TEXT NOT NULL,
+	webhook_url TEXT NOT NULL,
+	webhook_secret BLOB NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_alerts (
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	kind TEXT NOT NULL,
+	threshold INTEGER NOT NULL,
+	period_start TIMESTAMP NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, kind, threshold, period_start )
+);
+CREATE TABLE project_invoice_charges (
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	start_date TIMESTAMP NOT NULL,
+	credits INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, start_date )
+);
 CREATE TABLE project_invoice_stamps (
 	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	invoice_id BLOB NOT NULL,
@@ -893,6 +955,20 @@ CREATE TABLE user_payments (
 	PRIMARY KEY ( user_id ),
 	UNIQUE ( customer_id )
 );
+CREATE TABLE api_key_revocations (
+	revoked BLOB NOT NULL,
+	api_key_id BLOB NOT NULL REFERENCES api_keys( id ) ON DELETE CASCADE,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( revoked )
+);
+CREATE TABLE api_key_usages (
+	api_key_id BLOB NOT NULL REFERENCES api_keys( id ) ON DELETE CASCADE,
+	last_used_at TIMESTAMP NOT NULL,
+	request_count INTEGER NOT NULL,
+	bytes_uploaded INTEGER NOT NULL,
+	bytes_downloaded INTEGER NOT NULL,
+	PRIMARY KEY ( api_key_id )
+);
 CREATE TABLE project_payments (
 	id BLOB NOT NULL,
 	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
@@ -4695,6 +4771,334 @@ func (BucketMetainfo_DefaultRedundancyTotalShares_Field) _Column() string {
 	return "default_redundancy_total_shares"
 }
 
+type ProjectAlertSetting struct {
+	ProjectId           []byte
+	StorageThresholds   string
+	BandwidthThresholds string
+	WebhookUrl          string
+	WebhookSecret       []byte
+	UpdatedAt           time.Time
+}
+
+func (ProjectAlertSetting) _Table() string { return "project_alert_settings" }
+
+type ProjectAlertSetting_Update_Fields struct {
+	StorageThresholds   ProjectAlertSetting_StorageThresholds_Field
+	BandwidthThresholds ProjectAlertSetting_BandwidthThresholds_Field
+	WebhookUrl          ProjectAlertSetting_WebhookUrl_Field
+	WebhookSecret       ProjectAlertSetting_WebhookSecret_Field
+}
+
+type ProjectAlertSetting_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectAlertSetting_ProjectId(v []byte) ProjectAlertSetting_ProjectId_Field {
+	return ProjectAlertSetting_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectAlertSetting_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlertSetting_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectAlertSetting_StorageThresholds_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ProjectAlertSetting_StorageThresholds(v string) ProjectAlertSetting_StorageThresholds_Field {
+	return ProjectAlertSetting_StorageThresholds_Field{_set: true, _value: v}
+}
+
+func (f ProjectAlertSetting_StorageThresholds_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlertSetting_StorageThresholds_Field) _Column() string { return "storage_thresholds" }
+
+type ProjectAlertSetting_BandwidthThresholds_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ProjectAlertSetting_BandwidthThresholds(v string) ProjectAlertSetting_BandwidthThresholds_Field {
+	return ProjectAlertSetting_BandwidthThresholds_Field{_set: true, _value: v}
+}
+
+func (f ProjectAlertSetting_BandwidthThresholds_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlertSetting_BandwidthThresholds_Field) _Column() string { return "bandwidth_thresholds" }
+
+type ProjectAlertSetting_WebhookUrl_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ProjectAlertSetting_WebhookUrl(v string) ProjectAlertSetting_WebhookUrl_Field {
+	return ProjectAlertSetting_WebhookUrl_Field{_set: true, _value: v}
+}
+
+func (f ProjectAlertSetting_WebhookUrl_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlertSetting_WebhookUrl_Field) _Column() string { return "webhook_url" }
+
+type ProjectAlertSetting_WebhookSecret_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectAlertSetting_WebhookSecret(v []byte) ProjectAlertSetting_WebhookSecret_Field {
+	return ProjectAlertSetting_WebhookSecret_Field{_set: true, _value: v}
+}
+
+func (f ProjectAlertSetting_WebhookSecret_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlertSetting_WebhookSecret_Field) _Column() string { return "webhook_secret" }
+
+type ProjectAlertSetting_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectAlertSetting_UpdatedAt(v time.Time) ProjectAlertSetting_UpdatedAt_Field {
+	return ProjectAlertSetting_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectAlertSetting_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlertSetting_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type ProjectAlert struct {
+	ProjectId   []byte
+	Kind        string
+	Threshold   int
+	PeriodStart time.Time
+	CreatedAt   time.Time
+}
+
+func (ProjectAlert) _Table() string { return "project_alerts" }
+
+type ProjectAlert_Update_Fields struct {
+}
+
+type ProjectAlert_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectAlert_ProjectId(v []byte) ProjectAlert_ProjectId_Field {
+	return ProjectAlert_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectAlert_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlert_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectAlert_Kind_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ProjectAlert_Kind(v string) ProjectAlert_Kind_Field {
+	return ProjectAlert_Kind_Field{_set: true, _value: v}
+}
+
+func (f ProjectAlert_Kind_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlert_Kind_Field) _Column() string { return "kind" }
+
+type ProjectAlert_Threshold_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func ProjectAlert_Threshold(v int) ProjectAlert_Threshold_Field {
+	return ProjectAlert_Threshold_Field{_set: true, _value: v}
+}
+
+func (f ProjectAlert_Threshold_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlert_Threshold_Field) _Column() string { return "threshold" }
+
+type ProjectAlert_PeriodStart_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectAlert_PeriodStart(v time.Time) ProjectAlert_PeriodStart_Field {
+	return ProjectAlert_PeriodStart_Field{_set: true, _value: v}
+}
+
+func (f ProjectAlert_PeriodStart_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlert_PeriodStart_Field) _Column() string { return "period_start" }
+
+type ProjectAlert_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectAlert_CreatedAt(v time.Time) ProjectAlert_CreatedAt_Field {
+	return ProjectAlert_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectAlert_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlert_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ProjectInvoiceCharge struct {
+	ProjectId []byte
+	StartDate time.Time
+	Credits   int64
+	CreatedAt time.Time
+}
+
+func (ProjectInvoiceCharge) _Table() string { return "project_invoice_charges" }
+
+type ProjectInvoiceCharge_Update_Fields struct {
+}
+
+type ProjectInvoiceCharge_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectInvoiceCharge_ProjectId(v []byte) ProjectInvoiceCharge_ProjectId_Field {
+	return ProjectInvoiceCharge_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvoiceCharge_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvoiceCharge_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectInvoiceCharge_StartDate_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectInvoiceCharge_StartDate(v time.Time) ProjectInvoiceCharge_StartDate_Field {
+	return ProjectInvoiceCharge_StartDate_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvoiceCharge_StartDate_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvoiceCharge_StartDate_Field) _Column() string { return "start_date" }
+
+type ProjectInvoiceCharge_Credits_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func ProjectInvoiceCharge_Credits(v int64) ProjectInvoiceCharge_Credits_Field {
+	return ProjectInvoiceCharge_Credits_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvoiceCharge_Credits_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvoiceCharge_Credits_Field) _Column() string { return "credits" }
+
+type ProjectInvoiceCharge_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectInvoiceCharge_CreatedAt(v time.Time) ProjectInvoiceCharge_CreatedAt_Field {
+	return ProjectInvoiceCharge_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvoiceCharge_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvoiceCharge_CreatedAt_Field) _Column() string { return "created_at" }
+
 type ProjectInvoiceStamp struct {
 	ProjectId []byte
 	InvoiceId []byte
@@ -5194,6 +5598,186 @@ func (f UserPayment_CreatedAt_Field) value() interface{} {
 
 func (UserPayment_CreatedAt_Field) _Column() string { return "created_at" }
 
+type ApiKeyRevocation struct {
+	Revoked   []byte
+	ApiKeyId  []byte
+	CreatedAt time.Time
+}
+
+func (ApiKeyRevocation) _Table() string { return "api_key_revocations" }
+
+type ApiKeyRevocation_Update_Fields struct {
+}
+
+type ApiKeyRevocation_Revoked_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKeyRevocation_Revoked(v []byte) ApiKeyRevocation_Revoked_Field {
+	return ApiKeyRevocation_Revoked_Field{_set: true, _value: v}
+}
+
+func (f ApiKeyRevocation_Revoked_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKeyRevocation_Revoked_Field) _Column() string { return "revoked" }
+
+type ApiKeyRevocation_ApiKeyId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKeyRevocation_ApiKeyId(v []byte) ApiKeyRevocation_ApiKeyId_Field {
+	return ApiKeyRevocation_ApiKeyId_Field{_set: true, _value: v}
+}
+
+func (f ApiKeyRevocation_ApiKeyId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKeyRevocation_ApiKeyId_Field) _Column() string { return "api_key_id" }
+
+type ApiKeyRevocation_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ApiKeyRevocation_CreatedAt(v time.Time) ApiKeyRevocation_CreatedAt_Field {
+	return ApiKeyRevocation_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ApiKeyRevocation_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKeyRevocation_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ApiKeyUsage struct {
+	ApiKeyId        []byte
+	LastUsedAt      time.Time
+	RequestCount    int64
+	BytesUploaded   int64
+	BytesDownloaded int64
+}
+
+func (ApiKeyUsage) _Table() string { return "api_key_usages" }
+
+type ApiKeyUsage_Update_Fields struct {
+	LastUsedAt      ApiKeyUsage_LastUsedAt_Field
+	RequestCount    ApiKeyUsage_RequestCount_Field
+	BytesUploaded   ApiKeyUsage_BytesUploaded_Field
+	BytesDownloaded ApiKeyUsage_BytesDownloaded_Field
+}
+
+type ApiKeyUsage_ApiKeyId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ApiKeyUsage_ApiKeyId(v []byte) ApiKeyUsage_ApiKeyId_Field {
+	return ApiKeyUsage_ApiKeyId_Field{_set: true, _value: v}
+}
+
+func (f ApiKeyUsage_ApiKeyId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKeyUsage_ApiKeyId_Field) _Column() string { return "api_key_id" }
+
+type ApiKeyUsage_LastUsedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ApiKeyUsage_LastUsedAt(v time.Time) ApiKeyUsage_LastUsedAt_Field {
+	return ApiKeyUsage_LastUsedAt_Field{_set: true, _value: v}
+}
+
+func (f ApiKeyUsage_LastUsedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKeyUsage_LastUsedAt_Field) _Column() string { return "last_used_at" }
+
+type ApiKeyUsage_RequestCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func ApiKeyUsage_RequestCount(v int64) ApiKeyUsage_RequestCount_Field {
+	return ApiKeyUsage_RequestCount_Field{_set: true, _value: v}
+}
+
+func (f ApiKeyUsage_RequestCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKeyUsage_RequestCount_Field) _Column() string { return "request_count" }
+
+type ApiKeyUsage_BytesUploaded_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func ApiKeyUsage_BytesUploaded(v int64) ApiKeyUsage_BytesUploaded_Field {
+	return ApiKeyUsage_BytesUploaded_Field{_set: true, _value: v}
+}
+
+func (f ApiKeyUsage_BytesUploaded_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKeyUsage_BytesUploaded_Field) _Column() string { return "bytes_uploaded" }
+
+type ApiKeyUsage_BytesDownloaded_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func ApiKeyUsage_BytesDownloaded(v int64) ApiKeyUsage_BytesDownloaded_Field {
+	return ApiKeyUsage_BytesDownloaded_Field{_set: true, _value: v}
+}
+
+func (f ApiKeyUsage_BytesDownloaded_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ApiKeyUsage_BytesDownloaded_Field) _Column() string { return "bytes_downloaded" }
+
 type ProjectPayment struct {
 	Id              []byte
 	ProjectId       []byte
@@ -9097,6 +9681,26 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM api_key_usages;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM api_key_revocations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -9137,6 +9741,16 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_invoice_charges;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -9147,6 +9761,26 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_alert_settings;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -13532,6 +14166,26 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM api_key_usages;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM api_key_revocations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -13572,6 +14226,16 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_invoice_charges;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -13582,6 +14246,26 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_alert_settings;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -232,6 +232,30 @@ CREATE TABLE bucket_metainfos (
 	PRIMARY KEY ( id ),
 	UNIQUE ( name, project_id )
 );
+CREATE TABLE project_alert_settings (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	storage_thresholds text NOT NULL,
+	bandwidth_thresholds text NOT NULL,
+	webhook_url text NOT NULL,
+	webhook_secret bytea NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_alerts (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	kind text NOT NULL,
+	threshold integer NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, kind, threshold, period_start )
+);
+CREATE TABLE project_invoice_charges (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	start_date timestamp with time zone NOT NULL,
+	credits bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, start_date )
+);
 CREATE TABLE project_invoice_stamps (
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	invoice_id bytea NOT NULL,
@@ -271,6 +295,20 @@ CREATE TABLE user_payments (
 	PRIMARY KEY ( user_id ),
 	UNIQUE ( customer_id )
 );
+CREATE TABLE api_key_revocations (
+	revoked bytea NOT NULL,
+	api_key_id bytea NOT NULL REFERENCES api_keys( id ) ON DELETE CASCADE,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( revoked )
+);
+CREATE TABLE api_key_usages (
+	api_key_id bytea NOT NULL REFERENCES api_keys( id ) ON DELETE CASCADE,
+	last_used_at timestamp with time zone NOT NULL,
+	request_count bigint NOT NULL,
+	bytes_uploaded bigint NOT NULL,
+	bytes_downloaded bigint NOT NULL,
+	PRIMARY KEY ( api_key_id )
+);
 CREATE TABLE project_payments (
 	id bytea NOT NULL,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
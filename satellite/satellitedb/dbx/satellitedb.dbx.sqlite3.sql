@@ -232,6 +232,30 @@ CREATE TABLE bucket_metainfos (
 	PRIMARY KEY ( id ),
 	UNIQUE ( name, project_id )
 );
+CREATE TABLE project_alert_settings (
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	storage_thresholds TEXT NOT NULL,
+	bandwidth_thresholds TEXT NOT NULL,
+	webhook_url TEXT NOT NULL,
+	webhook_secret BLOB NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_alerts (
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	kind TEXT NOT NULL,
+	threshold INTEGER NOT NULL,
+	period_start TIMESTAMP NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, kind, threshold, period_start )
+);
+CREATE TABLE project_invoice_charges (
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	start_date TIMESTAMP NOT NULL,
+	credits INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, start_date )
+);
 CREATE TABLE project_invoice_stamps (
 	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	invoice_id BLOB NOT NULL,
@@ -271,6 +295,20 @@ CREATE TABLE user_payments (
 	PRIMARY KEY ( user_id ),
 	UNIQUE ( customer_id )
 );
+CREATE TABLE api_key_revocations (
+	revoked BLOB NOT NULL,
+	api_key_id BLOB NOT NULL REFERENCES api_keys( id ) ON DELETE CASCADE,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( revoked )
+);
+CREATE TABLE api_key_usages (
+	api_key_id BLOB NOT NULL REFERENCES api_keys( id ) ON DELETE CASCADE,
+	last_used_at TIMESTAMP NOT NULL,
+	request_count INTEGER NOT NULL,
+	bytes_uploaded INTEGER NOT NULL,
+	bytes_downloaded INTEGER NOT NULL,
+	PRIMARY KEY ( api_key_id )
+);
 CREATE TABLE project_payments (
 	id BLOB NOT NULL,
 	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
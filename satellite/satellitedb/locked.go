@@ -156,6 +156,13 @@ type lockedAPIKeys struct {
 	db console.APIKeys
 }
 
+// AddUsage adds usage deltas to the stored usage of api keys
+func (m *lockedAPIKeys) AddUsage(ctx context.Context, usages []console.APIKeyUsage) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.AddUsage(ctx, usages)
+}
+
 // Create creates and stores new APIKeyInfo
 func (m *lockedAPIKeys) Create(ctx context.Context, head []byte, info console.APIKeyInfo) (*console.APIKeyInfo, error) {
 	m.Lock()
@@ -235,6 +242,81 @@ func (m *lockedBucketUsage) GetPaged(ctx context.Context, cursor *accounting.Buc
 	return m.db.GetPaged(ctx, cursor)
 }
 
+// ProjectAlerts is a getter for ProjectAlerts repository
+func (m *lockedConsole) ProjectAlerts() console.ProjectAlerts {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedProjectAlerts{m.Locker, m.db.ProjectAlerts()}
+}
+
+// lockedProjectAlerts implements locking wrapper for console.ProjectAlerts
+type lockedProjectAlerts struct {
+	sync.Locker
+	db console.ProjectAlerts
+}
+
+// ClearSent removes the alert record, so that the alert can be sent again
+func (m *lockedProjectAlerts) ClearSent(ctx context.Context, alert console.ProjectAlert) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ClearSent(ctx, alert)
+}
+
+// GetSettings returns alert settings of the project
+func (m *lockedProjectAlerts) GetSettings(ctx context.Context, projectID uuid.UUID) (*console.ProjectAlertSettings, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetSettings(ctx, projectID)
+}
+
+// MarkSent records the alert, it returns false when the alert has been already recorded
+func (m *lockedProjectAlerts) MarkSent(ctx context.Context, alert console.ProjectAlert) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.MarkSent(ctx, alert)
+}
+
+// UpsertSettings creates or updates alert settings of the project
+func (m *lockedProjectAlerts) UpsertSettings(ctx context.Context, settings console.ProjectAlertSettings) (*console.ProjectAlertSettings, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpsertSettings(ctx, settings)
+}
+
+// ProjectInvoiceCharges is a getter for ProjectInvoiceCharges repository
+func (m *lockedConsole) ProjectInvoiceCharges() console.ProjectInvoiceCharges {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedProjectInvoiceCharges{m.Locker, m.db.ProjectInvoiceCharges()}
+}
+
+// lockedProjectInvoiceCharges implements locking wrapper for console.ProjectInvoiceCharges
+type lockedProjectInvoiceCharges struct {
+	sync.Locker
+	db console.ProjectInvoiceCharges
+}
+
+// Create records credits charged for a project invoice that is not created yet
+func (m *lockedProjectInvoiceCharges) Create(ctx context.Context, charge console.ProjectInvoiceCharge) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Create(ctx, charge)
+}
+
+// Delete removes the pending charge once the invoice stamp has been created
+func (m *lockedProjectInvoiceCharges) Delete(ctx context.Context, projectID uuid.UUID, startDate time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, projectID, startDate)
+}
+
+// Get returns the pending charge of the project for the billing period, sql.ErrNoRows when there is none
+func (m *lockedProjectInvoiceCharges) Get(ctx context.Context, projectID uuid.UUID, startDate time.Time) (*console.ProjectInvoiceCharge, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, projectID, startDate)
+}
+
 // ProjectInvoiceStamps is a getter for ProjectInvoiceStamps repository
 func (m *lockedConsole) ProjectInvoiceStamps() console.ProjectInvoiceStamps {
 	m.Lock()
@@ -506,6 +588,33 @@ func (m *lockedResetPasswordTokens) GetBySecret(ctx context.Context, secret cons
 	return m.db.GetBySecret(ctx, secret)
 }
 
+// Revocations is a getter for Revocations repository
+func (m *lockedConsole) Revocations() console.Revocations {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedRevocations{m.Locker, m.db.Revocations()}
+}
+
+// lockedRevocations implements locking wrapper for console.Revocations
+type lockedRevocations struct {
+	sync.Locker
+	db console.Revocations
+}
+
+// GetByProjectID returns the revoked tails of all api keys of the project
+func (m *lockedRevocations) GetByProjectID(ctx context.Context, projectID uuid.UUID) ([][]byte, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByProjectID(ctx, projectID)
+}
+
+// Revoke stores the tail of a key derived from the given api key as revoked
+func (m *lockedRevocations) Revoke(ctx context.Context, tail []byte, apiKeyID uuid.UUID) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Revoke(ctx, tail, apiKeyID)
+}
+
 // UsageRollups is a getter for UsageRollups repository
 func (m *lockedConsole) UsageRollups() console.UsageRollups {
 	m.Lock()
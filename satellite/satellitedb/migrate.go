@@ -1141,6 +1141,68 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					);`,
 				},
 			},
+			{
+				Description: "Add project alert settings and project alerts tables",
+				Version:     55,
+				Action: migrate.SQL{
+					`CREATE TABLE project_alert_settings (
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						storage_thresholds text NOT NULL,
+						bandwidth_thresholds text NOT NULL,
+						webhook_url text NOT NULL,
+						webhook_secret bytea NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id )
+					);`,
+					`CREATE TABLE project_alerts (
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						kind text NOT NULL,
+						threshold integer NOT NULL,
+						period_start timestamp with time zone NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, kind, threshold, period_start )
+					);`,
+				},
+			},
+			{
+				Description: "Add api key usages table",
+				Version:     56,
+				Action: migrate.SQL{
+					`CREATE TABLE api_key_usages (
+						api_key_id bytea NOT NULL REFERENCES api_keys( id ) ON DELETE CASCADE,
+						last_used_at timestamp with time zone NOT NULL,
+						request_count bigint NOT NULL,
+						bytes_uploaded bigint NOT NULL,
+						bytes_downloaded bigint NOT NULL,
+						PRIMARY KEY ( api_key_id )
+					);`,
+				},
+			},
+			{
+				Description: "Add api key revocations table",
+				Version:     57,
+				Action: migrate.SQL{
+					`CREATE TABLE api_key_revocations (
+						revoked bytea NOT NULL,
+						api_key_id bytea NOT NULL REFERENCES api_keys( id ) ON DELETE CASCADE,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( revoked )
+					);`,
+				},
+			},
+			{
+				Description: "Add project invoice charges table",
+				Version:     58,
+				Action: migrate.SQL{
+					`CREATE TABLE project_invoice_charges (
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						start_date timestamp with time zone NOT NULL,
+						credits bigint NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, start_date )
+					);`,
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,141 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// projectalerts implements console.ProjectAlerts
+type projectalerts struct {
+	db *dbx.DB
+}
+
+// GetSettings returns alert settings of the project
+func (db *projectalerts) GetSettings(ctx context.Context, projectID uuid.UUID) (_ *console.ProjectAlertSettings, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := db.db.QueryRowContext(ctx, db.db.Rebind(`
+		SELECT storage_thresholds, bandwidth_thresholds, webhook_url, webhook_secret, updated_at
+		FROM project_alert_settings
+		WHERE project_id = ?`), projectID[:])
+
+	var storage, bandwidth string
+	settings := &console.ProjectAlertSettings{ProjectID: projectID}
+	err = row.Scan(&storage, &bandwidth, &settings.WebhookURL, &settings.WebhookSecret, &settings.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, console.ErrAlertSettingsNotFound.New("%s", projectID)
+	}
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	settings.StorageThresholds, err = parseThresholds(storage)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	settings.BandwidthThresholds, err = parseThresholds(bandwidth)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return settings, nil
+}
+
+// UpsertSettings creates or updates alert settings of the project
+func (db *projectalerts) UpsertSettings(ctx context.Context, settings console.ProjectAlertSettings) (_ *console.ProjectAlertSettings, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	settings.UpdatedAt = time.Now().UTC()
+	if settings.WebhookSecret == nil {
+		settings.WebhookSecret = []byte{}
+	}
+
+	storage := formatThresholds(settings.StorageThresholds)
+	bandwidth := formatThresholds(settings.BandwidthThresholds)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		INSERT INTO project_alert_settings (project_id, storage_thresholds, bandwidth_thresholds, webhook_url, webhook_secret, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(project_id)
+		DO UPDATE SET storage_thresholds = ?, bandwidth_thresholds = ?, webhook_url = ?, webhook_secret = ?, updated_at = ?`),
+		settings.ProjectID[:], storage, bandwidth, settings.WebhookURL, settings.WebhookSecret, settings.UpdatedAt,
+		storage, bandwidth, settings.WebhookURL, settings.WebhookSecret, settings.UpdatedAt,
+	)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return &settings, nil
+}
+
+// MarkSent records the alert, it returns false when the alert has been already recorded
+func (db *projectalerts) MarkSent(ctx context.Context, alert console.ProjectAlert) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, db.db.Rebind(`
+		INSERT INTO project_alerts (project_id, kind, threshold, period_start, created_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT DO NOTHING`),
+		alert.ProjectID[:], string(alert.Kind), alert.Threshold, alert.PeriodStart.UTC(), time.Now().UTC(),
+	)
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+
+	return inserted > 0, nil
+}
+
+// ClearSent removes the alert record, so that the alert can be sent again
+func (db *projectalerts) ClearSent(ctx context.Context, alert console.ProjectAlert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		DELETE FROM project_alerts
+		WHERE project_id = ? AND kind = ? AND threshold = ? AND period_start = ?`),
+		alert.ProjectID[:], string(alert.Kind), alert.Threshold, alert.PeriodStart.UTC(),
+	)
+	return errs.Wrap(err)
+}
+
+// formatThresholds converts thresholds into comma separated list
+func formatThresholds(thresholds []int) string {
+	values := make([]string, 0, len(thresholds))
+	for _, threshold := range thresholds {
+		values = append(values, strconv.Itoa(threshold))
+	}
+	return strings.Join(values, ",")
+}
+
+// parseThresholds parses comma separated list of thresholds
+func parseThresholds(value string) ([]int, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var thresholds []int
+	for _, field := range strings.Split(value, ",") {
+		threshold, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, err
+		}
+		thresholds = append(thresholds, threshold)
+	}
+	return thresholds, nil
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type projectinvoicecharges struct {
+	db *dbx.DB
+	tx *dbx.Tx
+}
+
+// dbQueryer is implemented by both *sql.DB and *sql.Tx
+type dbQueryer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+func (charges *projectinvoicecharges) queryer() dbQueryer {
+	if charges.tx != nil {
+		return charges.tx.Tx
+	}
+	return charges.db.DB
+}
+
+// Create records credits charged for a project invoice that is not created yet
+func (charges *projectinvoicecharges) Create(ctx context.Context, charge console.ProjectInvoiceCharge) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = charges.queryer().ExecContext(ctx, charges.db.Rebind(`
+		INSERT INTO project_invoice_charges (project_id, start_date, credits, created_at)
+		VALUES (?, ?, ?, ?)`),
+		charge.ProjectID[:], charge.StartDate.UTC(), charge.Credits, charge.CreatedAt.UTC(),
+	)
+	return errs.Wrap(err)
+}
+
+// Get returns the pending charge of the project for the billing period
+func (charges *projectinvoicecharges) Get(ctx context.Context, projectID uuid.UUID, startDate time.Time) (_ *console.ProjectInvoiceCharge, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	charge := console.ProjectInvoiceCharge{
+		ProjectID: projectID,
+	}
+
+	err = charges.queryer().QueryRowContext(ctx, charges.db.Rebind(`
+		SELECT start_date, credits, created_at FROM project_invoice_charges
+		WHERE project_id = ? AND start_date = ?`),
+		projectID[:], startDate.UTC(),
+	).Scan(&charge.StartDate, &charge.Credits, &charge.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return &charge, nil
+}
+
+// Delete removes the pending charge once the invoice stamp has been created
+func (charges *projectinvoicecharges) Delete(ctx context.Context, projectID uuid.UUID, startDate time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = charges.queryer().ExecContext(ctx, charges.db.Rebind(`
+		DELETE FROM project_invoice_charges
+		WHERE project_id = ? AND start_date = ?`),
+		projectID[:], startDate.UTC(),
+	)
+	return errs.Wrap(err)
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// revocations implements console.Revocations
+type revocations struct {
+	db *dbx.DB
+}
+
+// Revoke stores the tail of a key derived from the given api key as revoked
+func (db *revocations) Revoke(ctx context.Context, tail []byte, apiKeyID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		INSERT INTO api_key_revocations (revoked, api_key_id, created_at)
+		VALUES (?, ?, ?)
+		ON CONFLICT(revoked) DO NOTHING`), tail, apiKeyID[:], time.Now().UTC())
+	return errs.Wrap(err)
+}
+
+// GetByProjectID returns the revoked tails of all api keys of the project
+func (db *revocations) GetByProjectID(ctx context.Context, projectID uuid.UUID) (_ [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT api_key_revocations.revoked
+		FROM api_key_revocations
+		JOIN api_keys ON api_keys.id = api_key_revocations.api_key_id
+		WHERE api_keys.project_id = ?`), projectID[:])
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var revoked [][]byte
+	for rows.Next() {
+		var tail []byte
+		if err := rows.Scan(&tail); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		revoked = append(revoked, tail)
+	}
+
+	return revoked, errs.Wrap(rows.Err())
+}
@@ -26,8 +26,18 @@ type usercredits struct {
 }
 
 // GetCreditUsage returns the total amount of referral a user has made based on user id, total available credits, and total used credits based on user id
-func (c *usercredits) GetCreditUsage(ctx context.Context, userID uuid.UUID, expirationEndDate time.Time) (*console.UserCreditUsage, error) {
-	usageRows, err := c.db.DB.QueryContext(ctx, c.db.Rebind(`SELECT a.used_credit, b.available_credit, c.referred
+func (c *usercredits) GetCreditUsage(ctx context.Context, userID uuid.UUID, expirationEndDate time.Time) (_ *console.UserCreditUsage, err error) {
+	var dbQuery interface {
+		QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	}
+
+	if c.tx != nil {
+		dbQuery = c.tx.Tx
+	} else {
+		dbQuery = c.db.DB
+	}
+
+	usageRows, err := dbQuery.QueryContext(ctx, c.db.Rebind(`SELECT a.used_credit, b.available_credit, c.referred
 		FROM (SELECT SUM(credits_used_in_cents) AS used_credit FROM user_credits WHERE user_id = ?) AS a,
 		(SELECT SUM(credits_earned_in_cents - credits_used_in_cents) AS available_credit FROM user_credits WHERE expires_at > ? AND user_id = ?) AS b,
 		(SELECT count(id) AS referred FROM user_credits WHERE user_credits.user_id = ? AND user_credits.type = ?) AS c;`), userID[:], expirationEndDate, userID[:], userID[:], console.Referrer)
@@ -173,22 +183,36 @@ func (c *usercredits) UpdateEarnedCredits(ctx context.Context, userID uuid.UUID)
 	return nil
 }
 
-// UpdateAvailableCredits updates user's available credits based on their spending and the time of their spending
+// UpdateAvailableCredits updates user's available credits based on their spending and the time of their spending.
+// When called in a transaction scope the credits are only used up once the transaction is committed.
 func (c *usercredits) UpdateAvailableCredits(ctx context.Context, creditsToCharge int, id uuid.UUID, expirationEndDate time.Time) (remainingCharge int, err error) {
+	if c.tx != nil {
+		return c.updateAvailableCredits(ctx, c.tx, creditsToCharge, id, expirationEndDate)
+	}
+
 	tx, err := c.db.Open(ctx)
 	if err != nil {
 		return creditsToCharge, errs.Wrap(err)
 	}
 
+	remainingCharge, err = c.updateAvailableCredits(ctx, tx, creditsToCharge, id, expirationEndDate)
+	if err != nil {
+		return creditsToCharge, errs.Combine(err, tx.Rollback())
+	}
+	return remainingCharge, errs.Wrap(tx.Commit())
+}
+
+// updateAvailableCredits charges the available credits in tx
+func (c *usercredits) updateAvailableCredits(ctx context.Context, tx *dbx.Tx, creditsToCharge int, id uuid.UUID, expirationEndDate time.Time) (remainingCharge int, err error) {
 	availableCredits, err := tx.All_UserCredit_By_UserId_And_ExpiresAt_Greater_And_CreditsUsedInCents_Less_CreditsEarnedInCents_OrderBy_Asc_ExpiresAt(ctx,
 		dbx.UserCredit_UserId(id[:]),
 		dbx.UserCredit_ExpiresAt(expirationEndDate),
 	)
 	if err != nil {
-		return creditsToCharge, errs.Wrap(errs.Combine(err, tx.Rollback()))
+		return creditsToCharge, errs.Wrap(err)
 	}
 	if len(availableCredits) == 0 {
-		return creditsToCharge, errs.Combine(errs.New("No available credits"), tx.Commit())
+		return creditsToCharge, errs.New("No available credits")
 	}
 
 	values := make([]interface{}, len(availableCredits)*2)
@@ -228,9 +252,9 @@ func (c *usercredits) UpdateAvailableCredits(ctx context.Context, creditsToCharg
 	_, err = tx.Tx.ExecContext(ctx, c.db.Rebind(`UPDATE user_credits SET
 			credits_used_in_cents = CASE `+statement), values...)
 	if err != nil {
-		return creditsToCharge, errs.Wrap(errs.Combine(err, tx.Rollback()))
+		return creditsToCharge, errs.Wrap(err)
 	}
-	return remainingCharge, errs.Wrap(tx.Commit())
+	return remainingCharge, nil
 }
 
 func generateQuery(totalRows int, toInt bool) (query string) {
@@ -1,19 +1,22 @@
 // Copyright (C) 2019 Storj Labs, Inc.
 // See LICENSE for copying information.
 
-// Package collector implements expired piece deletion from storage node.
+// Package collector implements expired piece deletion from storage node. It
+// deletes the pieces of untrusted satellites as well.
 package collector
 
 import (
 	"context"
 	"time"
 
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/trust"
 )
 
 var mon = monkit.Package()
@@ -21,6 +24,9 @@ var mon = monkit.Package()
 // Config defines parameters for storage node Collector.
 type Config struct {
 	Interval time.Duration `help:"how frequently expired pieces are collected" default:"1h0m0s"`
+	// UntrustedGracePeriod is how long the pieces of a satellite are kept
+	// after it isn't trusted anymore
+	UntrustedGracePeriod time.Duration `help:"how long after a satellite isn't trusted anymore its pieces are deleted, 0 keeps them" default:"0s"`
 }
 
 // Service implements collecting expired pieces on the storage node.
@@ -28,17 +34,24 @@ type Service struct {
 	log         *zap.Logger
 	pieces      *pieces.Store
 	usedSerials piecestore.UsedSerials
+	trust       *trust.Pool
+
+	untrustedGracePeriod time.Duration
 
 	Loop sync2.Cycle
 }
 
 // NewService creates a new collector service.
-func NewService(log *zap.Logger, pieces *pieces.Store, usedSerials piecestore.UsedSerials, config Config) *Service {
+func NewService(log *zap.Logger, pieces *pieces.Store, usedSerials piecestore.UsedSerials, trust *trust.Pool, config Config) *Service {
 	return &Service{
 		log:         log,
 		pieces:      pieces,
 		usedSerials: usedSerials,
-		Loop:        *sync2.NewCycle(config.Interval),
+		trust:       trust,
+
+		untrustedGracePeriod: config.UntrustedGracePeriod,
+
+		Loop: *sync2.NewCycle(config.Interval),
 	}
 }
 
@@ -51,6 +64,12 @@ func (service *Service) Run(ctx context.Context) (err error) {
 		if err != nil {
 			service.log.Error("error during collecting pieces: ", zap.Error(err))
 		}
+		if service.untrustedGracePeriod > 0 {
+			err = service.CollectUntrusted(ctx, time.Now())
+			if err != nil {
+				service.log.Error("error during collecting pieces of untrusted satellites: ", zap.Error(err))
+			}
+		}
 		return nil
 	})
 }
@@ -105,3 +124,40 @@ func (service *Service) Collect(ctx context.Context, now time.Time) (err error)
 
 	return nil
 }
+
+// CollectUntrusted deletes all pieces of the satellites which haven't been
+// trusted for the grace period by now. The satellites are forgotten after
+// their pieces were deleted.
+func (service *Service) CollectUntrusted(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for satellite, since := range service.trust.GetUntrusted(ctx) {
+		if now.Sub(since) < service.untrustedGracePeriod {
+			continue
+		}
+
+		var count, failed int64
+		err := service.pieces.WalkSatellitePieces(ctx, satellite, func(access pieces.StoredPieceAccess) error {
+			if err := service.pieces.Delete(ctx, satellite, access.PieceID()); err != nil {
+				service.log.Error("unable to delete piece", zap.Stringer("satellite id", satellite), zap.Stringer("piece id", access.PieceID()), zap.Error(err))
+				failed++
+				return nil
+			}
+			count++
+			return nil
+		})
+		if err != nil {
+			group.Add(err)
+			continue
+		}
+
+		service.log.Info("deleted pieces of untrusted satellite", zap.Stringer("satellite id", satellite), zap.Int64("count", count))
+		if failed > 0 {
+			// try again next time
+			continue
+		}
+		group.Add(service.trust.Forget(ctx, satellite))
+	}
+	return group.Err()
+}
@@ -4,6 +4,8 @@
 package collector_test
 
 import (
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/testrand"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode"
 	"storj.io/storj/uplink"
 )
 
@@ -125,3 +128,84 @@ func TestCollector(t *testing.T) {
 		require.Equal(t, 0, serialsPresent)
 	})
 }
+
+func TestCollectUntrusted(t *testing.T) {
+	// lists are the trust lists of the storage nodes
+	lists := make([]string, 4)
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				lists[index] = filepath.Join(filepath.Dir(config.Trust.CachePath), "trusted.txt")
+				config.Trust.Sources = lists[index]
+				config.Collector.UntrustedGracePeriod = time.Hour
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		trustSatellites := func(urls ...storj.NodeURL) {
+			for i, storageNode := range planet.StorageNodes {
+				// stop collector, so we can run it manually
+				storageNode.Collector.Loop.Pause()
+
+				list := storj.NodeURLs(urls).String()
+				require.NoError(t, ioutil.WriteFile(lists[i], []byte(list+"\n"), 0644))
+				require.NoError(t, storageNode.Storage2.Trust.Refresh(ctx))
+			}
+		}
+		trustSatellites(satellite.URL())
+
+		// the satellite wasn't trusted when the planet was started
+		planet.Reconnect(ctx)
+		satellite.Discovery.Service.Refresh.TriggerWait()
+
+		expectedData := testrand.Bytes(100 * memory.KiB)
+		err := planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "test/path", expectedData)
+		require.NoError(t, err)
+
+		trustSatellites()
+
+		// new uploads are refused, but the pieces can still be downloaded
+		err = planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "test/other", expectedData)
+		require.Error(t, err)
+
+		data, err := planet.Uplinks[0].Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+		require.Equal(t, expectedData, data)
+
+		deleted := 0
+		for _, storageNode := range planet.StorageNodes {
+			pieceStore := storageNode.DB.Pieces()
+
+			used, err := pieceStore.SpaceUsed(ctx)
+			require.NoError(t, err)
+
+			// the pieces are kept during the grace period
+			err = storageNode.Collector.CollectUntrusted(ctx, time.Now())
+			require.NoError(t, err)
+
+			usedDuringGracePeriod, err := pieceStore.SpaceUsed(ctx)
+			require.NoError(t, err)
+			require.Equal(t, used, usedDuringGracePeriod)
+
+			// and deleted after it
+			err = storageNode.Collector.CollectUntrusted(ctx, time.Now().Add(2*time.Hour))
+			require.NoError(t, err)
+
+			usedAfterGracePeriod, err := pieceStore.SpaceUsed(ctx)
+			require.NoError(t, err)
+			require.Equal(t, int64(0), usedAfterGracePeriod)
+			require.Empty(t, storageNode.Storage2.Trust.GetUntrusted(ctx))
+
+			if used > 0 {
+				deleted++
+			}
+		}
+		require.NotZero(t, deleted)
+
+		_, err = planet.Uplinks[0].Download(ctx, satellite, "testbucket", "test/path")
+		require.Error(t, err)
+	})
+}
@@ -73,6 +73,7 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, list
 	mux.Handle("/api/dashboard", http.HandlerFunc(server.dashboardHandler))
 	mux.Handle("/api/satellites", http.HandlerFunc(server.satellitesHandler))
 	mux.Handle("/api/satellite/", http.HandlerFunc(server.satelliteHandler))
+	mux.Handle("/api/earnings", http.HandlerFunc(server.earningsHandler))
 
 	server.server = http.Server{
 		Handler: mux,
@@ -177,6 +178,25 @@ func (server *Server) satelliteHandler(w http.ResponseWriter, r *http.Request) {
 	server.writeData(w, data)
 }
 
+// earningsHandler handles earnings API requests.
+func (server *Server) earningsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	defer mon.Task()(&ctx)(nil)
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	data, err := server.service.GetEarnings(ctx)
+	if err != nil {
+		server.writeError(w, http.StatusInternalServerError, Error.Wrap(err))
+		return
+	}
+
+	server.writeData(w, data)
+}
+
 // jsonOutput defines json structure of api response data.
 type jsonOutput struct {
 	Data  interface{} `json:"data"`
@@ -14,9 +14,10 @@ import (
 	"storj.io/storj/internal/date"
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/version"
-	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/payouts"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/reputation"
 	"storj.io/storj/storagenode/storageusage"
@@ -46,8 +47,9 @@ type Service struct {
 	reputationDB   reputation.DB
 	storageUsageDB storageusage.DB
 	pieceStore     *pieces.Store
-	kademlia       *kademlia.Kademlia
+	contact        *contact.Service
 	version        *version.Service
+	payouts        *payouts.Service
 
 	allocatedBandwidth memory.Size
 	allocatedDiskSpace memory.Size
@@ -57,9 +59,9 @@ type Service struct {
 }
 
 // NewService returns new instance of Service.
-func NewService(log *zap.Logger, consoleDB DB, bandwidth bandwidth.DB, pieceStore *pieces.Store, kademlia *kademlia.Kademlia, version *version.Service,
+func NewService(log *zap.Logger, consoleDB DB, bandwidth bandwidth.DB, pieceStore *pieces.Store, contact *contact.Service, version *version.Service,
 	allocatedBandwidth, allocatedDiskSpace memory.Size, walletAddress string, versionInfo version.Info, trust *trust.Pool,
-	reputationDB reputation.DB, storageUsageDB storageusage.DB) (*Service, error) {
+	reputationDB reputation.DB, storageUsageDB storageusage.DB, payouts *payouts.Service) (*Service, error) {
 	if log == nil {
 		return nil, errs.New("log can't be nil")
 	}
@@ -80,8 +82,12 @@ func NewService(log *zap.Logger, consoleDB DB, bandwidth bandwidth.DB, pieceStor
 		return nil, errs.New("version can't be nil")
 	}
 
-	if kademlia == nil {
-		return nil, errs.New("kademlia can't be nil")
+	if contact == nil {
+		return nil, errs.New("contact can't be nil")
+	}
+
+	if payouts == nil {
+		return nil, errs.New("payouts can't be nil")
 	}
 
 	return &Service{
@@ -92,8 +98,9 @@ func NewService(log *zap.Logger, consoleDB DB, bandwidth bandwidth.DB, pieceStor
 		reputationDB:       reputationDB,
 		storageUsageDB:     storageUsageDB,
 		pieceStore:         pieceStore,
-		kademlia:           kademlia,
+		contact:            contact,
 		version:            version,
+		payouts:            payouts,
 		allocatedBandwidth: allocatedBandwidth,
 		allocatedDiskSpace: allocatedDiskSpace,
 		walletAddress:      walletAddress,
@@ -112,6 +119,8 @@ type Dashboard struct {
 	DiskSpace DiskSpaceInfo `json:"diskSpace"`
 	Bandwidth BandwidthInfo `json:"bandwidth"`
 
+	EstimatedPayout payouts.Earnings `json:"estimatedPayout"`
+
 	Version  version.SemVer `json:"version"`
 	UpToDate bool           `json:"upToDate"`
 }
@@ -121,7 +130,7 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 	defer mon.Task()(&ctx)(&err)
 	data := new(Dashboard)
 
-	data.NodeID = s.kademlia.Local().Id
+	data.NodeID = s.contact.Local().Id
 	data.Wallet = s.walletAddress
 	data.Version = s.versionInfo.Version
 	data.UpToDate = s.version.IsAllowed()
@@ -156,6 +165,12 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 		Available: s.allocatedBandwidth.GB(),
 	}
 
+	estimate, err := s.payouts.Estimate(ctx)
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+	data.EstimatedPayout = *estimate
+
 	return data, nil
 }
 
@@ -166,6 +181,8 @@ type Satellite struct {
 	BandwidthDaily []BandwidthUsed      `json:"bandwidthDaily"`
 	Audit          reputation.Metric    `json:"audit"`
 	Uptime         reputation.Metric    `json:"uptime"`
+
+	EstimatedPayout payouts.Payout `json:"estimatedPayout"`
 }
 
 // GetSatelliteData returns satellite related data.
@@ -188,12 +205,18 @@ func (s *Service) GetSatelliteData(ctx context.Context, satelliteID storj.NodeID
 		return nil, SNOServiceErr.Wrap(err)
 	}
 
+	estimate, err := s.payouts.EstimateSatellite(ctx, satelliteID)
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+
 	return &Satellite{
-		ID:             satelliteID,
-		StorageDaily:   storageDaily,
-		BandwidthDaily: bandwidthDaily,
-		Audit:          rep.Audit,
-		Uptime:         rep.Uptime,
+		ID:              satelliteID,
+		StorageDaily:    storageDaily,
+		BandwidthDaily:  bandwidthDaily,
+		Audit:           rep.Audit,
+		Uptime:          rep.Uptime,
+		EstimatedPayout: *estimate,
 	}, nil
 }
 
@@ -225,6 +248,18 @@ func (s *Service) GetAllSatellitesData(ctx context.Context) (_ *Satellites, err
 	}, nil
 }
 
+// GetEarnings returns the estimated earnings per month, oldest first.
+func (s *Service) GetEarnings(ctx context.Context) (_ []payouts.Earnings, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	earnings, err := s.payouts.History(ctx)
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+
+	return earnings, nil
+}
+
 // VerifySatelliteID verifies if the satellite belongs to the trust pool.
 func (s *Service) VerifySatelliteID(ctx context.Context, satelliteID storj.NodeID) (err error) {
 	defer mon.Task()(&ctx)(&err)
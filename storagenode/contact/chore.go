@@ -0,0 +1,139 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/storagenode/trust"
+)
+
+// Chore checks in with the trusted satellites periodically. The satellites
+// ping the node back and update its address, capacity and version.
+type Chore struct {
+	log       *zap.Logger
+	service   *Service
+	trust     *trust.Pool
+	transport transport.Client
+
+	maxSleep time.Duration
+	Loop     *sync2.Cycle
+}
+
+// NewChore creates a new contact chore
+func NewChore(log *zap.Logger, config Config, trust *trust.Pool, transport transport.Client, service *Service) *Chore {
+	return &Chore{
+		log:       log,
+		service:   service,
+		trust:     trust,
+		transport: transport,
+
+		maxSleep: config.MaxSleep,
+		Loop:     sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run checks in with the trusted satellites on every interval, after
+// sleeping a random duration so that the nodes don't check in at once.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if chore.maxSleep > 0 {
+			jitter := time.Duration(rand.Int63n(int64(chore.maxSleep)))
+			if !sync2.Sleep(ctx, jitter) {
+				return ctx.Err()
+			}
+		}
+
+		if err := chore.CheckIn(ctx); err != nil {
+			chore.log.Warn("checking in with satellites failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// CheckIn checks in with all trusted satellites concurrently and returns the
+// errors of the failed check-ins.
+func (chore *Chore) CheckIn(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	self := chore.service.Local()
+
+	var mu sync.Mutex
+	var group errs.Group
+	var wg sync.WaitGroup
+	for _, satellite := range chore.trust.GetSatellites(ctx) {
+		satellite := satellite
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := chore.checkIn(ctx, satellite, self)
+			chore.service.checkedIn(satellite, err)
+			if err != nil {
+				mu.Lock()
+				group.Add(err)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return group.Err()
+}
+
+// checkIn sends the local node information to the satellite
+func (chore *Chore) checkIn(ctx context.Context, satellite storj.NodeID, self overlay.NodeDossier) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	address, err := chore.trust.GetAddress(ctx, satellite)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	conn, err := chore.transport.DialNode(ctx, &pb.Node{
+		Id: satellite,
+		Address: &pb.NodeAddress{
+			Transport: pb.NodeTransport_TCP_TLS_GRPC,
+			Address:   address,
+		},
+	})
+	if err != nil {
+		return Error.New("failed to dial satellite %s: %v", satellite, err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	capacity := chore.service.SatelliteCapacity(satellite)
+	resp, err := pb.NewNodeClient(conn).CheckIn(ctx, &pb.CheckInRequest{
+		Address:  self.Address.GetAddress(),
+		Version:  &self.Version,
+		Capacity: &capacity,
+		Operator: &self.Operator,
+	})
+	if err != nil {
+		return Error.New("failed to check in with satellite %s: %v", satellite, err)
+	}
+	if !resp.PingNodeSuccess {
+		return Error.New("satellite %s failed to ping back the node: %s", satellite, resp.PingErrorMessage)
+	}
+	return nil
+}
+
+// Close stops the chore
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
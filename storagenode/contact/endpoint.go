@@ -0,0 +1,45 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+)
+
+// Endpoint is the contact endpoint the satellites ping back to verify the
+// address the node checked in with.
+type Endpoint struct {
+	log     *zap.Logger
+	service *Service
+}
+
+// NewEndpoint creates a new contact endpoint
+func NewEndpoint(log *zap.Logger, service *Service) *Endpoint {
+	return &Endpoint{
+		log:     log,
+		service: service,
+	}
+}
+
+// PingNode records that a satellite pinged the node
+func (endpoint *Endpoint) PingNode(ctx context.Context, req *pb.ContactPingRequest) (_ *pb.ContactPingResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	endpoint.log.Debug("pinged", zap.Stringer("by", peer.ID))
+
+	endpoint.service.WasPinged(time.Now())
+	return &pb.ContactPingResponse{}, nil
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/overlay"
+)
+
+var (
+	// Error is the default error class for the contact service
+	Error = errs.Class("contact")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for checking in with the satellites
+type Config struct {
+	Interval time.Duration `help:"how frequently the node checks in with its trusted satellites" releaseDefault:"1h" devDefault:"30s"`
+	MaxSleep time.Duration `help:"maximum duration to wait before checking in, to spread out the check-ins of all nodes" releaseDefault:"45m" devDefault:"0s"`
+}
+
+// CheckIn is the result of the last check-in with a satellite
+type CheckIn struct {
+	At      time.Time
+	Success bool
+	Error   string
+}
+
+// Service keeps the information the node checks in with and the results of
+// the check-ins.
+type Service struct {
+	log *zap.Logger
+
+	mu         sync.Mutex
+	self       overlay.NodeDossier
+	capacities map[storj.NodeID]pb.NodeCapacity
+	lastPinged time.Time
+	checkIns   map[storj.NodeID]CheckIn
+}
+
+// NewService creates a new contact service
+func NewService(log *zap.Logger, self overlay.NodeDossier) *Service {
+	return &Service{
+		log:      log,
+		self:     self,
+		checkIns: make(map[storj.NodeID]CheckIn),
+	}
+}
+
+// Local returns the local node information
+func (service *Service) Local() overlay.NodeDossier {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.self
+}
+
+// UpdateSelf updates the capacity of the local node and the capacities
+// available to the satellites with their own allocations
+func (service *Service) UpdateSelf(capacity *pb.NodeCapacity, bySatellite map[storj.NodeID]pb.NodeCapacity) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	if capacity != nil {
+		service.self.Capacity = *capacity
+	}
+	service.capacities = bySatellite
+}
+
+// SatelliteCapacity returns the capacity available to the satellite
+func (service *Service) SatelliteCapacity(satellite storj.NodeID) pb.NodeCapacity {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	if capacity, ok := service.capacities[satellite]; ok {
+		return capacity
+	}
+	return service.self.Capacity
+}
+
+// WasPinged notifies the service that a satellite pinged the node
+func (service *Service) WasPinged(when time.Time) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	service.lastPinged = when
+}
+
+// LastPinged returns when a satellite pinged the node last
+func (service *Service) LastPinged() time.Time {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.lastPinged
+}
+
+// checkedIn records the result of a check-in with the satellite
+func (service *Service) checkedIn(satellite storj.NodeID, err error) {
+	checkIn := CheckIn{At: time.Now(), Success: err == nil}
+	if err != nil {
+		checkIn.Error = err.Error()
+	}
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	service.checkIns[satellite] = checkIn
+}
+
+// CheckIns returns the results of the last check-ins per satellite
+func (service *Service) CheckIns() map[storj.NodeID]CheckIn {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	checkIns := make(map[storj.NodeID]CheckIn, len(service.checkIns))
+	for satellite, checkIn := range service.checkIns {
+		checkIns[satellite] = checkIn
+	}
+	return checkIns
+}
@@ -18,6 +18,8 @@ import (
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/payouts"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
 )
@@ -33,8 +35,10 @@ var (
 type Endpoint struct {
 	log        *zap.Logger
 	pieceStore *pieces.Store
+	contact    *contact.Service
 	kademlia   *kademlia.Kademlia
 	usageDB    bandwidth.DB
+	payouts    *payouts.Service
 
 	startTime        time.Time
 	pieceStoreConfig piecestore.OldConfig
@@ -45,16 +49,20 @@ type Endpoint struct {
 func NewEndpoint(
 	log *zap.Logger,
 	pieceStore *pieces.Store,
+	contact *contact.Service,
 	kademlia *kademlia.Kademlia,
 	usageDB bandwidth.DB,
+	payouts *payouts.Service,
 	pieceStoreConfig piecestore.OldConfig,
 	dashbaordAddress net.Addr) *Endpoint {
 
 	return &Endpoint{
 		log:              log,
 		pieceStore:       pieceStore,
+		contact:          contact,
 		kademlia:         kademlia,
 		usageDB:          usageDB,
+		payouts:          payouts,
 		pieceStoreConfig: pieceStoreConfig,
 		dashboardAddress: dashbaordAddress,
 		startTime:        time.Now(),
@@ -112,6 +120,22 @@ func (inspector *Endpoint) getDashboardData(ctx context.Context) (_ *pb.Dashboar
 		return &pb.DashboardResponse{}, Error.Wrap(err)
 	}
 
+	self := inspector.contact.Local()
+	response := &pb.DashboardResponse{
+		NodeId:           self.Id,
+		InternalAddress:  "",
+		ExternalAddress:  self.Address.Address,
+		LastPinged:       inspector.contact.LastPinged(),
+		DashboardAddress: inspector.dashboardAddress.String(),
+		Uptime:           ptypes.DurationProto(time.Since(inspector.startTime)),
+		Stats:            statsSummary,
+	}
+
+	// kademlia is nil when it's disabled
+	if inspector.kademlia == nil {
+		return response, nil
+	}
+
 	// TODO: querying all nodes is slow, find a more performant way to do this.
 	nodes, err := inspector.kademlia.FindNear(ctx, storj.NodeID{}, 10000000)
 	if err != nil {
@@ -124,18 +148,11 @@ func (inspector *Endpoint) getDashboardData(ctx context.Context) (_ *pb.Dashboar
 		bsNodes[i] = node.Address.Address
 	}
 
-	return &pb.DashboardResponse{
-		NodeId:           inspector.kademlia.Local().Id,
-		NodeConnections:  int64(len(nodes)),
-		BootstrapAddress: strings.Join(bsNodes, ", "),
-		InternalAddress:  "",
-		ExternalAddress:  inspector.kademlia.Local().Address.Address,
-		LastPinged:       inspector.kademlia.LastPinged(),
-		LastQueried:      inspector.kademlia.LastQueried(),
-		DashboardAddress: inspector.dashboardAddress.String(),
-		Uptime:           ptypes.DurationProto(time.Since(inspector.startTime)),
-		Stats:            statsSummary,
-	}, nil
+	response.NodeConnections = int64(len(nodes))
+	response.BootstrapAddress = strings.Join(bsNodes, ", ")
+	response.LastPinged = inspector.kademlia.LastPinged()
+	response.LastQueried = inspector.kademlia.LastQueried()
+	return response, nil
 }
 
 // Dashboard returns dashboard information
@@ -149,3 +166,39 @@ func (inspector *Endpoint) Dashboard(ctx context.Context, in *pb.DashboardReques
 	}
 	return data, nil
 }
+
+// Earnings returns the estimated earnings per month
+func (inspector *Endpoint) Earnings(ctx context.Context, in *pb.EarningsRequest) (out *pb.EarningsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	history, err := inspector.payouts.History(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	out = &pb.EarningsResponse{}
+	for _, earnings := range history {
+		month := &pb.MonthlyEarnings{
+			Month: earnings.Month,
+			Gross: earnings.Gross,
+			Held:  earnings.Held,
+			Net:   earnings.Net,
+		}
+		for _, payout := range earnings.Satellites {
+			month.Satellites = append(month.Satellites, &pb.SatelliteEarnings{
+				SatelliteId:   payout.SatelliteID,
+				NodeAge:       int64(payout.NodeAge),
+				Egress:        payout.Egress,
+				RepairEgress:  payout.RepairEgress,
+				AuditEgress:   payout.AuditEgress,
+				DiskByteHours: payout.DiskByteHours,
+				Gross:         payout.Gross,
+				HeldPercent:   int64(payout.HeldPercent),
+				Held:          payout.Held,
+				Net:           payout.Net,
+			})
+		}
+		out.Months = append(out.Months, month)
+	}
+	return out, nil
+}
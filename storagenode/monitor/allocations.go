@@ -0,0 +1,86 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package monitor
+
+import (
+	"sort"
+	"strings"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/storj"
+)
+
+// Allocation is the disk space and bandwidth a single satellite can use.
+// A zero size doesn't limit the satellite.
+type Allocation struct {
+	DiskSpace memory.Size
+	Bandwidth memory.Size
+}
+
+// Allocations are the allocations of the satellites. They are configured as
+// a comma-separated list of <satellite id>:<disk space>:<bandwidth>, e.g.
+// "12EayRS2V1kEsWESU9QMRseFhdxYxKicsiFmxrsLZHeLUtdps3S:500GB:1TB". An empty
+// size doesn't limit the satellite.
+type Allocations map[storj.NodeID]Allocation
+
+// String implements pflag.Value.
+func (allocations Allocations) String() string {
+	var xs []string
+	for id, allocation := range allocations {
+		x := id.String() + ":"
+		if allocation.DiskSpace > 0 {
+			x += allocation.DiskSpace.String()
+		}
+		x += ":"
+		if allocation.Bandwidth > 0 {
+			x += allocation.Bandwidth.String()
+		}
+		xs = append(xs, x)
+	}
+	sort.Strings(xs)
+	return strings.Join(xs, ",")
+}
+
+// Set implements pflag.Value.
+func (allocations *Allocations) Set(s string) error {
+	parsed := make(Allocations)
+	for _, x := range strings.Split(s, ",") {
+		x = strings.TrimSpace(x)
+		if x == "" {
+			continue
+		}
+
+		parts := strings.Split(x, ":")
+		if len(parts) != 3 {
+			return Error.New("invalid allocation %q, expected <satellite id>:<disk space>:<bandwidth>", x)
+		}
+
+		id, err := storj.NodeIDFromString(parts[0])
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if _, ok := parsed[id]; ok {
+			return Error.New("duplicate allocation for satellite %s", id)
+		}
+
+		var allocation Allocation
+		if parts[1] != "" {
+			if err := allocation.DiskSpace.Set(parts[1]); err != nil {
+				return Error.Wrap(err)
+			}
+		}
+		if parts[2] != "" {
+			if err := allocation.Bandwidth.Set(parts[2]); err != nil {
+				return Error.Wrap(err)
+			}
+		}
+		parsed[id] = allocation
+	}
+
+	*allocations = parsed
+	return nil
+}
+
+// Type implements pflag.Value.
+func (Allocations) Type() string { return "monitor.Allocations" }
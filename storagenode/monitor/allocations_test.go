@@ -0,0 +1,44 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package monitor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/storagenode/monitor"
+)
+
+func TestAllocations(t *testing.T) {
+	first, second := testrand.NodeID(), testrand.NodeID()
+
+	var allocations monitor.Allocations
+	err := allocations.Set(first.String() + ":500GB:1TB, " + second.String() + "::2TB")
+	require.NoError(t, err)
+	assert.Equal(t, monitor.Allocations{
+		first:  {DiskSpace: 500 * memory.GB, Bandwidth: memory.TB},
+		second: {Bandwidth: 2 * memory.TB},
+	}, allocations)
+
+	var parsed monitor.Allocations
+	require.NoError(t, parsed.Set(allocations.String()))
+	assert.Equal(t, allocations, parsed)
+
+	require.NoError(t, parsed.Set(""))
+	assert.Empty(t, parsed)
+
+	for _, invalid := range []string{
+		first.String(),
+		first.String() + ":500GB",
+		"invalid:500GB:1TB",
+		first.String() + ":500XB:1TB",
+		first.String() + "::1TB," + first.String() + ":500GB:",
+	} {
+		assert.Error(t, parsed.Set(invalid), invalid)
+	}
+}
@@ -15,7 +15,9 @@ import (
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/contact"
 	"storj.io/storj/storagenode/pieces"
 )
 
@@ -31,11 +33,13 @@ type Config struct {
 	Interval         time.Duration `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
 	MinimumDiskSpace memory.Size   `help:"how much disk space a node at minimum has to advertise" default:"500GB"`
 	MinimumBandwidth memory.Size   `help:"how much bandwidth a node at minimum has to advertise" default:"500GB"`
+	Allocations      Allocations   `help:"a comma-separated list of per-satellite allocations as <satellite id>:<disk space>:<bandwidth>, an empty size doesn't limit the satellite" default:""`
 }
 
 // Service which monitors disk usage and updates kademlia network as necessary.
 type Service struct {
 	log                *zap.Logger
+	contact            *contact.Service
 	routingTable       *kademlia.RoutingTable
 	store              *pieces.Store
 	usageDB            bandwidth.DB
@@ -48,9 +52,10 @@ type Service struct {
 // TODO: should it be responsible for monitoring actual bandwidth as well?
 
 // NewService creates a new storage node monitoring service.
-func NewService(log *zap.Logger, routingTable *kademlia.RoutingTable, store *pieces.Store, usageDB bandwidth.DB, allocatedDiskSpace, allocatedBandwidth int64, interval time.Duration, config Config) *Service {
+func NewService(log *zap.Logger, contact *contact.Service, routingTable *kademlia.RoutingTable, store *pieces.Store, usageDB bandwidth.DB, allocatedDiskSpace, allocatedBandwidth int64, interval time.Duration, config Config) *Service {
 	return &Service{
 		log:                log,
+		contact:            contact,
 		routingTable:       routingTable,
 		store:              store,
 		usageDB:            usageDB,
@@ -150,10 +155,32 @@ func (service *Service) updateNodeInformation(ctx context.Context) (err error) {
 		return Error.Wrap(err)
 	}
 
-	service.routingTable.UpdateSelf(&pb.NodeCapacity{
+	capacity := &pb.NodeCapacity{
 		FreeBandwidth: service.allocatedBandwidth - usedBandwidth,
 		FreeDisk:      service.allocatedDiskSpace - usedSpace,
-	})
+	}
+
+	bySatellite := make(map[storj.NodeID]pb.NodeCapacity, len(service.Config.Allocations))
+	for satelliteID := range service.Config.Allocations {
+		freeDisk, err := service.AvailableSpaceForSatellite(ctx, satelliteID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		freeBandwidth, err := service.AvailableBandwidthForSatellite(ctx, satelliteID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		bySatellite[satelliteID] = pb.NodeCapacity{
+			FreeBandwidth: freeBandwidth,
+			FreeDisk:      freeDisk,
+		}
+	}
+
+	service.contact.UpdateSelf(capacity, bySatellite)
+	// the routing table is nil when kademlia is disabled
+	if service.routingTable != nil {
+		service.routingTable.UpdateSelf(capacity)
+	}
 
 	return nil
 }
@@ -197,3 +224,59 @@ func (service *Service) AvailableBandwidth(ctx context.Context) (_ int64, err er
 	allocatedBandwidth := service.allocatedBandwidth
 	return allocatedBandwidth - usage, nil
 }
+
+// AvailableSpaceForSatellite returns available disk space for uploads of the
+// satellite, which is limited by its allocation and the available space of
+// the node.
+func (service *Service) AvailableSpaceForSatellite(ctx context.Context, satelliteID storj.NodeID) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	available, err := service.AvailableSpace(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	allocation := service.Config.Allocations[satelliteID]
+	if allocation.DiskSpace <= 0 {
+		return available, nil
+	}
+
+	usedSpace, err := service.store.SpaceUsedBySatellite(ctx, satelliteID)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	return min(available, allocation.DiskSpace.Int64()-usedSpace), nil
+}
+
+// AvailableBandwidthForSatellite returns available bandwidth for
+// uploads/downloads of the satellite, which is limited by its allocation and
+// the available bandwidth of the node.
+func (service *Service) AvailableBandwidthForSatellite(ctx context.Context, satelliteID storj.NodeID) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	available, err := service.AvailableBandwidth(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	allocation := service.Config.Allocations[satelliteID]
+	if allocation.Bandwidth <= 0 {
+		return available, nil
+	}
+
+	usage, err := bandwidth.MonthlySummaryBySatellite(ctx, service.usageDB)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	var used int64
+	if satelliteUsage, ok := usage[satelliteID]; ok {
+		used = satelliteUsage.Total()
+	}
+	return min(available, allocation.Bandwidth.Int64()-used), nil
+}
+
+func min(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
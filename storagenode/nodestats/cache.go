@@ -16,6 +16,7 @@ import (
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storagenode/reputation"
+	"storj.io/storj/storagenode/satellites"
 	"storj.io/storj/storagenode/storageusage"
 	"storj.io/storj/storagenode/trust"
 )
@@ -36,6 +37,7 @@ type Config struct {
 type CacheStorage struct {
 	Reputation   reputation.DB
 	StorageUsage storageusage.DB
+	Satellites   satellites.DB
 }
 
 // Cache runs cache loop and stores reputation stats
@@ -98,7 +100,8 @@ func (cache *Cache) Run(ctx context.Context) error {
 }
 
 // CacheReputationStats queries node stats from all the satellites
-// known to the storagenode and stores information into db
+// known to the storagenode and stores information into db. Satellites
+// responding for the first time are recorded as first seen now.
 func (cache *Cache) CacheReputationStats(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -108,6 +111,10 @@ func (cache *Cache) CacheReputationStats(ctx context.Context) (err error) {
 			return err
 		}
 
+		if err = cache.db.Satellites.SetAddedAt(ctx, satellite, time.Now()); err != nil {
+			return err
+		}
+
 		if err = cache.db.Reputation.Store(ctx, *stats); err != nil {
 			return err
 		}
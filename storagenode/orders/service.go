@@ -245,6 +245,18 @@ func (service *Service) settle(ctx context.Context, log *zap.Logger, satelliteID
 
 	address, err := service.trust.GetAddress(ctx, satelliteID)
 	if err != nil {
+		if trust.ErrUntrusted.Has(err) {
+			// the satellite was forgotten, its orders can't be settled anymore
+			log.Warn("archiving orders of untrusted satellite as rejected", zap.Int("count", len(orders)))
+			for _, order := range orders {
+				requests <- ArchiveRequest{
+					Satellite: satelliteID,
+					Serial:    order.Limit.SerialNumber,
+					Status:    StatusRejected,
+				}
+			}
+			return nil
+		}
 		return OrderError.New("unable to get satellite address: %v", err)
 	}
 	satellite := pb.Node{
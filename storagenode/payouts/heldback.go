@@ -0,0 +1,64 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts
+
+import (
+	"strconv"
+	"strings"
+)
+
+// HeldBack is the percentage of the payout which is held back by the
+// satellites, indexed by the age of the node in months. The last percentage
+// applies to all older nodes.
+type HeldBack []int
+
+// Percent returns the held back percentage for a node of the given age in
+// months, where a node in its first month is one month old.
+func (heldBack HeldBack) Percent(age int) int {
+	if len(heldBack) == 0 {
+		return 0
+	}
+	if age < 1 {
+		age = 1
+	}
+	if age > len(heldBack) {
+		return heldBack[len(heldBack)-1]
+	}
+	return heldBack[age-1]
+}
+
+// String implements pflag.Value.
+func (heldBack HeldBack) String() string {
+	xs := make([]string, len(heldBack))
+	for i, percent := range heldBack {
+		xs[i] = strconv.Itoa(percent)
+	}
+	return strings.Join(xs, ",")
+}
+
+// Set implements pflag.Value.
+func (heldBack *HeldBack) Set(s string) error {
+	var parsed HeldBack
+	for _, x := range strings.Split(s, ",") {
+		x = strings.TrimSpace(x)
+		if x == "" {
+			continue
+		}
+
+		percent, err := strconv.Atoi(x)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if percent < 0 || percent > 100 {
+			return Error.New("invalid held back percentage %d", percent)
+		}
+		parsed = append(parsed, percent)
+	}
+
+	*heldBack = parsed
+	return nil
+}
+
+// Type implements pflag.Value.
+func (HeldBack) Type() string { return "payouts.HeldBack" }
@@ -0,0 +1,277 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/internal/date"
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/storageusage"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	// Error is the default error class for the payouts service
+	Error = errs.Class("payouts")
+
+	mon = monkit.Package()
+)
+
+// hoursPerMonth is the number of hours in a month used for the disk payout
+const hoursPerMonth = 720
+
+// Config contains the rate table and the held back schedule used to
+// estimate payouts. All rates are in cents.
+type Config struct {
+	EgressRate       int64    `help:"payout for egress in cents per TB" default:"2000"`
+	RepairEgressRate int64    `help:"payout for repair egress in cents per TB" default:"1000"`
+	AuditEgressRate  int64    `help:"payout for audit egress in cents per TB" default:"1000"`
+	DiskRate         int64    `help:"payout for stored data in cents per TB-month" default:"150"`
+	HeldBack         HeldBack `help:"a comma-separated list of the held back percentages by node age in months, the last one applies to older nodes" default:"75,75,75,50,50,50,25,25,25,0"`
+	HistoryMonths    int      `help:"how many months the earnings history covers" default:"15"`
+}
+
+// Payout is the estimated payout of a single satellite for a month.
+// Amounts are in cents.
+type Payout struct {
+	SatelliteID storj.NodeID `json:"satelliteID"`
+	// NodeAge is the age of the node on the satellite in months. It's
+	// counted in calendar months from when the node was first seen working
+	// with the satellite, so it can be a month off the age the satellite
+	// holds back by.
+	NodeAge int `json:"nodeAge"`
+
+	Egress        int64   `json:"egress"`
+	RepairEgress  int64   `json:"repairEgress"`
+	AuditEgress   int64   `json:"auditEgress"`
+	DiskByteHours float64 `json:"diskByteHours"`
+
+	EgressPayout       int64 `json:"egressPayout"`
+	RepairEgressPayout int64 `json:"repairEgressPayout"`
+	AuditEgressPayout  int64 `json:"auditEgressPayout"`
+	DiskPayout         int64 `json:"diskPayout"`
+
+	Gross       int64 `json:"gross"`
+	HeldPercent int   `json:"heldPercent"`
+	Held        int64 `json:"held"`
+	Net         int64 `json:"net"`
+}
+
+// Earnings are the estimated payouts of all satellites for a month.
+// Amounts are in cents.
+type Earnings struct {
+	Month      time.Time `json:"month"`
+	Satellites []Payout  `json:"satellites"`
+
+	Gross int64 `json:"gross"`
+	Held  int64 `json:"held"`
+	Net   int64 `json:"net"`
+}
+
+// Service estimates the payouts of the node from its bandwidth and storage
+// usage.
+type Service struct {
+	log    *zap.Logger
+	config Config
+
+	bandwidthDB    bandwidth.DB
+	storageUsageDB storageusage.DB
+	satellitesDB   satellites.DB
+	trust          *trust.Pool
+}
+
+// NewService creates a new payouts service
+func NewService(log *zap.Logger, bandwidthDB bandwidth.DB, storageUsageDB storageusage.DB, satellitesDB satellites.DB, trust *trust.Pool, config Config) *Service {
+	return &Service{
+		log:            log,
+		config:         config,
+		bandwidthDB:    bandwidthDB,
+		storageUsageDB: storageUsageDB,
+		satellitesDB:   satellitesDB,
+		trust:          trust,
+	}
+}
+
+// Estimate returns the estimated earnings of the current month.
+func (service *Service) Estimate(ctx context.Context) (_ *Earnings, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	from, to := date.MonthBoundary(time.Now())
+	earnings, err := service.monthEarnings(ctx, from, to, make(map[storj.NodeID]time.Time))
+	if err != nil {
+		return nil, err
+	}
+	return &earnings, nil
+}
+
+// EstimateSatellite returns the estimated payout of the satellite for the
+// current month.
+func (service *Service) EstimateSatellite(ctx context.Context, satelliteID storj.NodeID) (_ *Payout, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	earnings, err := service.Estimate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, payout := range earnings.Satellites {
+		if payout.SatelliteID == satelliteID {
+			return &payout, nil
+		}
+	}
+	return &Payout{SatelliteID: satelliteID}, nil
+}
+
+// History returns the estimated earnings per month, oldest first, ending
+// with the current month.
+func (service *Service) History(ctx context.Context) (_ []Earnings, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	months := service.config.HistoryMonths
+	if months < 1 {
+		months = 1
+	}
+
+	now := time.Now()
+	addedAt := make(map[storj.NodeID]time.Time)
+
+	history := make([]Earnings, 0, months)
+	for i := 0; i < months; i++ {
+		from, to := date.MonthBoundary(time.Date(now.Year(), now.Month()-time.Month(months-1-i), 1, 0, 0, 0, 0, now.Location()))
+
+		earnings, err := service.monthEarnings(ctx, from, to, addedAt)
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, earnings)
+	}
+
+	return history, nil
+}
+
+// monthEarnings returns the estimated earnings of the month starting at
+// from. addedAt caches when the node was first seen working with the
+// satellites across calls.
+func (service *Service) monthEarnings(ctx context.Context, from, to time.Time, addedAt map[storj.NodeID]time.Time) (_ Earnings, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	usage, err := service.monthUsage(ctx, from, to)
+	if err != nil {
+		return Earnings{}, err
+	}
+
+	earnings := Earnings{Month: from}
+	for _, payout := range usage {
+		first, ok := addedAt[payout.SatelliteID]
+		if !ok {
+			first, err = service.satellitesDB.GetAddedAt(ctx, payout.SatelliteID)
+			if err != nil {
+				return Earnings{}, Error.Wrap(err)
+			}
+			addedAt[payout.SatelliteID] = first
+		}
+
+		service.price(&payout, nodeAge(first, from))
+		earnings.Satellites = append(earnings.Satellites, payout)
+		earnings.Gross += payout.Gross
+		earnings.Held += payout.Held
+		earnings.Net += payout.Net
+	}
+	return earnings, nil
+}
+
+// nodeAge returns the age in months of a node first seen at addedAt in the
+// month starting at month. The month the node was first seen counts as the
+// first month, a node which wasn't seen yet is in its first month.
+func nodeAge(addedAt, month time.Time) int {
+	if addedAt.IsZero() {
+		return 1
+	}
+	addedAt = addedAt.In(month.Location())
+
+	age := (month.Year()-addedAt.Year())*12 + int(month.Month()-addedAt.Month()) + 1
+	if age < 1 {
+		return 1
+	}
+	return age
+}
+
+// monthUsage returns the usage of the satellites in the month without
+// pricing it. Satellites without any usage are left out.
+func (service *Service) monthUsage(ctx context.Context, from, to time.Time) (_ []Payout, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bandwidthUsage, err := service.bandwidthDB.SummaryBySatellite(ctx, from, to)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	satellites := make(map[storj.NodeID]struct{})
+	for satelliteID := range bandwidthUsage {
+		satellites[satelliteID] = struct{}{}
+	}
+	for _, satelliteID := range service.trust.GetSatellites(ctx) {
+		satellites[satelliteID] = struct{}{}
+	}
+
+	var payouts []Payout
+	for satelliteID := range satellites {
+		payout := Payout{SatelliteID: satelliteID}
+		if usage, ok := bandwidthUsage[satelliteID]; ok {
+			payout.Egress = usage.Get
+			payout.RepairEgress = usage.GetRepair
+			payout.AuditEgress = usage.GetAudit
+		}
+
+		stamps, err := service.storageUsageDB.GetDaily(ctx, satelliteID, from, to)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		for _, stamp := range stamps {
+			payout.DiskByteHours += stamp.AtRestTotal
+		}
+
+		if payout.Egress == 0 && payout.RepairEgress == 0 && payout.AuditEgress == 0 && payout.DiskByteHours == 0 {
+			continue
+		}
+		payouts = append(payouts, payout)
+	}
+
+	sort.Slice(payouts, func(i, k int) bool {
+		return payouts[i].SatelliteID.Less(payouts[k].SatelliteID)
+	})
+	return payouts, nil
+}
+
+// price calculates the payout amounts of the usage for a node of the given
+// age in months.
+func (service *Service) price(payout *Payout, age int) {
+	tb := float64(memory.TB)
+
+	payout.NodeAge = age
+	payout.EgressPayout = cents(float64(payout.Egress) / tb * float64(service.config.EgressRate))
+	payout.RepairEgressPayout = cents(float64(payout.RepairEgress) / tb * float64(service.config.RepairEgressRate))
+	payout.AuditEgressPayout = cents(float64(payout.AuditEgress) / tb * float64(service.config.AuditEgressRate))
+	payout.DiskPayout = cents(payout.DiskByteHours / hoursPerMonth / tb * float64(service.config.DiskRate))
+
+	payout.Gross = payout.EgressPayout + payout.RepairEgressPayout + payout.AuditEgressPayout + payout.DiskPayout
+	payout.HeldPercent = service.config.HeldBack.Percent(age)
+	payout.Held = payout.Gross * int64(payout.HeldPercent) / 100
+	payout.Net = payout.Gross - payout.Held
+}
+
+// cents rounds the amount to whole cents
+func cents(amount float64) int64 {
+	return int64(math.Round(amount))
+}
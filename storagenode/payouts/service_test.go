@@ -0,0 +1,140 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storagenode/payouts"
+	"storj.io/storj/storagenode/storageusage"
+)
+
+func TestHeldBack(t *testing.T) {
+	var heldBack payouts.HeldBack
+	require.NoError(t, heldBack.Set("75, 50,0"))
+	assert.Equal(t, payouts.HeldBack{75, 50, 0}, heldBack)
+	assert.Equal(t, "75,50,0", heldBack.String())
+
+	assert.Equal(t, 75, heldBack.Percent(0))
+	assert.Equal(t, 75, heldBack.Percent(1))
+	assert.Equal(t, 50, heldBack.Percent(2))
+	assert.Equal(t, 0, heldBack.Percent(3))
+	assert.Equal(t, 0, heldBack.Percent(40))
+
+	assert.Error(t, heldBack.Set("75,x"))
+	assert.Error(t, heldBack.Set("101"))
+	assert.Error(t, heldBack.Set("-1"))
+}
+
+func TestHistory(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satelliteID := planet.Satellites[0].ID()
+		storageNode := planet.StorageNodes[0]
+
+		now := time.Now()
+		thisMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		firstMonth := thisMonth.AddDate(0, -3, 0)
+
+		// the node started with the satellite three months ago
+		err := storageNode.DB.Satellites().SetAddedAt(ctx, satelliteID, firstMonth.Add(time.Hour))
+		require.NoError(t, err)
+		err = storageNode.DB.Bandwidth().Add(ctx, satelliteID, pb.PieceAction_GET, memory.TB.Int64(), firstMonth.Add(time.Hour))
+		require.NoError(t, err)
+
+		err = storageNode.DB.Bandwidth().Add(ctx, satelliteID, pb.PieceAction_GET, memory.TB.Int64(), thisMonth.Add(time.Hour))
+		require.NoError(t, err)
+		err = storageNode.DB.Bandwidth().Add(ctx, satelliteID, pb.PieceAction_GET_REPAIR, memory.TB.Int64(), thisMonth.Add(time.Hour))
+		require.NoError(t, err)
+		err = storageNode.DB.Bandwidth().Add(ctx, satelliteID, pb.PieceAction_GET_AUDIT, memory.TB.Int64()/2, thisMonth.Add(time.Hour))
+		require.NoError(t, err)
+
+		// a TB stored for a whole month
+		err = storageNode.DB.StorageUsage().Store(ctx, []storageusage.Stamp{
+			{SatelliteID: satelliteID, AtRestTotal: float64(memory.TB) * 360, Timestamp: thisMonth.Add(time.Hour)},
+			{SatelliteID: satelliteID, AtRestTotal: float64(memory.TB) * 360, Timestamp: thisMonth.Add(25 * time.Hour)},
+		})
+		require.NoError(t, err)
+
+		history, err := storageNode.Payouts.History(ctx)
+		require.NoError(t, err)
+		require.Len(t, history, 15)
+
+		for _, earnings := range history[:len(history)-4] {
+			assert.Empty(t, earnings.Satellites)
+			assert.Zero(t, earnings.Gross)
+		}
+
+		first := history[len(history)-4]
+		assert.Equal(t, firstMonth, first.Month)
+		require.Len(t, first.Satellites, 1)
+		assert.Equal(t, 1, first.Satellites[0].NodeAge)
+		assert.Equal(t, int64(2000), first.Gross)
+		assert.Equal(t, int64(1500), first.Held)
+		assert.Equal(t, int64(500), first.Net)
+
+		assert.Empty(t, history[len(history)-3].Satellites)
+		assert.Empty(t, history[len(history)-2].Satellites)
+
+		estimate, err := storageNode.Payouts.Estimate(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, thisMonth, estimate.Month)
+		require.Len(t, estimate.Satellites, 1)
+
+		payout := estimate.Satellites[0]
+		assert.Equal(t, satelliteID, payout.SatelliteID)
+		assert.Equal(t, 4, payout.NodeAge)
+		assert.Equal(t, int64(2000), payout.EgressPayout)
+		assert.Equal(t, int64(1000), payout.RepairEgressPayout)
+		assert.Equal(t, int64(500), payout.AuditEgressPayout)
+		assert.Equal(t, int64(150), payout.DiskPayout)
+		assert.Equal(t, int64(3650), payout.Gross)
+		assert.Equal(t, 50, payout.HeldPercent)
+		assert.Equal(t, int64(1825), payout.Held)
+		assert.Equal(t, int64(1825), payout.Net)
+
+		// the inspector reports the same history
+		response, err := storageNode.Storage2.Inspector.Earnings(ctx, &pb.EarningsRequest{})
+		require.NoError(t, err)
+
+		data, err := proto.Marshal(response)
+		require.NoError(t, err)
+		var decoded pb.EarningsResponse
+		require.NoError(t, proto.Unmarshal(data, &decoded))
+
+		require.Len(t, decoded.Months, 15)
+		current := decoded.Months[len(decoded.Months)-1]
+		assert.True(t, thisMonth.Equal(current.Month))
+		require.Len(t, current.Satellites, 1)
+		assert.Equal(t, satelliteID, current.Satellites[0].SatelliteId)
+		assert.Equal(t, int64(3650), current.Gross)
+		assert.Equal(t, int64(1825), current.Net)
+
+		// the age of a node first seen before the history isn't capped by it
+		err = storageNode.DB.Satellites().SetAddedAt(ctx, satelliteID, thisMonth.AddDate(0, -20, 0))
+		require.NoError(t, err)
+
+		estimate, err = storageNode.Payouts.Estimate(ctx)
+		require.NoError(t, err)
+		require.Len(t, estimate.Satellites, 1)
+		assert.Equal(t, 21, estimate.Satellites[0].NodeAge)
+		assert.Equal(t, 0, estimate.Satellites[0].HeldPercent)
+		assert.Equal(t, int64(3650), estimate.Net)
+
+		history, err = storageNode.Payouts.History(ctx)
+		require.NoError(t, err)
+		require.Len(t, history[len(history)-4].Satellites, 1)
+		assert.Equal(t, 18, history[len(history)-4].Satellites[0].NodeAge)
+	})
+}
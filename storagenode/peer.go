@@ -29,14 +29,17 @@ import (
 	"storj.io/storj/storagenode/collector"
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleserver"
+	"storj.io/storj/storagenode/contact"
 	"storj.io/storj/storagenode/inspector"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/nodestats"
 	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/payouts"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/reputation"
 	"storj.io/storj/storagenode/retain"
+	"storj.io/storj/storagenode/satellites"
 	"storj.io/storj/storagenode/storageusage"
 	"storj.io/storj/storagenode/trust"
 )
@@ -62,6 +65,7 @@ type DB interface {
 	UsedSerials() piecestore.UsedSerials
 	Console() console.DB
 	Reputation() reputation.DB
+	Satellites() satellites.DB
 	StorageUsage() storageusage.DB
 
 	// TODO: use better interfaces
@@ -74,6 +78,8 @@ type Config struct {
 
 	Server   server.Config
 	Kademlia kademlia.Config
+	Contact  contact.Config
+	Trust    trust.Config
 
 	// TODO: flatten storage config and only keep the new one
 	Storage   piecestore.OldConfig
@@ -86,6 +92,8 @@ type Config struct {
 
 	Console consoleserver.Config
 
+	Payouts payouts.Config
+
 	Version version.Config
 
 	Bandwidth bandwidth.Config
@@ -111,6 +119,12 @@ type Peer struct {
 
 	// services and endpoints
 	// TODO: similar grouping to satellite.Peer
+	Contact struct {
+		Service  *contact.Service
+		Chore    *contact.Chore
+		Endpoint *contact.Endpoint
+	}
+
 	Kademlia struct {
 		RoutingTable *kademlia.RoutingTable
 		Service      *kademlia.Kademlia
@@ -138,6 +152,8 @@ type Peer struct {
 		Cache   *nodestats.Cache
 	}
 
+	Payouts *payouts.Service
+
 	// Web server with web UI
 	Console struct {
 		Listener net.Listener
@@ -164,7 +180,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Log.Sugar().Debugf("Binary Version: %s with CommitHash %s, built at %s as Release %v",
 				versionInfo.Version.String(), versionInfo.CommitHash, versionInfo.Timestamp.String(), versionInfo.Release)
 		}
-		peer.Version = version.NewService(log.Named("version"), config.Version, versionInfo, "Storagenode")
+		peer.Version = version.NewService(log.Named("version"), config.Version, versionInfo, peer.Identity.ID, "Storagenode")
 	}
 
 	{ // setup listener and server
@@ -183,16 +199,22 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		}
 	}
 
-	{ // setup trust pool before kademlia
-		peer.Storage2.Trust, err = trust.NewPool(peer.Transport, config.Storage.WhitelistedSatellites)
+	{ // setup trust pool before contact and kademlia
+		whitelisted := config.Storage.WhitelistedSatellites
+		config := config.Trust
+		// the whitelisted satellites are trusted unless sources are configured
+		if config.Sources == "" {
+			config.Sources = whitelisted.String()
+		}
+
+		peer.Storage2.Trust, err = trust.NewPool(peer.Log.Named("trust"), peer.Transport, config)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
 	}
 
-	{ // setup kademlia
+	{ // setup contact
 		config := config.Kademlia
-		// TODO: move this setup logic into kademlia package
 		if config.ExternalAddress == "" {
 			config.ExternalAddress = peer.Addr()
 		}
@@ -202,7 +224,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		self := &overlay.NodeDossier{
+		self := overlay.NodeDossier{
 			Node: pb.Node{
 				Id: peer.ID(),
 				Address: &pb.NodeAddress{
@@ -218,8 +240,18 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			Version: *pbVersion,
 		}
 
+		peer.Contact.Service = contact.NewService(peer.Log.Named("contact:service"), self)
+		peer.Contact.Endpoint = contact.NewEndpoint(peer.Log.Named("contact:endpoint"), peer.Contact.Service)
+		pb.RegisterContactServer(peer.Server.GRPC(), peer.Contact.Endpoint)
+	}
+
+	if !config.Kademlia.Disabled { // setup kademlia
+		config := config.Kademlia
+		// TODO: move this setup logic into kademlia package
+		self := peer.Contact.Service.Local()
+
 		kdb, ndb, adb := peer.DB.RoutingTable()
-		peer.Kademlia.RoutingTable, err = kademlia.NewRoutingTable(peer.Log.Named("routing"), self, kdb, ndb, adb, &config.RoutingTableConfig)
+		peer.Kademlia.RoutingTable, err = kademlia.NewRoutingTable(peer.Log.Named("routing"), &self, kdb, ndb, adb, &config.RoutingTableConfig)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -231,13 +263,15 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Kademlia.Endpoint = kademlia.NewEndpoint(peer.Log.Named("kademlia:endpoint"), peer.Kademlia.Service, peer.Kademlia.RoutingTable, peer.Storage2.Trust)
+		peer.Kademlia.Endpoint = kademlia.NewEndpoint(peer.Log.Named("kademlia:endpoint"), peer.Kademlia.Service, peer.Kademlia.RoutingTable, peer.Storage2.Trust, peer.Contact.Service)
 		pb.RegisterNodesServer(peer.Server.GRPC(), peer.Kademlia.Endpoint)
 
 		peer.Kademlia.Inspector = kademlia.NewInspector(peer.Kademlia.Service, peer.Identity)
 		pb.RegisterKadInspectorServer(peer.Server.PrivateGRPC(), peer.Kademlia.Inspector)
 	}
 
+	peer.Contact.Chore = contact.NewChore(peer.Log.Named("contact:chore"), config.Contact, peer.Storage2.Trust, peer.Transport, peer.Contact.Service)
+
 	{ // setup storage
 		peer.Storage2.BlobsCache = pieces.NewBlobsUsageCache(peer.DB.Pieces())
 
@@ -257,6 +291,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 
 		peer.Storage2.Monitor = monitor.NewService(
 			log.Named("piecestore:monitor"),
+			peer.Contact.Service,
 			peer.Kademlia.RoutingTable,
 			peer.Storage2.Store,
 			peer.DB.Bandwidth(),
@@ -323,18 +358,28 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			nodestats.CacheStorage{
 				Reputation:   peer.DB.Reputation(),
 				StorageUsage: peer.DB.StorageUsage(),
+				Satellites:   peer.DB.Satellites(),
 			},
 			peer.NodeStats.Service,
 			peer.Storage2.Trust)
 	}
 
+	peer.Payouts = payouts.NewService(
+		peer.Log.Named("payouts"),
+		peer.DB.Bandwidth(),
+		peer.DB.StorageUsage(),
+		peer.DB.Satellites(),
+		peer.Storage2.Trust,
+		config.Payouts,
+	)
+
 	{ // setup storage node operator dashboard
 		peer.Console.Service, err = console.NewService(
 			peer.Log.Named("console:service"),
 			peer.DB.Console(),
 			peer.DB.Bandwidth(),
 			peer.Storage2.Store,
-			peer.Kademlia.Service,
+			peer.Contact.Service,
 			peer.Version,
 			config.Storage.AllocatedBandwidth,
 			config.Storage.AllocatedDiskSpace,
@@ -342,7 +387,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			versionInfo,
 			peer.Storage2.Trust,
 			peer.DB.Reputation(),
-			peer.DB.StorageUsage())
+			peer.DB.StorageUsage(),
+			peer.Payouts)
 
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -365,15 +411,17 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		peer.Storage2.Inspector = inspector.NewEndpoint(
 			peer.Log.Named("pieces:inspector"),
 			peer.Storage2.Store,
+			peer.Contact.Service,
 			peer.Kademlia.Service,
 			peer.DB.Bandwidth(),
+			peer.Payouts,
 			config.Storage,
 			peer.Console.Listener.Addr(),
 		)
 		pb.RegisterPieceStoreInspectorServer(peer.Server.PrivateGRPC(), peer.Storage2.Inspector)
 	}
 
-	peer.Collector = collector.NewService(peer.Log.Named("collector"), peer.Storage2.Store, peer.DB.UsedSerials(), config.Collector)
+	peer.Collector = collector.NewService(peer.Log.Named("collector"), peer.Storage2.Store, peer.DB.UsedSerials(), peer.Storage2.Trust, config.Collector)
 
 	peer.Bandwidth = bandwidth.NewService(peer.Log.Named("bandwidth"), peer.DB.Bandwidth(), config.Bandwidth)
 
@@ -391,12 +439,21 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	})
 
 	group.Go(func() error {
-		return errs2.IgnoreCanceled(peer.Kademlia.Service.Bootstrap(ctx))
+		return errs2.IgnoreCanceled(peer.Storage2.Trust.Run(ctx))
 	})
 	group.Go(func() error {
-		return errs2.IgnoreCanceled(peer.Kademlia.Service.Run(ctx))
+		return errs2.IgnoreCanceled(peer.Contact.Chore.Run(ctx))
 	})
 
+	if peer.Kademlia.Service != nil {
+		group.Go(func() error {
+			return errs2.IgnoreCanceled(peer.Kademlia.Service.Bootstrap(ctx))
+		})
+		group.Go(func() error {
+			return errs2.IgnoreCanceled(peer.Kademlia.Service.Run(ctx))
+		})
+	}
+
 	group.Go(func() error {
 		return errs2.IgnoreCanceled(peer.Collector.Run(ctx))
 	})
@@ -471,6 +528,12 @@ func (peer *Peer) Close() error {
 	if peer.Kademlia.Service != nil {
 		errlist.Add(peer.Kademlia.Service.Close())
 	}
+	if peer.Contact.Chore != nil {
+		errlist.Add(peer.Contact.Chore.Close())
+	}
+	if peer.Storage2.Trust != nil {
+		errlist.Add(peer.Storage2.Trust.Close())
+	}
 	if peer.Kademlia.RoutingTable != nil {
 		errlist.Add(peer.Kademlia.RoutingTable.Close())
 	}
@@ -491,7 +554,7 @@ func (peer *Peer) Close() error {
 func (peer *Peer) ID() storj.NodeID { return peer.Identity.ID }
 
 // Local returns the peer local node info.
-func (peer *Peer) Local() overlay.NodeDossier { return peer.Kademlia.RoutingTable.Local() }
+func (peer *Peer) Local() overlay.NodeDossier { return peer.Contact.Service.Local() }
 
 // Addr returns the public address.
 func (peer *Peer) Addr() string { return peer.Server.Addr().String() }
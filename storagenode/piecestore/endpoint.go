@@ -221,12 +221,12 @@ func (endpoint *Endpoint) Upload(stream pb.Piecestore_UploadServer) (err error)
 		}
 	}()
 
-	availableBandwidth, err := endpoint.monitor.AvailableBandwidth(ctx)
+	availableBandwidth, err := endpoint.monitor.AvailableBandwidthForSatellite(ctx, limit.SatelliteId)
 	if err != nil {
 		return ErrInternal.Wrap(err)
 	}
 
-	availableSpace, err := endpoint.monitor.AvailableSpace(ctx)
+	availableSpace, err := endpoint.monitor.AvailableSpaceForSatellite(ctx, limit.SatelliteId)
 	if err != nil {
 		return ErrInternal.Wrap(err)
 	}
@@ -453,7 +453,7 @@ func (endpoint *Endpoint) Download(stream pb.Piecestore_DownloadServer) (err err
 		return Error.New("requested more data than available, requesting=%v available=%v", chunk.Offset+chunk.ChunkSize, pieceReader.Size())
 	}
 
-	availableBandwidth, err := endpoint.monitor.AvailableBandwidth(ctx)
+	availableBandwidth, err := endpoint.monitor.AvailableBandwidthForSatellite(ctx, limit.SatelliteId)
 	if err != nil {
 		endpoint.log.Error("error getting available bandwidth", zap.Error(err))
 		return status.Error(codes.Internal, err.Error())
@@ -15,6 +15,7 @@ import (
 	"storj.io/storj/internal/errs2"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/signing"
+	"storj.io/storj/storagenode/trust"
 )
 
 var (
@@ -54,11 +55,18 @@ func (endpoint *Endpoint) verifyOrderLimit(ctx context.Context, limit *pb.OrderL
 		return status.Errorf(codes.InvalidArgument, "missing piece id")
 	}
 
-	if err := endpoint.trust.VerifySatelliteID(ctx, limit.SatelliteId); err != nil {
-		return status.Errorf(codes.PermissionDenied, "untrusted: %+v", err)
+	// satellites which aren't trusted anymore can't upload new pieces, but
+	// the pieces they stored can be accessed until they are forgotten
+	if limit.Action == pb.PieceAction_PUT || limit.Action == pb.PieceAction_PUT_REPAIR {
+		if err := endpoint.trust.VerifySatelliteID(ctx, limit.SatelliteId); err != nil {
+			return status.Errorf(codes.PermissionDenied, "untrusted: %+v", err)
+		}
 	}
 
 	if err := endpoint.VerifyOrderLimitSignature(ctx, limit); err != nil {
+		if trust.ErrUntrusted.Has(err) {
+			return status.Errorf(codes.PermissionDenied, "untrusted: %+v", err)
+		}
 		if errs2.IsCanceled(err) {
 			return status.Error(codes.Canceled, "context has been canceled")
 		}
@@ -132,6 +140,9 @@ func (endpoint *Endpoint) VerifyOrderLimitSignature(ctx context.Context, limit *
 		if errs2.IsCanceled(err) {
 			return err
 		}
+		if trust.ErrUntrusted.Has(err) {
+			return ErrVerifyUntrusted.Wrap(err)
+		}
 		return ErrVerifyUntrusted.New("unable to get signee: %v", err) // TODO: report grpc status bad message
 	}
 
@@ -19,6 +19,7 @@ import (
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/signing"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/monitor"
 )
 
 const oneWeek = 7 * 24 * time.Hour
@@ -335,3 +336,64 @@ func setSpace(ctx context.Context, t *testing.T, planet *testplanet.Planet, spac
 		require.NoError(t, err)
 	}
 }
+
+func TestSatelliteAllocations(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 2, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		storageNode := planet.StorageNodes[0]
+		limited, unlimited := planet.Satellites[0], planet.Satellites[1]
+
+		storageNode.Storage2.Monitor.Loop.Pause()
+		storageNode.Storage2.Monitor.Config.Allocations = monitor.Allocations{
+			limited.ID(): {DiskSpace: 5 * memory.KiB, Bandwidth: 20 * memory.KiB},
+		}
+		storageNode.Storage2.Monitor.Loop.TriggerWait()
+
+		// satellites only see the capacity available to them
+		info, err := limited.Kademlia.Service.FetchInfo(ctx, storageNode.Local().Node)
+		require.NoError(t, err)
+		require.Equal(t, (5 * memory.KiB).Int64(), info.Capacity.FreeDisk)
+		require.Equal(t, (20 * memory.KiB).Int64(), info.Capacity.FreeBandwidth)
+
+		info, err = unlimited.Kademlia.Service.FetchInfo(ctx, storageNode.Local().Node)
+		require.NoError(t, err)
+		require.True(t, info.Capacity.FreeDisk > (5*memory.KiB).Int64())
+		require.True(t, info.Capacity.FreeBandwidth > (20*memory.KiB).Int64())
+
+		client, err := planet.Uplinks[0].DialPiecestore(ctx, storageNode)
+		require.NoError(t, err)
+		defer ctx.Check(client.Close)
+
+		upload := func(satellite *identity.FullIdentity, serialNumber storj.SerialNumber) error {
+			orderLimit, piecePrivateKey := GenerateOrderLimit(
+				t,
+				satellite.ID,
+				storageNode.ID(),
+				testrand.PieceID(),
+				pb.PieceAction_PUT,
+				serialNumber,
+				oneWeek,
+				oneWeek,
+				(10 * memory.KiB).Int64(),
+			)
+
+			orderLimit, err := signing.SignOrderLimit(ctx, signing.SignerFromFullIdentity(satellite), orderLimit)
+			require.NoError(t, err)
+
+			uploader, err := client.Upload(ctx, orderLimit, piecePrivateKey)
+			require.NoError(t, err)
+
+			_, writeErr := uploader.Write(testrand.Bytes(10 * memory.KiB))
+			_, commitErr := uploader.Commit(ctx)
+			return errs.Combine(writeErr, commitErr)
+		}
+
+		err = upload(limited.Identity, storj.SerialNumber{1})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "out of space")
+
+		err = upload(unlimited.Identity, storj.SerialNumber{2})
+		require.NoError(t, err)
+	})
+}
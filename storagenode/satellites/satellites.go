@@ -0,0 +1,21 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellites
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// DB works with the satellites the node works with
+type DB interface {
+	// SetAddedAt records when the node was first seen working with the
+	// satellite. An earlier recorded time isn't changed.
+	SetAddedAt(ctx context.Context, satelliteID storj.NodeID, addedAt time.Time) error
+	// GetAddedAt returns when the node was first seen working with the
+	// satellite, it's zero if the satellite wasn't seen yet
+	GetAddedAt(ctx context.Context, satelliteID storj.NodeID) (time.Time, error)
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellites_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestSatellitesDB(t *testing.T) {
+	storagenodedbtest.Run(t, func(t *testing.T, db storagenode.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		satellitesDB := db.Satellites()
+		satelliteID := testrand.NodeID()
+		addedAt := time.Now().UTC().Add(-time.Hour)
+
+		// unknown satellites weren't seen yet
+		seen, err := satellitesDB.GetAddedAt(ctx, satelliteID)
+		require.NoError(t, err)
+		assert.True(t, seen.IsZero())
+
+		require.NoError(t, satellitesDB.SetAddedAt(ctx, satelliteID, addedAt))
+		seen, err = satellitesDB.GetAddedAt(ctx, satelliteID)
+		require.NoError(t, err)
+		assert.True(t, addedAt.Equal(seen))
+
+		// a later time doesn't replace the first seen time
+		require.NoError(t, satellitesDB.SetAddedAt(ctx, satelliteID, addedAt.Add(time.Hour)))
+		seen, err = satellitesDB.GetAddedAt(ctx, satelliteID)
+		require.NoError(t, err)
+		assert.True(t, addedAt.Equal(seen))
+
+		// an earlier time does
+		require.NoError(t, satellitesDB.SetAddedAt(ctx, satelliteID, addedAt.Add(-time.Hour)))
+		seen, err = satellitesDB.GetAddedAt(ctx, satelliteID)
+		require.NoError(t, err)
+		assert.True(t, addedAt.Add(-time.Hour).Equal(seen))
+	})
+}
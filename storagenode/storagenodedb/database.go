@@ -32,6 +32,7 @@ import (
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/reputation"
+	"storj.io/storj/storagenode/satellites"
 	"storj.io/storj/storagenode/storageusage"
 )
 
@@ -97,6 +98,7 @@ type DB struct {
 	pieceExpirationDB *pieceExpirationDB
 	pieceSpaceUsedDB  *pieceSpaceUsedDB
 	reputationDB      *reputationDB
+	satellitesDB      *satellitesDB
 	storageUsageDB    *storageusageDB
 	usedSerialsDB     *usedSerialsDB
 
@@ -139,6 +141,7 @@ func New(log *zap.Logger, config Config) (*DB, error) {
 		pieceExpirationDB: newPieceExpirationDB(versionsDB, versionsPath),
 		pieceSpaceUsedDB:  newPieceSpaceUsedDB(versionsDB, versionsPath),
 		reputationDB:      newReputationDB(versionsDB, versionsPath),
+		satellitesDB:      newSatellitesDB(versionsDB, versionsPath),
 		storageUsageDB:    newStorageusageDB(versionsDB, versionsPath),
 		usedSerialsDB:     newUsedSerialsDB(versionsDB, versionsPath),
 	}
@@ -177,6 +180,7 @@ func NewTest(log *zap.Logger, storageDir string) (*DB, error) {
 		pieceExpirationDB: newPieceExpirationDB(versionsDB, versionsPath),
 		pieceSpaceUsedDB:  newPieceSpaceUsedDB(versionsDB, versionsPath),
 		reputationDB:      newReputationDB(versionsDB, versionsPath),
+		satellitesDB:      newSatellitesDB(versionsDB, versionsPath),
 		storageUsageDB:    newStorageusageDB(versionsDB, versionsPath),
 		usedSerialsDB:     newUsedSerialsDB(versionsDB, versionsPath),
 	}
@@ -241,6 +245,7 @@ func (db *DB) Close() error {
 		db.pieceExpirationDB.Close(),
 		db.pieceSpaceUsedDB.Close(),
 		db.reputationDB.Close(),
+		db.satellitesDB.Close(),
 		db.storageUsageDB.Close(),
 		db.usedSerialsDB.Close(),
 	)
@@ -296,6 +301,11 @@ func (db *DB) Reputation() reputation.DB {
 	return db.reputationDB
 }
 
+// Satellites returns the instance of the Satellites database.
+func (db *DB) Satellites() satellites.DB {
+	return db.satellitesDB
+}
+
 // StorageUsage returns the instance of the StorageUsage database.
 func (db *DB) StorageUsage() storageusage.DB {
 	return db.storageUsageDB
@@ -673,6 +683,26 @@ func (db *DB) Migration() *migrate.Migration {
 					);`,
 				},
 			},
+			{
+				Description: "Add satellites table with the first seen time of the satellites",
+				Version:     20,
+				Action: migrate.SQL{
+					`CREATE TABLE satellites (
+						node_id BLOB NOT NULL,
+						added_at TIMESTAMP NOT NULL,
+						PRIMARY KEY (node_id)
+					)`,
+					// the first seen time of known satellites is their earliest usage
+					`INSERT INTO satellites (node_id, added_at)
+						SELECT satellite_id, MIN(added_at) FROM (
+							SELECT satellite_id, created_at AS added_at FROM bandwidth_usage
+							UNION ALL
+							SELECT satellite_id, interval_start AS added_at FROM bandwidth_usage_rollups
+							UNION ALL
+							SELECT satellite_id, timestamp AS added_at FROM storage_usage
+						) GROUP BY satellite_id`,
+				},
+			},
 		},
 	}
 }
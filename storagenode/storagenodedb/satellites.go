@@ -0,0 +1,62 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/satellites"
+)
+
+// ErrSatellites represents errors from the satellites database.
+var ErrSatellites = errs.Class("satellites error")
+
+// satellitesDB works with the satellites the node works with
+type satellitesDB struct {
+	location string
+	SQLDB
+}
+
+var _ satellites.DB = (*satellitesDB)(nil)
+
+// newSatellitesDB returns a new instance of satellitesDB initialized with the specified database.
+func newSatellitesDB(db SQLDB, location string) *satellitesDB {
+	return &satellitesDB{
+		location: location,
+		SQLDB:    db,
+	}
+}
+
+// SetAddedAt records when the node was first seen working with the
+// satellite, unless an earlier time is recorded already
+func (db *satellitesDB) SetAddedAt(ctx context.Context, satelliteID storj.NodeID, addedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.ExecContext(ctx,
+		`INSERT INTO satellites (node_id, added_at) VALUES (?, ?)
+			ON CONFLICT (node_id) DO UPDATE SET added_at = MIN(added_at, excluded.added_at)`,
+		satelliteID, addedAt.UTC(),
+	)
+	return ErrSatellites.Wrap(err)
+}
+
+// GetAddedAt returns when the node was first seen working with the satellite
+func (db *satellitesDB) GetAddedAt(ctx context.Context, satelliteID storj.NodeID) (_ time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var addedAt time.Time
+	err = db.QueryRowContext(ctx,
+		`SELECT added_at FROM satellites WHERE node_id = ?`,
+		satelliteID,
+	).Scan(&addedAt)
+	if err == sql.ErrNoRows {
+		return time.Time{}, nil
+	}
+	return addedAt, ErrSatellites.Wrap(err)
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package trust
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// cache is the state of the pool which is kept across restarts, so that the
+// satellites of unreachable sources stay trusted and the grace period of
+// untrusted satellites isn't restarted.
+type cache struct {
+	// Lists are the last fetched lists by source
+	Lists map[string][]storj.NodeURL `json:"lists"`
+	// Trusted are the trusted satellites
+	Trusted []storj.NodeURL `json:"trusted"`
+	// Untrusted are the satellites which aren't trusted anymore
+	Untrusted []untrustedEntry `json:"untrusted"`
+}
+
+// untrustedEntry is a satellite which isn't trusted since Since
+type untrustedEntry struct {
+	URL   storj.NodeURL `json:"url"`
+	Since time.Time     `json:"since"`
+}
+
+// loadCache loads the cache at path. It returns an empty cache when the file
+// doesn't exist.
+func loadCache(path string) (*cache, error) {
+	loaded := &cache{}
+	if path == "" {
+		return loaded, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return loaded, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+	if err := json.Unmarshal(data, loaded); err != nil {
+		return nil, Error.New("invalid cache %s: %v", path, err)
+	}
+	return loaded, nil
+}
+
+// save writes the cache to path, replacing the file atomically.
+func (cache *cache) save(path string) error {
+	if path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(cache, "", "\t")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return Error.Wrap(err)
+	}
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(os.Rename(tmp, path))
+}
@@ -5,13 +5,20 @@ package trust
 
 import (
 	"context"
+	"crypto"
+	"io/ioutil"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/zeebo/errs"
+	"go.uber.org/zap"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pkcrypto"
 	"storj.io/storj/pkg/signing"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
@@ -20,14 +27,38 @@ import (
 // Error is the default error class
 var Error = errs.Class("trust:")
 
+// ErrUntrusted is the error class for satellites which aren't trusted
+var ErrUntrusted = errs.Class("untrusted satellite")
+
 var mon = monkit.Package()
 
+// Config defines the sources of trusted satellites.
+type Config struct {
+	Sources         string        `help:"comma-separated list of trust sources: satellite node urls, paths of files or http(s) urls of lists with one satellite node url per line" default:""`
+	Exclusions      string        `help:"comma-separated list of trust exclusions: satellite node ids, node urls or hosts" default:""`
+	KeyPath         string        `help:"path of the PEM encoded public key lists fetched over http(s) have to be signed with, the signature is fetched from the list url with the .sig suffix" default:""`
+	RefreshInterval time.Duration `help:"how often the trust sources are refreshed" releaseDefault:"6h0m0s" devDefault:"1m0s"`
+	RequestTimeout  time.Duration `help:"timeout for fetching a list over http(s)" default:"1m0s"`
+	CachePath       string        `help:"file where the trusted and untrusted satellites are kept across restarts" default:"$CONFDIR/trust-cache.json"`
+}
+
 // Pool implements different peer verifications.
 type Pool struct {
-	mu        sync.RWMutex
-	transport transport.Client
+	log        *zap.Logger
+	mu         sync.RWMutex
+	transport  transport.Client
+	sources    []Source
+	exclusions []Exclusion
+	cachePath  string
 
+	// lists are the last fetched lists by source
+	lists             map[string][]storj.NodeURL
 	trustedSatellites map[storj.NodeID]*satelliteInfoCache
+	// untrustedSatellites were trusted before. Their pieces can still be
+	// downloaded and their orders settled until they are forgotten.
+	untrustedSatellites map[storj.NodeID]*untrustedSatellite
+
+	Loop *sync2.Cycle
 }
 
 // satelliteInfoCache caches identity information about a satellite
@@ -37,21 +68,200 @@ type satelliteInfoCache struct {
 	identity *identity.PeerIdentity
 }
 
-// NewPool creates a new trust pool of the specified list of trusted satellites.
-func NewPool(transport transport.Client, trustedSatellites storj.NodeURLs) (*Pool, error) {
+// untrustedSatellite is a satellite which isn't trusted since since
+type untrustedSatellite struct {
+	info  *satelliteInfoCache
+	since time.Time
+}
+
+// NewPool creates a new trust pool of the trusted satellites of the sources.
+// The satellites of the sources which aren't local are loaded from the cache
+// until the pool is refreshed.
+func NewPool(log *zap.Logger, transport transport.Client, config Config) (*Pool, error) {
 	// TODO: preload all satellite peer identities
 
-	// parse the comma separated list of approved satellite IDs into an array of storj.NodeIDs
+	var key crypto.PublicKey
+	if config.KeyPath != "" {
+		keyPEM, err := ioutil.ReadFile(config.KeyPath)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		key, err = pkcrypto.PublicKeyFromPEM(keyPEM)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	pool := &Pool{
+		log:       log,
+		transport: transport,
+		cachePath: config.CachePath,
+
+		lists:               make(map[string][]storj.NodeURL),
+		trustedSatellites:   make(map[storj.NodeID]*satelliteInfoCache),
+		untrustedSatellites: make(map[storj.NodeID]*untrustedSatellite),
+
+		Loop: sync2.NewCycle(config.RefreshInterval),
+	}
+
+	for _, source := range splitList(config.Sources) {
+		parsed, err := NewSource(source, key, config.RequestTimeout)
+		if err != nil {
+			return nil, err
+		}
+		pool.sources = append(pool.sources, parsed)
+	}
+	for _, exclusion := range splitList(config.Exclusions) {
+		parsed, err := NewExclusion(exclusion)
+		if err != nil {
+			return nil, err
+		}
+		pool.exclusions = append(pool.exclusions, parsed)
+	}
+
+	cached, err := loadCache(config.CachePath)
+	if err != nil {
+		return nil, err
+	}
+	for _, url := range cached.Trusted {
+		pool.trustedSatellites[url.ID] = &satelliteInfoCache{url: url}
+	}
+	for _, entry := range cached.Untrusted {
+		pool.untrustedSatellites[entry.URL.ID] = &untrustedSatellite{
+			info:  &satelliteInfoCache{url: entry.URL},
+			since: entry.Since,
+		}
+	}
+
+	lists := make(map[string][]storj.NodeURL)
+	for _, source := range pool.sources {
+		// static sources can't fail, others are loaded from the cache
+		if static, ok := source.(staticSource); ok {
+			lists[source.String()] = []storj.NodeURL{static.url}
+			continue
+		}
+		lists[source.String()] = cached.Lists[source.String()]
+	}
+	pool.update(lists, time.Now())
+
+	return pool, nil
+}
+
+// splitList splits a comma-separated list and drops empty entries
+func splitList(list string) (entries []string) {
+	for _, entry := range strings.Split(list, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// Run refreshes the pool on every interval.
+func (pool *Pool) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return pool.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := pool.Refresh(ctx); err != nil {
+			pool.log.Warn("refreshing trusted satellites failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops refreshing the pool.
+func (pool *Pool) Close() error {
+	pool.Loop.Close()
+	return nil
+}
+
+// Refresh fetches the lists of all sources and updates the trusted
+// satellites. The last list of a source which fails is kept.
+func (pool *Pool) Refresh(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	lists := make(map[string][]storj.NodeURL)
+	for _, source := range pool.sources {
+		list, err := source.Fetch(ctx)
+		if err != nil {
+			group.Add(Error.New("fetching %s failed: %v", source.String(), err))
+
+			pool.mu.RLock()
+			list = pool.lists[source.String()]
+			pool.mu.RUnlock()
+		}
+		lists[source.String()] = list
+	}
+
+	pool.mu.Lock()
+	pool.update(lists, time.Now())
+	cache := pool.cache()
+	pool.mu.Unlock()
+
+	group.Add(cache.save(pool.cachePath))
+	return group.Err()
+}
+
+// update replaces the trusted satellites with the satellites of lists which
+// aren't excluded. The satellites which aren't trusted anymore are
+// remembered as untrusted since now.
+func (pool *Pool) update(lists map[string][]storj.NodeURL, now time.Time) {
 	trusted := make(map[storj.NodeID]*satelliteInfoCache)
+	for _, source := range pool.sources {
+		for _, url := range lists[source.String()] {
+			if _, ok := trusted[url.ID]; ok || pool.excluded(url) {
+				continue
+			}
+
+			// keep the cached identity
+			info, ok := pool.trustedSatellites[url.ID]
+			if !ok || info.url != url {
+				info = &satelliteInfoCache{url: url}
+			}
+			trusted[url.ID] = info
+
+			if _, ok := pool.untrustedSatellites[url.ID]; ok {
+				pool.log.Info("satellite is trusted again", zap.Stringer("satellite", url.ID))
+				delete(pool.untrustedSatellites, url.ID)
+			}
+		}
+	}
 
-	for _, node := range trustedSatellites {
-		trusted[node.ID] = &satelliteInfoCache{url: node}
+	for id, info := range pool.trustedSatellites {
+		if _, ok := trusted[id]; !ok {
+			pool.log.Info("satellite is not trusted anymore", zap.Stringer("satellite", id))
+			pool.untrustedSatellites[id] = &untrustedSatellite{info: info, since: now}
+		}
 	}
 
-	return &Pool{
-		transport:         transport,
-		trustedSatellites: trusted,
-	}, nil
+	pool.lists = lists
+	pool.trustedSatellites = trusted
+}
+
+// excluded returns whether any exclusion excludes the satellite
+func (pool *Pool) excluded(url storj.NodeURL) bool {
+	for _, exclusion := range pool.exclusions {
+		if exclusion.Excludes(url) {
+			return true
+		}
+	}
+	return false
+}
+
+// cache returns the state of the pool which is kept across restarts
+func (pool *Pool) cache() *cache {
+	cache := &cache{Lists: pool.lists}
+	for _, info := range pool.trustedSatellites {
+		cache.Trusted = append(cache.Trusted, info.url)
+	}
+	for _, untrusted := range pool.untrustedSatellites {
+		cache.Untrusted = append(cache.Untrusted, untrustedEntry{
+			URL:   untrusted.info.url,
+			Since: untrusted.since,
+		})
+	}
+	return cache
 }
 
 // VerifySatelliteID checks whether id corresponds to a trusted satellite.
@@ -63,23 +273,35 @@ func (pool *Pool) VerifySatelliteID(ctx context.Context, id storj.NodeID) (err e
 
 	_, ok := pool.trustedSatellites[id]
 	if !ok {
-		return Error.New("satellite %q is untrusted", id)
+		return Error.Wrap(ErrUntrusted.New("satellite %q is untrusted", id))
 	}
 	return nil
 }
 
-// GetSignee gets the corresponding signee for verifying signatures.
+// lookup returns the information about a trusted or untrusted satellite.
+func (pool *Pool) lookup(id storj.NodeID) (*satelliteInfoCache, bool) {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	if info, ok := pool.trustedSatellites[id]; ok {
+		return info, true
+	}
+	if untrusted, ok := pool.untrustedSatellites[id]; ok {
+		return untrusted.info, true
+	}
+	return nil, false
+}
+
+// GetSignee gets the corresponding signee for verifying signatures. Signees
+// of untrusted satellites which haven't been forgotten are returned as well.
 // It ignores passed in ctx cancellation to avoid miscaching between concurrent requests.
 func (pool *Pool) GetSignee(ctx context.Context, id storj.NodeID) (_ signing.Signee, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	// lookup peer identity with id
-	pool.mu.RLock()
-	info, ok := pool.trustedSatellites[id]
-	pool.mu.RUnlock()
-
+	info, ok := pool.lookup(id)
 	if !ok {
-		return nil, Error.New("signee %q is untrusted", id)
+		return nil, Error.Wrap(ErrUntrusted.New("signee %q is untrusted", id))
 	}
 
 	info.mu.Lock()
@@ -111,22 +333,54 @@ func (pool *Pool) FetchPeerIdentity(ctx context.Context, url storj.NodeURL) (_ *
 // GetSatellites returns a slice containing all trusted satellites
 func (pool *Pool) GetSatellites(ctx context.Context) (satellites []storj.NodeID) {
 	defer mon.Task()(&ctx)(nil)
+
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
 	for sat := range pool.trustedSatellites {
 		satellites = append(satellites, sat)
 	}
 	return satellites
 }
 
-// GetAddress returns the address of a satellite in the trusted list
-func (pool *Pool) GetAddress(ctx context.Context, id storj.NodeID) (_ string, err error) {
-	defer mon.Task()(&ctx)(&err)
+// GetUntrusted returns the untrusted satellites which haven't been forgotten
+// and since when they aren't trusted.
+func (pool *Pool) GetUntrusted(ctx context.Context) (untrusted map[storj.NodeID]time.Time) {
+	defer mon.Task()(&ctx)(nil)
 
 	pool.mu.RLock()
 	defer pool.mu.RUnlock()
 
-	info, ok := pool.trustedSatellites[id]
+	untrusted = make(map[storj.NodeID]time.Time, len(pool.untrustedSatellites))
+	for id, satellite := range pool.untrustedSatellites {
+		untrusted[id] = satellite.since
+	}
+	return untrusted
+}
+
+// Forget forgets an untrusted satellite, e.g. after its pieces were deleted.
+func (pool *Pool) Forget(ctx context.Context, id storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pool.mu.Lock()
+	if _, ok := pool.untrustedSatellites[id]; !ok {
+		pool.mu.Unlock()
+		return nil
+	}
+	delete(pool.untrustedSatellites, id)
+	cache := pool.cache()
+	pool.mu.Unlock()
+
+	return cache.save(pool.cachePath)
+}
+
+// GetAddress returns the address of a trusted or untrusted satellite
+func (pool *Pool) GetAddress(ctx context.Context, id storj.NodeID) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, ok := pool.lookup(id)
 	if !ok {
-		return "", Error.New("ID %v not found in trusted list", id)
+		return "", Error.Wrap(ErrUntrusted.New("ID %v not found in trusted list", id))
 	}
 	return info.url.Address, nil
 }
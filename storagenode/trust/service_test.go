@@ -6,15 +6,22 @@ package trust_test
 import (
 	"context"
 	"errors"
+	"io/ioutil"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/storj/internal/errs2"
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/trust"
 )
 
 func TestGetSignee(t *testing.T) {
@@ -89,3 +96,80 @@ func TestGetAddress(t *testing.T) {
 		assert.NoError(t, group.Wait())
 	})
 }
+
+func TestPoolRefresh(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	first := storj.NodeURL{ID: testrand.NodeID(), Address: "first.test:7777"}
+	second := storj.NodeURL{ID: testrand.NodeID(), Address: "second.test:7777"}
+	static := storj.NodeURL{ID: testrand.NodeID(), Address: "static.test:7777"}
+
+	list := ctx.File("trusted.txt")
+	writeList := func(urls ...storj.NodeURL) {
+		data := "# trusted satellites\n"
+		for _, url := range urls {
+			data += url.String() + "\n"
+		}
+		require.NoError(t, ioutil.WriteFile(list, []byte(data), 0644))
+	}
+	writeList(first, second)
+
+	config := trust.Config{
+		Sources:         list + "," + static.String(),
+		RefreshInterval: time.Hour,
+		CachePath:       ctx.File("cache.json"),
+	}
+
+	pool, err := trust.NewPool(zaptest.NewLogger(t), nil, config)
+	require.NoError(t, err)
+
+	// the list file isn't read until the pool is refreshed
+	assert.ElementsMatch(t, []storj.NodeID{static.ID}, pool.GetSatellites(ctx))
+
+	require.NoError(t, pool.Refresh(ctx))
+	assert.ElementsMatch(t, []storj.NodeID{first.ID, second.ID, static.ID}, pool.GetSatellites(ctx))
+	assert.Empty(t, pool.GetUntrusted(ctx))
+
+	// a satellite removed from the list isn't trusted anymore
+	writeList(first)
+	require.NoError(t, pool.Refresh(ctx))
+	assert.ElementsMatch(t, []storj.NodeID{first.ID, static.ID}, pool.GetSatellites(ctx))
+
+	err = pool.VerifySatelliteID(ctx, second.ID)
+	assert.True(t, trust.ErrUntrusted.Has(err))
+
+	untrusted := pool.GetUntrusted(ctx)
+	require.Contains(t, untrusted, second.ID)
+	since := untrusted[second.ID]
+
+	// the address of an untrusted satellite is still known
+	address, err := pool.GetAddress(ctx, second.ID)
+	require.NoError(t, err)
+	assert.Equal(t, second.Address, address)
+
+	// the last list is kept when the source fails
+	require.NoError(t, os.Remove(list))
+	assert.Error(t, pool.Refresh(ctx))
+	assert.ElementsMatch(t, []storj.NodeID{first.ID, static.ID}, pool.GetSatellites(ctx))
+
+	// the state is restored from the cache
+	restored, err := trust.NewPool(zaptest.NewLogger(t), nil, config)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []storj.NodeID{first.ID, static.ID}, restored.GetSatellites(ctx))
+	assert.True(t, since.Equal(restored.GetUntrusted(ctx)[second.ID]))
+
+	// forgotten satellites are unknown
+	require.NoError(t, restored.Forget(ctx, second.ID))
+	assert.Empty(t, restored.GetUntrusted(ctx))
+	_, err = restored.GetAddress(ctx, second.ID)
+	assert.True(t, trust.ErrUntrusted.Has(err))
+
+	// excluded satellites aren't trusted
+	config.Exclusions = "first.test," + static.ID.String()
+	excluded, err := trust.NewPool(zaptest.NewLogger(t), nil, config)
+	require.NoError(t, err)
+	assert.Empty(t, excluded.GetSatellites(ctx))
+	assert.Contains(t, excluded.GetUntrusted(ctx), first.ID)
+	assert.Contains(t, excluded.GetUntrusted(ctx), static.ID)
+}
@@ -0,0 +1,202 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package trust
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+)
+
+// Source is a source of trusted satellites.
+type Source interface {
+	// String returns the configured name of the source.
+	String() string
+	// Fetch returns the satellites the source trusts.
+	Fetch(ctx context.Context) ([]storj.NodeURL, error)
+}
+
+// NewSource parses a trust source, which is either a satellite node url, an
+// http(s) url of a list or the path of a list file. Lists fetched over plain
+// http have to be signed with key.
+func NewSource(source string, key crypto.PublicKey, timeout time.Duration) (Source, error) {
+	switch {
+	case strings.HasPrefix(source, "http://"), strings.HasPrefix(source, "https://"):
+		if key == nil && strings.HasPrefix(source, "http://") {
+			return nil, Error.New("http source %q requires a key to verify its signature", source)
+		}
+		return &httpSource{url: source, key: key, timeout: timeout}, nil
+	case strings.Contains(source, "@"):
+		url, err := parseSatelliteURL(source)
+		if err != nil {
+			return nil, err
+		}
+		return staticSource{url: url}, nil
+	default:
+		return fileSource{path: source}, nil
+	}
+}
+
+// staticSource is a single explicitly trusted satellite.
+type staticSource struct {
+	url storj.NodeURL
+}
+
+func (source staticSource) String() string { return source.url.String() }
+
+// Fetch returns the satellite.
+func (source staticSource) Fetch(ctx context.Context) ([]storj.NodeURL, error) {
+	return []storj.NodeURL{source.url}, nil
+}
+
+// fileSource is a local file listing trusted satellites.
+type fileSource struct {
+	path string
+}
+
+func (source fileSource) String() string { return source.path }
+
+// Fetch reads the list from the file.
+func (source fileSource) Fetch(ctx context.Context) (_ []storj.NodeURL, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := ioutil.ReadFile(source.path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return ParseList(data)
+}
+
+// httpSource is a list of trusted satellites served over http(s). When it
+// has a key, the list has to be signed with it and the signature is served
+// at the url of the list with the ".sig" suffix.
+type httpSource struct {
+	url     string
+	key     crypto.PublicKey
+	timeout time.Duration
+}
+
+func (source *httpSource) String() string { return source.url }
+
+// Fetch downloads the list and verifies its signature.
+func (source *httpSource) Fetch(ctx context.Context) (_ []storj.NodeURL, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := source.get(ctx, source.url)
+	if err != nil {
+		return nil, err
+	}
+
+	if source.key != nil {
+		signature, err := source.get(ctx, source.url+".sig")
+		if err != nil {
+			return nil, err
+		}
+		if err := pkcrypto.HashAndVerifySignature(source.key, data, signature); err != nil {
+			return nil, Error.New("invalid signature of %s: %v", source.url, err)
+		}
+	}
+
+	return ParseList(data)
+}
+
+// get returns the body of url
+func (source *httpSource) get(ctx context.Context, url string) (_ []byte, err error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	client := http.Client{Timeout: source.timeout}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, Error.New("fetching %s failed: %s", url, resp.Status)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	return data, Error.Wrap(err)
+}
+
+// ParseList parses a list of satellite node urls, one per line. Empty lines
+// and lines starting with # are ignored.
+func ParseList(data []byte) (urls []storj.NodeURL, err error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		url, err := parseSatelliteURL(line)
+		if err != nil {
+			return nil, err
+		}
+		urls = append(urls, url)
+	}
+	return urls, Error.Wrap(scanner.Err())
+}
+
+// parseSatelliteURL parses a node url, which has to contain the id and the
+// address of the satellite.
+func parseSatelliteURL(s string) (storj.NodeURL, error) {
+	url, err := storj.ParseNodeURL(s)
+	if err != nil {
+		return storj.NodeURL{}, Error.Wrap(err)
+	}
+	if url.ID.IsZero() || url.Address == "" {
+		return storj.NodeURL{}, Error.New("satellite url %q must contain the id and the address", s)
+	}
+	return url, nil
+}
+
+// Exclusion excludes satellites from the trusted satellites.
+type Exclusion struct {
+	id   storj.NodeID
+	host string
+}
+
+// NewExclusion parses an exclusion, which is either a satellite node id or
+// node url, which excludes the satellite, or a host, which excludes all
+// satellites at the host.
+func NewExclusion(exclusion string) (Exclusion, error) {
+	if strings.Contains(exclusion, "@") {
+		url, err := storj.ParseNodeURL(exclusion)
+		if err != nil {
+			return Exclusion{}, Error.Wrap(err)
+		}
+		return Exclusion{id: url.ID}, nil
+	}
+	if id, err := storj.NodeIDFromString(exclusion); err == nil {
+		return Exclusion{id: id}, nil
+	}
+	if exclusion == "" {
+		return Exclusion{}, Error.New("empty exclusion")
+	}
+	return Exclusion{host: exclusion}, nil
+}
+
+// Excludes returns whether the satellite is excluded.
+func (exclusion Exclusion) Excludes(url storj.NodeURL) bool {
+	if exclusion.host == "" {
+		return exclusion.id == url.ID
+	}
+	host, _, err := net.SplitHostPort(url.Address)
+	if err != nil {
+		host = url.Address
+	}
+	return strings.EqualFold(host, exclusion.host)
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package trust_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storagenode/trust"
+)
+
+func TestParseList(t *testing.T) {
+	first := storj.NodeURL{ID: testrand.NodeID(), Address: "first.test:7777"}
+	second := storj.NodeURL{ID: testrand.NodeID(), Address: "second.test:7777"}
+
+	urls, err := trust.ParseList([]byte("# satellites\n\n" + first.String() + "\n  " + second.String() + "  \n"))
+	require.NoError(t, err)
+	assert.Equal(t, []storj.NodeURL{first, second}, urls)
+
+	_, err = trust.ParseList([]byte(first.ID.String() + "\n"))
+	assert.Error(t, err)
+
+	_, err = trust.ParseList([]byte("@first.test:7777\n"))
+	assert.Error(t, err)
+}
+
+func TestHTTPSource(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	key, err := pkcrypto.GeneratePrivateKey()
+	require.NoError(t, err)
+	otherKey, err := pkcrypto.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	satellite := storj.NodeURL{ID: testrand.NodeID(), Address: "satellite.test:7777"}
+	list := []byte(satellite.String() + "\n")
+
+	signature, err := pkcrypto.HashAndSign(key, list)
+	require.NoError(t, err)
+	otherSignature, err := pkcrypto.HashAndSign(otherKey, list)
+	require.NoError(t, err)
+
+	files := map[string][]byte{
+		"/signed":     list,
+		"/signed.sig": signature,
+		"/forged":     list,
+		"/forged.sig": otherSignature,
+		"/unsigned":   list,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	publicKey := pkcrypto.PublicKeyFromPrivate(key)
+
+	// plain http lists have to be signed
+	_, err = trust.NewSource(server.URL+"/unsigned", nil, time.Second)
+	assert.Error(t, err)
+
+	source, err := trust.NewSource(server.URL+"/signed", publicKey, time.Second)
+	require.NoError(t, err)
+	urls, err := source.Fetch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []storj.NodeURL{satellite}, urls)
+
+	for _, path := range []string{"/forged", "/unsigned", "/missing"} {
+		source, err := trust.NewSource(server.URL+path, publicKey, time.Second)
+		require.NoError(t, err)
+		_, err = source.Fetch(ctx)
+		assert.Error(t, err, path)
+	}
+}
+
+func TestExclusion(t *testing.T) {
+	satellite := storj.NodeURL{ID: testrand.NodeID(), Address: "satellite.test:7777"}
+	other := storj.NodeURL{ID: testrand.NodeID(), Address: "other.test:7777"}
+
+	for _, exclusion := range []string{satellite.ID.String(), satellite.String(), "satellite.test", "SATELLITE.test"} {
+		parsed, err := trust.NewExclusion(exclusion)
+		require.NoError(t, err)
+		assert.True(t, parsed.Excludes(satellite), exclusion)
+		assert.False(t, parsed.Excludes(other), exclusion)
+	}
+}
@@ -33,8 +33,8 @@ type RSConfig struct {
 // EncryptionConfig is a configuration struct that keeps details about
 // encrypting segments
 type EncryptionConfig struct {
-	DataType int `help:"Type of encryption to use for content and metadata (2=AES-GCM, 3=SecretBox)" default:"2"`
-	PathType int `help:"Type of encryption to use for paths (1=Unencrypted, 2=AES-GCM, 3=SecretBox)" default:"2"`
+	DataType int `help:"Type of encryption to use for content and metadata (2=AES-256-GCM, 3=SecretBox, 4=ChaCha20-Poly1305)" default:"2"`
+	PathType int `help:"Type of encryption to use for paths (1=Unencrypted, 2=AES-256-GCM, 3=SecretBox, 4=ChaCha20-Poly1305)" default:"2"`
 }
 
 // ClientConfig is a configuration struct for the uplink that controls how
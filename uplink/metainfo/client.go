@@ -186,6 +186,28 @@ func (client *Client) DeleteSegment(ctx context.Context, bucket string, path sto
 	return response.GetAddressedLimits(), response.PrivateKey, nil
 }
 
+// MoveSegment moves a segment to newPath and replaces its metadata, keeping
+// its pieces
+func (client *Client) MoveSegment(ctx context.Context, bucket string, path storj.Path, segmentIndex int64, newPath storj.Path, metadata []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = client.client.MoveSegmentOld(ctx, &pb.SegmentMoveRequestOld{
+		Bucket:   []byte(bucket),
+		Path:     []byte(path),
+		Segment:  segmentIndex,
+		NewPath:  []byte(newPath),
+		Metadata: metadata,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storage.ErrKeyNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
 // ListSegments lists the available segments
 func (client *Client) ListSegments(ctx context.Context, bucket string, prefix, startAfter, endBefore storj.Path, recursive bool, limit int32, metaFlags uint32) (items []ListItem, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -235,6 +257,15 @@ func (client *Client) GetProjectInfo(ctx context.Context) (resp *pb.ProjectInfoR
 	return client.client.ProjectInfo(ctx, &pb.ProjectInfoRequest{})
 }
 
+// RevokeAPIKey revokes the given serialized api key and every key derived from it.
+// The api key of the client must be the revoked key itself or one of its ancestors.
+func (client *Client) RevokeAPIKey(ctx context.Context, apiKey []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = client.client.RevokeAPIKey(ctx, &pb.RevokeAPIKeyRequest{ApiKey: apiKey})
+	return Error.Wrap(err)
+}
+
 // CreateBucketParams parameters for CreateBucket method
 type CreateBucketParams struct {
 	Name                        []byte
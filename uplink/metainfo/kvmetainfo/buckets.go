@@ -55,7 +55,7 @@ func (db *Project) CreateBucket(ctx context.Context, bucketName string, info *st
 		return storj.Bucket{}, storj.ErrBucket.Wrap(err)
 	}
 
-	if info.PathCipher < storj.EncNull || info.PathCipher > storj.EncSecretBox {
+	if info.PathCipher < storj.EncNull || info.PathCipher > storj.EncChaCha20Poly1305 {
 		return storj.Bucket{}, encryption.ErrInvalidConfig.New("encryption type %d is not supported", info.PathCipher)
 	}
 
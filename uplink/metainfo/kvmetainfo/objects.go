@@ -94,6 +94,7 @@ func (db *DB) CreateObject(ctx context.Context, bucket string, path storj.Path,
 		info.Expires = createInfo.Expires
 		info.RedundancyScheme = createInfo.RedundancyScheme
 		info.EncryptionParameters = createInfo.EncryptionParameters
+		info.Compression = createInfo.Compression
 	}
 
 	// TODO: autodetect content type from the path extension
@@ -314,8 +315,9 @@ func objectFromMeta(bucket storj.Bucket, path storj.Path, isPrefix bool, meta ob
 		Expires:     meta.Expiration,
 
 		Stream: storj.Stream{
-			Size:     meta.Size,
-			Checksum: []byte(meta.Checksum),
+			Size:        meta.Size,
+			Checksum:    meta.Checksum,
+			MD5Checksum: meta.MD5Checksum,
 		},
 	}
 }
@@ -353,8 +355,9 @@ func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segm
 		Expires:     lastSegment.Expiration, // TODO: use correct field
 
 		Stream: storj.Stream{
-			Size: stream.SegmentsSize*(numberOfSegments-1) + stream.LastSegmentSize,
-			// Checksum: []byte(object.Checksum),
+			Size:        streams.StreamSize(&stream, &streamMeta),
+			Checksum:    stream.Sha256Checksum,
+			MD5Checksum: stream.Md5Checksum,
 
 			SegmentCount:     numberOfSegments,
 			FixedSegmentSize: stream.SegmentsSize,
@@ -371,6 +374,7 @@ func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segm
 				CipherSuite: storj.CipherSuite(streamMeta.EncryptionType),
 				BlockSize:   streamMeta.EncryptionBlockSize,
 			},
+			Compression: storj.Compression(streamMeta.CompressionType),
 			LastSegment: storj.LastSegment{
 				Size:              stream.LastSegmentSize,
 				EncryptedKeyNonce: nonce,
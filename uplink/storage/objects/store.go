@@ -24,10 +24,11 @@ var mon = monkit.Package()
 // Meta is the full object metadata
 type Meta struct {
 	pb.SerializableMeta
-	Modified   time.Time
-	Expiration time.Time
-	Size       int64
-	Checksum   string
+	Modified    time.Time
+	Expiration  time.Time
+	Size        int64
+	Checksum    []byte
+	MD5Checksum []byte
 }
 
 // ListItem is a single item in a listing
@@ -103,7 +104,7 @@ func (o *objStore) Put(ctx context.Context, path storj.Path, data io.Reader, met
 	if err != nil {
 		return Meta{}, err
 	}
-	m, err := o.store.Put(ctx, path, o.pathCipher, data, b, expiration)
+	m, err := o.store.Put(ctx, path, o.pathCipher, data, b, expiration, storj.CompressionNone)
 	return convertMeta(m), err
 }
 
@@ -155,6 +156,8 @@ func convertMeta(m streams.Meta) Meta {
 		Modified:         m.Modified,
 		Expiration:       m.Expiration,
 		Size:             m.Size,
+		Checksum:         m.Checksum,
+		MD5Checksum:      m.MD5Checksum,
 		SerializableMeta: ser,
 	}
 }
@@ -47,6 +47,7 @@ type Store interface {
 	Get(ctx context.Context, path storj.Path) (rr ranger.Ranger, meta Meta, err error)
 	Put(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (storj.Path, []byte, error)) (meta Meta, err error)
 	Delete(ctx context.Context, path storj.Path) (err error)
+	Move(ctx context.Context, path, newPath storj.Path, metadata []byte) (err error)
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
 }
 
@@ -303,6 +304,31 @@ func (s *segmentStore) Delete(ctx context.Context, path storj.Path) (err error)
 	return nil
 }
 
+// Move moves the segment to newPath and replaces its metadata. The pieces of
+// remote segments stay on the storage nodes.
+func (s *segmentStore) Move(ctx context.Context, path, newPath storj.Path, metadata []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket, objectPath, segmentIndex, err := splitPathFragments(path)
+	if err != nil {
+		return err
+	}
+
+	newBucket, newObjectPath, newSegmentIndex, err := splitPathFragments(newPath)
+	if err != nil {
+		return err
+	}
+	if newBucket != bucket || newSegmentIndex != segmentIndex {
+		return Error.New("segments can only be moved within the same bucket and segment index")
+	}
+
+	err = s.metainfo.MoveSegment(ctx, bucket, objectPath, segmentIndex, newObjectPath, metadata)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
 // List retrieves paths to segments and their metadata stored in the metainfo
 func (s *segmentStore) List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
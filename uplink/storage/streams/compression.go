@@ -0,0 +1,242 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storj"
+)
+
+// compressionBlockSize is the size of the blocks compressed independently
+const compressionBlockSize = 1 * memory.MiB
+
+// compressReader compresses the data read from the underlying reader in
+// independently compressed blocks and keeps track of their sizes. Blocks
+// which don't get smaller by compression are stored as they are, so the
+// compressed data is never larger than the data.
+type compressReader struct {
+	data       io.Reader
+	block      []byte
+	writer     *gzip.Writer
+	compressed bytes.Buffer
+	buffer     bytes.Buffer
+	err        error
+	size       int64
+	blockSizes []int64
+}
+
+// newCompressReader returns a reader compressing data with the compression
+func newCompressReader(data io.Reader, compression storj.Compression, blockSize int) (*compressReader, error) {
+	if compression != storj.CompressionGzip {
+		return nil, errs.New("unsupported compression %d", compression)
+	}
+	return &compressReader{
+		data:   data,
+		block:  make([]byte, blockSize),
+		writer: gzip.NewWriter(nil),
+	}, nil
+}
+
+// Read reads the compressed data
+func (r *compressReader) Read(p []byte) (n int, err error) {
+	for r.buffer.Len() == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+
+		n, err := io.ReadFull(r.data, r.block)
+		if n > 0 {
+			if err := r.compress(r.block[:n]); err != nil {
+				r.err = err
+				return 0, err
+			}
+		}
+
+		switch err {
+		case nil:
+		case io.EOF, io.ErrUnexpectedEOF:
+			r.err = io.EOF
+		default:
+			r.err = err
+		}
+	}
+
+	return r.buffer.Read(p)
+}
+
+// compress compresses the block into the buffer, or copies it when it
+// doesn't get smaller
+func (r *compressReader) compress(block []byte) error {
+	r.compressed.Reset()
+	r.writer.Reset(&r.compressed)
+	if _, err := r.writer.Write(block); err != nil {
+		return err
+	}
+	if err := r.writer.Close(); err != nil {
+		return err
+	}
+
+	stored := r.compressed.Bytes()
+	if len(stored) >= len(block) {
+		stored = block
+	}
+	_, _ = r.buffer.Write(stored)
+
+	r.size += int64(len(block))
+	r.blockSizes = append(r.blockSizes, int64(len(stored)))
+	return nil
+}
+
+// Size returns the number of uncompressed bytes read so far
+func (r *compressReader) Size() int64 { return r.size }
+
+// BlockSizes returns the sizes of the compressed blocks so far
+func (r *compressReader) BlockSizes() []int64 { return r.blockSizes }
+
+// decompressRanger decompresses ranges of data compressed in blocks
+type decompressRanger struct {
+	rr        ranger.Ranger
+	size      int64
+	blockSize int64
+	// offsets are the offsets of the compressed blocks in rr followed by
+	// the size of rr
+	offsets []int64
+}
+
+// newDecompressRanger returns a ranger over the size bytes of uncompressed
+// data of rr, which consists of the blocks with the block sizes
+func newDecompressRanger(rr ranger.Ranger, compression storj.Compression, blockSize int64, size int64, blockSizes []int64) (ranger.Ranger, error) {
+	if compression != storj.CompressionGzip {
+		return nil, errs.New("unsupported compression %d", compression)
+	}
+	if blockSize <= 0 {
+		return nil, errs.New("invalid compression block size %d", blockSize)
+	}
+	if expected := (size + blockSize - 1) / blockSize; int64(len(blockSizes)) != expected {
+		return nil, errs.New("invalid number of compressed blocks %d, expected %d", len(blockSizes), expected)
+	}
+
+	offsets := make([]int64, len(blockSizes)+1)
+	for i, compressed := range blockSizes {
+		offsets[i+1] = offsets[i] + compressed
+	}
+	if offsets[len(blockSizes)] != rr.Size() {
+		return nil, errs.New("compressed blocks don't match the segment size")
+	}
+
+	return &decompressRanger{
+		rr:        rr,
+		size:      size,
+		blockSize: blockSize,
+		offsets:   offsets,
+	}, nil
+}
+
+// Size implements Ranger.Size
+func (r *decompressRanger) Size() int64 { return r.size }
+
+// Range implements Ranger.Range by downloading and decompressing only the
+// blocks overlapping the range
+func (r *decompressRanger) Range(ctx context.Context, offset, length int64) (_ io.ReadCloser, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if offset < 0 {
+		return nil, errs.New("negative offset")
+	}
+	if length < 0 {
+		return nil, errs.New("negative length")
+	}
+	if offset+length > r.size {
+		return nil, errs.New("range beyond end")
+	}
+	if length == 0 {
+		return ioutil.NopCloser(bytes.NewReader(nil)), nil
+	}
+
+	first := offset / r.blockSize
+	last := (offset + length - 1) / r.blockSize
+
+	compressed, err := r.rr.Range(ctx, r.offsets[first], r.offsets[last+1]-r.offsets[first])
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := &blockReader{compressed: compressed}
+	for i := first; i <= last; i++ {
+		size := r.blockSize
+		if remaining := r.size - i*r.blockSize; remaining < size {
+			size = remaining
+		}
+		blocks.blocks = append(blocks.blocks, compressedBlock{
+			stored: r.offsets[i+1] - r.offsets[i],
+			size:   size,
+		})
+	}
+
+	// skip the beginning of the first block
+	if _, err := io.CopyN(ioutil.Discard, blocks, offset-first*r.blockSize); err != nil {
+		return nil, errs.Combine(err, compressed.Close())
+	}
+
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(blocks, length), compressed}, nil
+}
+
+// compressedBlock is the stored size of a block together with its size
+// before compression. Blocks of the same size are stored uncompressed.
+type compressedBlock struct {
+	stored int64
+	size   int64
+}
+
+// blockReader decompresses consecutive blocks
+type blockReader struct {
+	compressed io.Reader
+	blocks     []compressedBlock
+	current    io.Reader
+}
+
+// Read reads the decompressed data
+func (r *blockReader) Read(p []byte) (n int, err error) {
+	for {
+		if r.current == nil {
+			if len(r.blocks) == 0 {
+				return 0, io.EOF
+			}
+			next := r.blocks[0]
+			r.blocks = r.blocks[1:]
+
+			block := io.LimitReader(r.compressed, next.stored)
+			if next.stored == next.size {
+				r.current = block
+			} else {
+				r.current, err = gzip.NewReader(block)
+				if err != nil {
+					return 0, err
+				}
+			}
+		}
+
+		n, err = r.current.Read(p)
+		if err == io.EOF {
+			r.current = nil
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		return n, err
+	}
+}
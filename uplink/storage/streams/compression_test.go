@@ -0,0 +1,97 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestCompressionRanges(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	const blockSize = 1024
+
+	// compressible data with some random blocks in between
+	var data []byte
+	for i := 0; i < 10; i++ {
+		data = append(data, bytes.Repeat([]byte("storj "), 100)...)
+		data = append(data, testrand.BytesInt(blockSize/2)...)
+	}
+	data = append(data, 'x')
+
+	compressor, err := newCompressReader(bytes.NewReader(data), storj.CompressionGzip, blockSize)
+	require.NoError(t, err)
+
+	compressed, err := ioutil.ReadAll(compressor)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(data)), compressor.Size())
+	assert.Len(t, compressor.BlockSizes(), (len(data)+blockSize-1)/blockSize)
+	assert.True(t, len(compressed) < len(data))
+
+	rr, err := newDecompressRanger(ranger.ByteRanger(compressed), storj.CompressionGzip, blockSize, compressor.Size(), compressor.BlockSizes())
+	require.NoError(t, err)
+	require.Equal(t, int64(len(data)), rr.Size())
+
+	for _, r := range []struct{ offset, length int64 }{
+		{0, int64(len(data))},
+		{0, 0},
+		{0, 1},
+		{blockSize - 1, 2},
+		{blockSize, blockSize},
+		{100, 3 * blockSize},
+		{int64(len(data)) - 1, 1},
+	} {
+		rc, err := rr.Range(ctx, r.offset, r.length)
+		require.NoError(t, err)
+		got, err := ioutil.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		assert.Equal(t, data[r.offset:r.offset+r.length], got, "range %d+%d", r.offset, r.length)
+	}
+
+	_, err = rr.Range(ctx, 0, int64(len(data))+1)
+	assert.Error(t, err)
+
+	_, err = newDecompressRanger(ranger.ByteRanger(compressed), storj.CompressionGzip, blockSize, compressor.Size()+blockSize, compressor.BlockSizes())
+	assert.Error(t, err)
+
+	// blocks which don't compress are stored as they are
+	random := testrand.BytesInt(2*blockSize + 10)
+	incompressible, err := newCompressReader(bytes.NewReader(random), storj.CompressionGzip, blockSize)
+	require.NoError(t, err)
+	compressed, err = ioutil.ReadAll(incompressible)
+	require.NoError(t, err)
+	assert.Equal(t, random, compressed)
+	assert.Equal(t, []int64{blockSize, blockSize, 10}, incompressible.BlockSizes())
+
+	rr, err = newDecompressRanger(ranger.ByteRanger(compressed), storj.CompressionGzip, blockSize, incompressible.Size(), incompressible.BlockSizes())
+	require.NoError(t, err)
+	rc, err := rr.Range(ctx, blockSize-5, blockSize+10)
+	require.NoError(t, err)
+	got, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	require.NoError(t, rc.Close())
+	assert.Equal(t, random[blockSize-5:2*blockSize+5], got)
+
+	_, err = newCompressReader(bytes.NewReader(data), storj.Compression(100), blockSize)
+	assert.Error(t, err)
+
+	empty, err := newCompressReader(bytes.NewReader(nil), storj.CompressionGzip, blockSize)
+	require.NoError(t, err)
+	compressed, err = ioutil.ReadAll(empty)
+	require.NoError(t, err)
+	assert.Empty(t, compressed)
+	assert.Empty(t, empty.BlockSizes())
+}
@@ -0,0 +1,252 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+	"storj.io/storj/uplink/storage/meta"
+)
+
+// Rekey re-encrypts the path of the stream with the keys of the encryption
+// store to and re-wraps the content keys of its segments with the content
+// key derived from to. The segments are moved to their new paths, but their
+// data isn't downloaded or re-uploaded.
+//
+// Rekey can be called again on a stream whose rekeying was interrupted:
+// segments that were already moved are skipped.
+func (s *streamStore) Rekey(ctx context.Context, path Path, pathCipher storj.CipherSuite, to *encryption.Store) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	encPath, err := encryption.EncryptPath(path.Bucket(), path.UnencryptedPath(), pathCipher, s.encStore)
+	if err != nil {
+		return err
+	}
+	newEncPath, err := encryption.EncryptPath(path.Bucket(), path.UnencryptedPath(), pathCipher, to)
+	if err != nil {
+		return err
+	}
+
+	derivedKey, err := encryption.DeriveContentKey(path.Bucket(), path.UnencryptedPath(), s.encStore)
+	if err != nil {
+		return err
+	}
+	newDerivedKey, err := encryption.DeriveContentKey(path.Bucket(), path.UnencryptedPath(), to)
+	if err != nil {
+		return err
+	}
+
+	lastSegmentPath, err := createSegmentPath(ctx, -1, path.Bucket(), encPath)
+	if err != nil {
+		return err
+	}
+	newLastSegmentPath, err := createSegmentPath(ctx, -1, path.Bucket(), newEncPath)
+	if err != nil {
+		return err
+	}
+
+	lastSegmentMeta, err := s.segments.Meta(ctx, lastSegmentPath)
+	if err != nil {
+		return err
+	}
+
+	var streamMeta pb.StreamMeta
+	if err := proto.Unmarshal(lastSegmentMeta.Data, &streamMeta); err != nil {
+		return err
+	}
+	cipher := storj.CipherSuite(streamMeta.EncryptionType)
+
+	contentKey, newLastSegmentMeta, err := rewrapKey(streamMeta.LastSegmentMeta, cipher, derivedKey, newDerivedKey)
+	if err != nil {
+		return err
+	}
+
+	segmentCount := streamMeta.NumberOfSegments
+	if segmentCount == 0 {
+		// decrypt metadata with the content encryption key and zero nonce
+		streamInfo, err := encryption.Decrypt(streamMeta.EncryptedStreamInfo, cipher, contentKey, &storj.Nonce{})
+		if err != nil {
+			return err
+		}
+		var stream pb.StreamInfo
+		if err := proto.Unmarshal(streamInfo, &stream); err != nil {
+			return err
+		}
+		segmentCount = stream.DeprecatedNumberOfSegments
+	}
+
+	// the last segment is moved last, so that the stream stays listed under
+	// the old path until all of its segments were moved
+	for i := int64(0); i < segmentCount-1; i++ {
+		segmentPath, err := createSegmentPath(ctx, i, path.Bucket(), encPath)
+		if err != nil {
+			return err
+		}
+		newSegmentPath, err := createSegmentPath(ctx, i, path.Bucket(), newEncPath)
+		if err != nil {
+			return err
+		}
+
+		segmentMeta, err := s.segments.Meta(ctx, segmentPath)
+		if err != nil {
+			if storage.ErrKeyNotFound.Has(err) && segmentPath != newSegmentPath {
+				// moved by an interrupted rekeying
+				continue
+			}
+			return err
+		}
+
+		var newMetadata []byte
+		if len(segmentMeta.Data) > 0 {
+			var keyMeta pb.SegmentMeta
+			if err := proto.Unmarshal(segmentMeta.Data, &keyMeta); err != nil {
+				return err
+			}
+			_, newKeyMeta, err := rewrapKey(&keyMeta, cipher, derivedKey, newDerivedKey)
+			if err != nil {
+				return err
+			}
+			newMetadata, err = proto.Marshal(newKeyMeta)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = s.segments.Move(ctx, segmentPath, newSegmentPath, newMetadata)
+		if err != nil {
+			return err
+		}
+	}
+
+	streamMeta.LastSegmentMeta = newLastSegmentMeta
+	newMetadata, err := proto.Marshal(&streamMeta)
+	if err != nil {
+		return err
+	}
+
+	return s.segments.Move(ctx, lastSegmentPath, newLastSegmentPath, newMetadata)
+}
+
+// Rotate rekeys all streams under prefix for the encryption store to, see
+// Rekey. The streams are listed before any of them is rekeyed. Streams whose
+// paths are already encrypted with to, because an earlier rotation was
+// interrupted, are skipped.
+func (s *streamStore) Rotate(ctx context.Context, prefix Path, pathCipher storj.CipherSuite, to *encryption.Store) (rotated int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if prefix.Bucket() == "" {
+		return 0, errs.New("rotation requires a bucket")
+	}
+
+	prefixKey, err := encryption.DerivePathKey(prefix.Bucket(), pathForKey(prefix.UnencryptedPath().Raw()), s.encStore)
+	if err != nil {
+		return 0, err
+	}
+	newPrefixKey, err := encryption.DerivePathKey(prefix.Bucket(), pathForKey(prefix.UnencryptedPath().Raw()), to)
+	if err != nil {
+		return 0, err
+	}
+
+	encPrefix, err := encryption.EncryptPath(prefix.Bucket(), prefix.UnencryptedPath(), pathCipher, s.encStore)
+	if err != nil {
+		return 0, err
+	}
+
+	// see List for why the last component is removed
+	if strings.HasSuffix(prefix.UnencryptedPath().Raw(), "/") {
+		lastSlashIdx := strings.LastIndex(encPrefix.Raw(), "/")
+		encPrefix = paths.NewEncrypted(encPrefix.Raw()[:lastSlashIdx])
+	}
+
+	segmentPrefix, err := createSegmentPath(ctx, -1, prefix.Bucket(), encPrefix)
+	if err != nil {
+		return 0, err
+	}
+
+	fullPrefix := prefix.UnencryptedPath().Raw()
+	if len(fullPrefix) > 0 && fullPrefix[len(fullPrefix)-1] != '/' {
+		fullPrefix += "/"
+	}
+
+	var pending []Path
+	startAfter := ""
+	for {
+		items, more, err := s.segments.List(ctx, segmentPrefix, startAfter, "", true, 0, meta.None)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, item := range items {
+			startAfter = item.Path
+
+			itemPath, err := encryption.DecryptPathRaw(item.Path, pathCipher, prefixKey)
+			if err != nil {
+				if _, newErr := encryption.DecryptPathRaw(item.Path, pathCipher, newPrefixKey); newErr == nil {
+					continue
+				}
+				return 0, err
+			}
+
+			pending = append(pending, CreatePath(prefix.Bucket(), paths.NewUnencrypted(fullPrefix+itemPath)))
+		}
+
+		if !more || len(items) == 0 {
+			break
+		}
+	}
+
+	for _, path := range pending {
+		if err := s.Rekey(ctx, path, pathCipher, to); err != nil {
+			return rotated, err
+		}
+		rotated++
+	}
+
+	return rotated, nil
+}
+
+// rewrapKey decrypts the content key of segmentMeta with derivedKey and
+// encrypts it with newDerivedKey under a new random nonce. Keys that are
+// already encrypted with newDerivedKey are returned as they are.
+func rewrapKey(segmentMeta *pb.SegmentMeta, cipher storj.CipherSuite, derivedKey, newDerivedKey *storj.Key) (contentKey *storj.Key, _ *pb.SegmentMeta, err error) {
+	if cipher == storj.EncNull {
+		return nil, segmentMeta, nil
+	}
+
+	encryptedKey, keyNonce := getEncryptedKeyAndNonce(segmentMeta)
+	contentKey, err = encryption.DecryptKey(encryptedKey, cipher, derivedKey, keyNonce)
+	if err != nil {
+		if contentKey, newErr := encryption.DecryptKey(encryptedKey, cipher, newDerivedKey, keyNonce); newErr == nil {
+			return contentKey, segmentMeta, nil
+		}
+		return nil, nil, err
+	}
+
+	var newKeyNonce storj.Nonce
+	if _, err := rand.Read(newKeyNonce[:]); err != nil {
+		return nil, nil, err
+	}
+
+	newEncryptedKey, err := encryption.EncryptKey(contentKey, cipher, newDerivedKey, &newKeyNonce)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return contentKey, &pb.SegmentMeta{
+		EncryptedKey: newEncryptedKey,
+		KeyNonce:     newKeyNonce[:],
+		// the segment info is encrypted with the content key, which is kept
+		EncryptedSegmentInfo: segmentMeta.EncryptedSegmentInfo,
+	}, nil
+}
@@ -18,9 +18,11 @@ import (
 type Store interface {
 	Meta(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite) (Meta, error)
 	Get(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite) (ranger.Ranger, Meta, error)
-	Put(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
+	Put(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time, compression storj.Compression) (Meta, error)
 	Delete(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite) error
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, pathCipher storj.CipherSuite, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+	Rekey(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, to *encryption.Store) error
+	Rotate(ctx context.Context, prefix storj.Path, pathCipher storj.CipherSuite, to *encryption.Store) (rotated int, err error)
 }
 
 type shimStore struct {
@@ -51,10 +53,10 @@ func (s *shimStore) Get(ctx context.Context, path storj.Path, pathCipher storj.C
 }
 
 // Put parses the passed in path and dispatches to the typed store.
-func (s *shimStore) Put(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (_ Meta, err error) {
+func (s *shimStore) Put(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time, compression storj.Compression) (_ Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	return s.store.Put(ctx, ParsePath(path), pathCipher, data, metadata, expiration)
+	return s.store.Put(ctx, ParsePath(path), pathCipher, data, metadata, expiration, compression)
 }
 
 // Delete parses the passed in path and dispatches to the typed store.
@@ -70,3 +72,17 @@ func (s *shimStore) List(ctx context.Context, prefix storj.Path, startAfter stor
 
 	return s.store.List(ctx, ParsePath(prefix), startAfter, endBefore, pathCipher, recursive, limit, metaFlags)
 }
+
+// Rekey parses the passed in path and dispatches to the typed store.
+func (s *shimStore) Rekey(ctx context.Context, path storj.Path, pathCipher storj.CipherSuite, to *encryption.Store) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.store.Rekey(ctx, ParsePath(path), pathCipher, to)
+}
+
+// Rotate parses the passed in prefix and dispatches to the typed store.
+func (s *shimStore) Rotate(ctx context.Context, prefix storj.Path, pathCipher storj.CipherSuite, to *encryption.Store) (rotated int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.store.Rotate(ctx, ParsePath(prefix), pathCipher, to)
+}
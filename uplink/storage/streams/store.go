@@ -6,7 +6,9 @@ package streams
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"crypto/rand"
+	"crypto/sha256"
 	"io"
 	"io/ioutil"
 	"strconv"
@@ -37,6 +39,10 @@ type Meta struct {
 	Expiration time.Time
 	Size       int64
 	Data       []byte
+	// Checksum is the SHA-256 of the stream's data, if known
+	Checksum []byte
+	// MD5Checksum is the MD5 of the stream's data, if known
+	MD5Checksum []byte
 }
 
 func numberOfSegments(stream *pb.StreamInfo, streamMeta *pb.StreamMeta) int64 {
@@ -46,13 +52,24 @@ func numberOfSegments(stream *pb.StreamInfo, streamMeta *pb.StreamMeta) int64 {
 	return stream.DeprecatedNumberOfSegments
 }
 
+// StreamSize returns the size of the stream's data, which is the size before
+// compression for compressed streams
+func StreamSize(stream *pb.StreamInfo, streamMeta *pb.StreamMeta) int64 {
+	if storj.Compression(streamMeta.CompressionType) != storj.CompressionNone {
+		return stream.UncompressedSize
+	}
+	return ((numberOfSegments(stream, streamMeta) - 1) * stream.SegmentsSize) + stream.LastSegmentSize
+}
+
 // convertMeta converts segment metadata to stream metadata
 func convertMeta(lastSegmentMeta segments.Meta, stream pb.StreamInfo, streamMeta pb.StreamMeta) Meta {
 	return Meta{
-		Modified:   lastSegmentMeta.Modified,
-		Expiration: lastSegmentMeta.Expiration,
-		Size:       ((numberOfSegments(&stream, &streamMeta) - 1) * stream.SegmentsSize) + stream.LastSegmentSize,
-		Data:       stream.Metadata,
+		Modified:    lastSegmentMeta.Modified,
+		Expiration:  lastSegmentMeta.Expiration,
+		Size:        StreamSize(&stream, &streamMeta),
+		Data:        stream.Metadata,
+		Checksum:    stream.Sha256Checksum,
+		MD5Checksum: stream.Md5Checksum,
 	}
 }
 
@@ -60,9 +77,11 @@ func convertMeta(lastSegmentMeta segments.Meta, stream pb.StreamInfo, streamMeta
 type typedStore interface {
 	Meta(ctx context.Context, path Path, pathCipher storj.CipherSuite) (Meta, error)
 	Get(ctx context.Context, path Path, pathCipher storj.CipherSuite) (ranger.Ranger, Meta, error)
-	Put(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
+	Put(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time, compression storj.Compression) (Meta, error)
 	Delete(ctx context.Context, path Path, pathCipher storj.CipherSuite) error
 	List(ctx context.Context, prefix Path, startAfter, endBefore string, pathCipher storj.CipherSuite, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+	Rekey(ctx context.Context, path Path, pathCipher storj.CipherSuite, to *encryption.Store) error
+	Rotate(ctx context.Context, prefix Path, pathCipher storj.CipherSuite, to *encryption.Store) (rotated int, err error)
 }
 
 // streamStore is a store for streams. It implements typedStore as part of an ongoing migration
@@ -99,7 +118,10 @@ func newTypedStreamStore(segments segments.Store, segmentSize int64, encStore *e
 // store the first piece at s0/<path>, second piece at s1/<path>, and the
 // *last* piece at l/<path>. Store the given metadata, along with the number
 // of segments, in a new protobuf, in the metadata of l/<path>.
-func (s *streamStore) Put(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (m Meta, err error) {
+//
+// Data is compressed before encryption unless compression is
+// storj.CompressionNone.
+func (s *streamStore) Put(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time, compression storj.Compression) (m Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	// previously file uploaded?
@@ -110,7 +132,7 @@ func (s *streamStore) Put(ctx context.Context, path Path, pathCipher storj.Ciphe
 		return Meta{}, err
 	}
 
-	m, lastSegment, err := s.upload(ctx, path, pathCipher, data, metadata, expiration)
+	m, lastSegment, err := s.upload(ctx, path, pathCipher, data, metadata, expiration, compression)
 	if err != nil {
 		s.cancelHandler(context.Background(), lastSegment, path, pathCipher)
 	}
@@ -118,7 +140,7 @@ func (s *streamStore) Put(ctx context.Context, path Path, pathCipher storj.Ciphe
 	return m, err
 }
 
-func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time) (m Meta, lastSegment int64, err error) {
+func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.CipherSuite, data io.Reader, metadata []byte, expiration time.Time, compression storj.Compression) (m Meta, lastSegment int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	var currentSegment int64
@@ -142,6 +164,11 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 		return Meta{}, currentSegment, err
 	}
 
+	// the checksums are computed over the data as given, before
+	// compression and encryption
+	sha256Hash, md5Hash := sha256.New(), md5.New()
+	data = io.TeeReader(data, io.MultiWriter(sha256Hash, md5Hash))
+
 	eofReader := NewEOFReader(data)
 
 	for !eofReader.isEOF() && !eofReader.hasError() {
@@ -179,8 +206,21 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 		}
 
 		sizeReader := NewSizeReader(eofReader)
-		segmentReader := io.LimitReader(sizeReader, s.segmentSize)
-		peekReader := segments.NewPeekThresholdReader(segmentReader)
+		var segmentReader io.Reader = io.LimitReader(sizeReader, s.segmentSize)
+
+		// every segment is compressed on its own, so that the block sizes
+		// of a segment are stored with it and not with the whole stream
+		var compressor *compressReader
+		if compression != storj.CompressionNone {
+			compressor, err = newCompressReader(segmentReader, compression, compressionBlockSize.Int())
+			if err != nil {
+				return Meta{}, currentSegment, err
+			}
+			segmentReader = compressor
+		}
+
+		storedReader := NewSizeReader(segmentReader)
+		peekReader := segments.NewPeekThresholdReader(storedReader)
 		// If the data is larger than the inline threshold size, then it will be a remote segment
 		isRemote, err := peekReader.IsLargerThan(s.inlineThreshold)
 		if err != nil {
@@ -209,19 +249,39 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 					return "", nil, err
 				}
 
-				if s.cipher == storj.EncNull {
+				if s.cipher == storj.EncNull && compressor == nil {
 					return segmentPath, nil, nil
 				}
 
-				segmentMeta, err := proto.Marshal(&pb.SegmentMeta{
-					EncryptedKey: encryptedKey,
-					KeyNonce:     keyNonce[:],
-				})
+				segmentMeta := &pb.SegmentMeta{}
+				if s.cipher != storj.EncNull {
+					segmentMeta.EncryptedKey = encryptedKey
+					segmentMeta.KeyNonce = keyNonce[:]
+				}
+
+				if compressor != nil {
+					segmentInfo, err := proto.Marshal(&pb.SegmentInfo{
+						CompressedBlockSizes: compressor.BlockSizes(),
+					})
+					if err != nil {
+						return "", nil, err
+					}
+
+					// encrypt segment info with the content encryption key and
+					// zero nonce, which isn't used for the content of segments
+					// other than the last one
+					segmentMeta.EncryptedSegmentInfo, err = encryption.Encrypt(segmentInfo, s.cipher, &contentKey, &storj.Nonce{})
+					if err != nil {
+						return "", nil, err
+					}
+				}
+
+				segmentMetaBytes, err := proto.Marshal(segmentMeta)
 				if err != nil {
 					return "", nil, err
 				}
 
-				return segmentPath, segmentMeta, nil
+				return segmentPath, segmentMetaBytes, nil
 			}
 
 			lastSegmentPath, err := createSegmentPath(ctx, -1, path.Bucket(), encPath)
@@ -229,12 +289,23 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 				return "", nil, err
 			}
 
-			streamInfo, err := proto.Marshal(&pb.StreamInfo{
+			info := &pb.StreamInfo{
 				DeprecatedNumberOfSegments: currentSegment + 1,
 				SegmentsSize:               s.segmentSize,
-				LastSegmentSize:            sizeReader.Size(),
+				LastSegmentSize:            storedReader.Size(),
 				Metadata:                   metadata,
-			})
+				Sha256Checksum:             sha256Hash.Sum(nil),
+				Md5Checksum:                md5Hash.Sum(nil),
+			}
+			if compressor != nil {
+				// the block sizes of the last segment are stored in the
+				// encrypted stream info, so that they don't reveal how well
+				// the content compresses
+				info.UncompressedSize = streamSize + compressor.Size()
+				info.CompressedBlockSizes = compressor.BlockSizes()
+			}
+
+			streamInfo, err := proto.Marshal(info)
 			if err != nil {
 				return "", nil, err
 			}
@@ -252,6 +323,11 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 				EncryptionBlockSize: int32(s.encBlockSize),
 			}
 
+			if compressor != nil {
+				streamMeta.CompressionType = int32(compression)
+				streamMeta.CompressionBlockSize = int32(compressionBlockSize)
+			}
+
 			if s.cipher != storj.EncNull {
 				streamMeta.LastSegmentMeta = &pb.SegmentMeta{
 					EncryptedKey: encryptedKey,
@@ -279,10 +355,12 @@ func (s *streamStore) upload(ctx context.Context, path Path, pathCipher storj.Ci
 	}
 
 	resultMeta := Meta{
-		Modified:   putMeta.Modified,
-		Expiration: expiration,
-		Size:       streamSize,
-		Data:       metadata,
+		Modified:    putMeta.Modified,
+		Expiration:  expiration,
+		Size:        streamSize,
+		Data:        metadata,
+		Checksum:    sha256Hash.Sum(nil),
+		MD5Checksum: md5Hash.Sum(nil),
 	}
 
 	return resultMeta, currentSegment, nil
@@ -325,8 +403,13 @@ func (s *streamStore) Get(ctx context.Context, path Path, pathCipher storj.Ciphe
 		return nil, Meta{}, err
 	}
 
+	cipher := storj.CipherSuite(streamMeta.EncryptionType)
+	compression := storj.Compression(streamMeta.CompressionType)
+	compressionBlockSize := int64(streamMeta.CompressionBlockSize)
+	segmentCount := numberOfSegments(&stream, &streamMeta)
+
 	var rangers []ranger.Ranger
-	for i := int64(0); i < numberOfSegments(&stream, &streamMeta)-1; i++ {
+	for i := int64(0); i < segmentCount-1; i++ {
 		currentPath, err := createSegmentPath(ctx, i, path.Bucket(), encPath)
 		if err != nil {
 			return nil, Meta{}, err
@@ -339,18 +422,20 @@ func (s *streamStore) Get(ctx context.Context, path Path, pathCipher storj.Ciphe
 		}
 
 		rangers = append(rangers, &lazySegmentRanger{
-			segments:      s.segments,
-			path:          currentPath,
-			size:          stream.SegmentsSize,
-			derivedKey:    derivedKey,
-			startingNonce: &contentNonce,
-			encBlockSize:  int(streamMeta.EncryptionBlockSize),
-			cipher:        storj.CipherSuite(streamMeta.EncryptionType),
+			segments:             s.segments,
+			path:                 currentPath,
+			size:                 stream.SegmentsSize,
+			derivedKey:           derivedKey,
+			startingNonce:        &contentNonce,
+			encBlockSize:         int(streamMeta.EncryptionBlockSize),
+			cipher:               cipher,
+			compression:          compression,
+			compressionBlockSize: compressionBlockSize,
 		})
 	}
 
 	var contentNonce storj.Nonce
-	_, err = encryption.Increment(&contentNonce, numberOfSegments(&stream, &streamMeta))
+	_, err = encryption.Increment(&contentNonce, segmentCount)
 	if err != nil {
 		return nil, Meta{}, err
 	}
@@ -360,7 +445,7 @@ func (s *streamStore) Get(ctx context.Context, path Path, pathCipher storj.Ciphe
 		ctx,
 		lastSegmentRanger,
 		stream.LastSegmentSize,
-		storj.CipherSuite(streamMeta.EncryptionType),
+		cipher,
 		derivedKey,
 		encryptedKey,
 		keyNonce,
@@ -371,8 +456,20 @@ func (s *streamStore) Get(ctx context.Context, path Path, pathCipher storj.Ciphe
 		return nil, Meta{}, err
 	}
 
+	if compression != storj.CompressionNone {
+		lastSegmentSize := stream.UncompressedSize - (segmentCount-1)*stream.SegmentsSize
+		if lastSegmentSize < 0 {
+			return nil, Meta{}, errs.New("invalid uncompressed size %d", stream.UncompressedSize)
+		}
+		decryptedLastSegmentRanger, err = newDecompressRanger(decryptedLastSegmentRanger, compression, compressionBlockSize, lastSegmentSize, stream.CompressedBlockSizes)
+		if err != nil {
+			return nil, Meta{}, err
+		}
+	}
+
 	rangers = append(rangers, decryptedLastSegmentRanger)
 	catRangers := ranger.Concat(rangers...)
+
 	meta = convertMeta(lastSegmentMeta, stream, streamMeta)
 	return catRangers, meta, nil
 }
@@ -576,6 +673,10 @@ type lazySegmentRanger struct {
 	startingNonce *storj.Nonce
 	encBlockSize  int
 	cipher        storj.CipherSuite
+	// compression of the segment, size is the uncompressed size of
+	// compressed segments
+	compression          storj.Compression
+	compressionBlockSize int64
 }
 
 // Size implements Ranger.Size
@@ -597,14 +698,57 @@ func (lr *lazySegmentRanger) Range(ctx context.Context, offset, length int64) (_
 			return nil, err
 		}
 		encryptedKey, keyNonce := getEncryptedKeyAndNonce(&segmentMeta)
-		lr.ranger, err = decryptRanger(ctx, rr, lr.size, lr.cipher, lr.derivedKey, encryptedKey, keyNonce, lr.startingNonce, lr.encBlockSize)
-		if err != nil {
-			return nil, err
+		if lr.compression == storj.CompressionNone {
+			lr.ranger, err = decryptRanger(ctx, rr, lr.size, lr.cipher, lr.derivedKey, encryptedKey, keyNonce, lr.startingNonce, lr.encBlockSize)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			lr.ranger, err = lr.decompressRanger(ctx, rr, &segmentMeta)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 	return lr.ranger.Range(ctx, offset, length)
 }
 
+// decompressRanger returns a decrypted and decompressed ranger of the given
+// rr ranger of a compressed segment with the given segment metadata
+func (lr *lazySegmentRanger) decompressRanger(ctx context.Context, rr ranger.Ranger, segmentMeta *pb.SegmentMeta) (_ ranger.Ranger, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	encryptedKey, keyNonce := getEncryptedKeyAndNonce(segmentMeta)
+	contentKey, err := encryption.DecryptKey(encryptedKey, lr.cipher, lr.derivedKey, keyNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	// decrypt segment info with the content encryption key and zero nonce
+	segmentInfo, err := encryption.Decrypt(segmentMeta.EncryptedSegmentInfo, lr.cipher, contentKey, &storj.Nonce{})
+	if err != nil {
+		return nil, err
+	}
+
+	info := pb.SegmentInfo{}
+	err = proto.Unmarshal(segmentInfo, &info)
+	if err != nil {
+		return nil, err
+	}
+
+	var compressedSize int64
+	for _, blockSize := range info.CompressedBlockSizes {
+		compressedSize += blockSize
+	}
+
+	decrypted, err := decryptRanger(ctx, rr, compressedSize, lr.cipher, lr.derivedKey, encryptedKey, keyNonce, lr.startingNonce, lr.encBlockSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDecompressRanger(decrypted, lr.compression, lr.compressionBlockSize, lr.size, info.CompressedBlockSizes)
+}
+
 // decryptRanger returns a decrypted ranger of the given rr ranger
 func decryptRanger(ctx context.Context, rr ranger.Ranger, decryptedSize int64, cipher storj.CipherSuite, derivedKey *storj.Key, encryptedKey storj.EncryptedPrivateKey, encryptedKeyNonce, startingNonce *storj.Nonce, encBlockSize int) (decrypted ranger.Ranger, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -8,6 +8,9 @@ import (
 	"encoding/json"
 	"net"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -17,10 +20,17 @@ import (
 	"storj.io/storj/internal/version"
 )
 
+// Error is the default error class for the Version Control Server
+var Error = errs.Class("versioncontrol")
+
 // Config is all the configuration parameters for a Version Control Server
 type Config struct {
-	Address  string `user:"true" help:"public address to listen on" default:":8080"`
-	Versions ServiceVersions
+	Address      string `user:"true" help:"public address to listen on" default:":8080"`
+	AdminAddress string `user:"true" help:"private address to listen on for adjusting rollouts, disabled if empty" default:"127.0.0.1:8081"`
+	Versions     ServiceVersions
+	Rollouts     ServiceRollouts
+
+	Binary Versions
 }
 
 // ServiceVersions provides a list of allowed Versions per Service
@@ -33,6 +43,40 @@ type ServiceVersions struct {
 	Identity    string `user:"true" help:"Allowed Identity Versions" default:"v0.0.1"`
 }
 
+// ServiceRollouts provides the staged rollout of a new version per Service
+type ServiceRollouts struct {
+	Bootstrap   Rollout
+	Satellite   Rollout
+	Storagenode Rollout
+	Uplink      Rollout
+	Gateway     Rollout
+	Identity    Rollout
+}
+
+// Rollout is the staged rollout of a version. The cursor can be adjusted on
+// the admin address while the server is running.
+type Rollout struct {
+	Version string `user:"true" help:"version the nodes inside the rollout are required to run, no rollout if empty" default:""`
+	Seed    string `user:"true" help:"seed selecting which nodes are inside the rollout" default:""`
+	Cursor  int    `user:"true" help:"percentage of nodes inside the rollout" default:"0"`
+}
+
+// Versions provides the releases of the binaries that are updated automatically
+type Versions struct {
+	Storagenode Binary
+}
+
+// Binary provides the releases of a binary
+type Binary struct {
+	Suggested Release
+}
+
+// Release is a release of a binary
+type Release struct {
+	Version string `user:"true" help:"version of the release" default:""`
+	URL     string `user:"true" help:"URL of the release's binary, may contain {os} and {arch}; its signature is at URL.sig" default:""`
+}
+
 // Peer is the representation of a VersionControl Server.
 type Peer struct {
 	// core dependencies
@@ -43,6 +87,13 @@ type Peer struct {
 		Endpoint http.Server
 		Listener net.Listener
 	}
+	// Admin server for adjusting rollouts
+	Admin struct {
+		Endpoint http.Server
+		Listener net.Listener
+	}
+
+	mu       sync.Mutex
 	Versions version.AllowedVersions
 
 	// response contains the byte version of current allowed versions
@@ -63,13 +114,89 @@ func (peer *Peer) HandleGet(w http.ResponseWriter, r *http.Request) {
 	}
 	peer.Log.Sugar().Debugf("Request from: %s for %s", r.RemoteAddr, xfor)
 
+	peer.mu.Lock()
+	response := peer.response
+	peer.mu.Unlock()
+
 	w.Header().Set("Content-Type", "application/json")
-	_, err := w.Write(peer.response)
+	_, err := w.Write(response)
 	if err != nil {
 		peer.Log.Sugar().Errorf("error writing response to client: %v", err)
 	}
 }
 
+// HandleRollout contains the request handler for adjusting the cursor of a
+// rollout, e.g. POST /rollouts/storagenode?cursor=50
+func (peer *Peer) HandleRollout(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	service := strings.TrimPrefix(r.URL.Path, "/rollouts/")
+	cursor, err := strconv.Atoi(r.FormValue("cursor"))
+	if err != nil {
+		http.Error(w, "invalid cursor", http.StatusBadRequest)
+		return
+	}
+
+	rollout, err := peer.SetRolloutCursor(service, cursor)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(rollout)
+	if err != nil {
+		peer.Log.Sugar().Errorf("error writing response to client: %v", err)
+	}
+}
+
+// SetRolloutCursor sets the percentage of nodes inside the rollout of the
+// service and returns the adjusted rollout
+func (peer *Peer) SetRolloutCursor(service string, cursor int) (version.Rollout, error) {
+	if cursor < 0 || cursor > 100 {
+		return version.Rollout{}, Error.New("cursor %d is not a percentage", cursor)
+	}
+
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+
+	var rollout *version.Rollout
+	switch strings.ToLower(service) {
+	case "bootstrap":
+		rollout = &peer.Versions.Rollouts.Bootstrap
+	case "satellite":
+		rollout = &peer.Versions.Rollouts.Satellite
+	case "storagenode":
+		rollout = &peer.Versions.Rollouts.Storagenode
+	case "uplink":
+		rollout = &peer.Versions.Rollouts.Uplink
+	case "gateway":
+		rollout = &peer.Versions.Rollouts.Gateway
+	case "identity":
+		rollout = &peer.Versions.Rollouts.Identity
+	default:
+		return version.Rollout{}, Error.New("unknown service %q", service)
+	}
+	if rollout.Version == (version.SemVer{}) {
+		return version.Rollout{}, Error.New("no rollout for %s", service)
+	}
+
+	previous := rollout.Cursor
+	rollout.Cursor = cursor
+	response, err := json.Marshal(peer.Versions)
+	if err != nil {
+		rollout.Cursor = previous
+		return version.Rollout{}, Error.Wrap(err)
+	}
+	peer.response = response
+
+	peer.Log.Sugar().Infof("set cursor of the %s rollout of %s to %d%%", service, rollout.Version.String(), cursor)
+	return *rollout, nil
+}
+
 // New creates a new VersionControl Server.
 func New(log *zap.Logger, config *Config) (peer *Peer, err error) {
 	peer = &Peer{
@@ -107,6 +234,46 @@ func New(log *zap.Logger, config *Config) (peer *Peer, err error) {
 		return &Peer{}, err
 	}
 
+	peer.Versions.Rollouts.Bootstrap, err = newRollout(config.Rollouts.Bootstrap)
+	if err != nil {
+		return &Peer{}, err
+	}
+
+	peer.Versions.Rollouts.Satellite, err = newRollout(config.Rollouts.Satellite)
+	if err != nil {
+		return &Peer{}, err
+	}
+
+	peer.Versions.Rollouts.Storagenode, err = newRollout(config.Rollouts.Storagenode)
+	if err != nil {
+		return &Peer{}, err
+	}
+
+	peer.Versions.Rollouts.Uplink, err = newRollout(config.Rollouts.Uplink)
+	if err != nil {
+		return &Peer{}, err
+	}
+
+	peer.Versions.Rollouts.Gateway, err = newRollout(config.Rollouts.Gateway)
+	if err != nil {
+		return &Peer{}, err
+	}
+
+	peer.Versions.Rollouts.Identity, err = newRollout(config.Rollouts.Identity)
+	if err != nil {
+		return &Peer{}, err
+	}
+
+	if config.Binary.Storagenode.Suggested.URL != "" {
+		if _, err := version.NewSemVer(config.Binary.Storagenode.Suggested.Version); err != nil {
+			return &Peer{}, err
+		}
+	}
+	peer.Versions.Processes.Storagenode.Suggested = version.Release{
+		Version: config.Binary.Storagenode.Suggested.Version,
+		URL:     config.Binary.Storagenode.Suggested.URL,
+	}
+
 	peer.response, err = json.Marshal(peer.Versions)
 
 	if err != nil {
@@ -125,9 +292,40 @@ func New(log *zap.Logger, config *Config) (peer *Peer, err error) {
 	if err != nil {
 		return nil, errs.Combine(err, peer.Close())
 	}
+
+	if config.AdminAddress != "" {
+		adminMux := http.NewServeMux()
+		adminMux.HandleFunc("/rollouts/", peer.HandleRollout)
+		peer.Admin.Endpoint = http.Server{
+			Handler: adminMux,
+		}
+
+		peer.Admin.Listener, err = net.Listen("tcp", config.AdminAddress)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
 	return peer, nil
 }
 
+// newRollout converts the configuration of a rollout
+func newRollout(config Rollout) (rollout version.Rollout, err error) {
+	if config.Version == "" {
+		return version.Rollout{}, nil
+	}
+	if config.Cursor < 0 || config.Cursor > 100 {
+		return version.Rollout{}, Error.New("rollout cursor %d is not a percentage", config.Cursor)
+	}
+
+	rollout.Version, err = version.NewSemVer(config.Version)
+	if err != nil {
+		return version.Rollout{}, err
+	}
+	rollout.Seed = config.Seed
+	rollout.Cursor = config.Cursor
+	return rollout, nil
+}
+
 // Run runs versioncontrol server until it's either closed or it errors.
 func (peer *Peer) Run(ctx context.Context) (err error) {
 
@@ -143,13 +341,30 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 		peer.Log.Sugar().Infof("Versioning server started on %s", peer.Addr())
 		return errs2.IgnoreCanceled(peer.Server.Endpoint.Serve(peer.Server.Listener))
 	})
+	if peer.Admin.Listener != nil {
+		group.Go(func() error {
+			<-ctx.Done()
+			return errs2.IgnoreCanceled(peer.Admin.Endpoint.Shutdown(ctx))
+		})
+		group.Go(func() error {
+			defer cancel()
+			peer.Log.Sugar().Infof("Versioning admin server started on %s", peer.AdminAddr())
+			return errs2.IgnoreCanceled(peer.Admin.Endpoint.Serve(peer.Admin.Listener))
+		})
+	}
 	return group.Wait()
 }
 
 // Close closes all the resources.
 func (peer *Peer) Close() (err error) {
-	return peer.Server.Endpoint.Close()
+	return errs.Combine(
+		peer.Server.Endpoint.Close(),
+		peer.Admin.Endpoint.Close(),
+	)
 }
 
 // Addr returns the public address.
 func (peer *Peer) Addr() string { return peer.Server.Listener.Addr().String() }
+
+// AdminAddr returns the private address for adjusting rollouts.
+func (peer *Peer) AdminAddr() string { return peer.Admin.Listener.Addr().String() }
@@ -0,0 +1,91 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package versioncontrol_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testrand"
+	"storj.io/storj/internal/version"
+	"storj.io/storj/versioncontrol"
+)
+
+func TestRollout(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	peer, err := versioncontrol.New(zaptest.NewLogger(t), &versioncontrol.Config{
+		Address:      "127.0.0.1:0",
+		AdminAddress: "127.0.0.1:0",
+		Versions: versioncontrol.ServiceVersions{
+			Bootstrap:   "v0.0.1",
+			Satellite:   "v0.0.1",
+			Storagenode: "v0.0.1",
+			Uplink:      "v0.0.1",
+			Gateway:     "v0.0.1",
+			Identity:    "v0.0.1",
+		},
+		Rollouts: versioncontrol.ServiceRollouts{
+			Storagenode: versioncontrol.Rollout{
+				Version: "v0.0.2",
+				Seed:    "seed",
+				Cursor:  0,
+			},
+		},
+	})
+	require.NoError(t, err)
+	ctx.Go(func() error { return peer.Run(ctx) })
+	defer ctx.Check(peer.Close)
+
+	config := version.Config{
+		ServerAddress:  "http://" + peer.Addr(),
+		RequestTimeout: 15 * time.Second,
+		CheckInterval:  time.Minute,
+	}
+	info := version.Info{
+		Version: version.SemVer{Major: 0, Minor: 0, Patch: 1},
+		Release: true,
+	}
+	nodeID := testrand.NodeID()
+
+	setCursor := func(service, cursor string) int {
+		resp, err := http.PostForm("http://"+peer.AdminAddr()+"/rollouts/"+service, url.Values{"cursor": {cursor}})
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+
+	{ // no node is inside the rollout yet
+		service := version.NewService(zaptest.NewLogger(t), config, info, nodeID, "Storagenode")
+		assert.NoError(t, service.CheckVersion(ctx))
+		assert.True(t, service.IsAllowed())
+	}
+
+	assert.Equal(t, http.StatusOK, setCursor("storagenode", "100"))
+
+	{ // every node is inside the rollout
+		service := version.NewService(zaptest.NewLogger(t), config, info, nodeID, "Storagenode")
+		assert.Error(t, service.CheckVersion(ctx))
+		assert.False(t, service.IsAllowed())
+
+		// the rollout doesn't apply to the other services
+		service = version.NewService(zaptest.NewLogger(t), config, info, nodeID, "Satellite")
+		assert.NoError(t, service.CheckVersion(ctx))
+	}
+
+	{ // invalid adjustments
+		assert.Equal(t, http.StatusBadRequest, setCursor("storagenode", "101"))
+		assert.Equal(t, http.StatusBadRequest, setCursor("storagenode", "all"))
+		assert.Equal(t, http.StatusBadRequest, setCursor("satellite", "50"))
+		assert.Equal(t, http.StatusBadRequest, setCursor("unknown", "50"))
+	}
+}
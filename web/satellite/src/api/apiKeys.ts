@@ -24,7 +24,11 @@ export class ApiKeysApiGql extends BaseGql implements ApiKeysApi {
                     apiKeys {
                         id,
                         name,
-                        createdAt
+                        createdAt,
+                        lastUsedAt,
+                        requestCount,
+                        bytesUploaded,
+                        bytesDownloaded
                     }
                 }
             }`;
@@ -102,6 +106,6 @@ export class ApiKeysApiGql extends BaseGql implements ApiKeysApi {
             return [];
         }
 
-        return apiKeys.map(key => new ApiKey(key.id, key.name, key.createdAt, ''));
+        return apiKeys.map(key => new ApiKey(key.id, key.name, key.createdAt, '', key.lastUsedAt, key.requestCount, key.bytesUploaded, key.bytesDownloaded));
     }
 }
@@ -36,13 +36,22 @@ export class ApiKey {
     public secret: string;
     public name: string;
     public createdAt: string;
+    public lastUsedAt: string;
+    public requestCount: number;
+    public bytesUploaded: number;
+    public bytesDownloaded: number;
     public isSelected: boolean = false;
 
-    constructor(id: string, name: string, createdAt: string, secret: string) {
+    constructor(id: string, name: string, createdAt: string, secret: string,
+                lastUsedAt: string = '', requestCount: number = 0, bytesUploaded: number = 0, bytesDownloaded: number = 0) {
         this.id = id || '';
         this.name = name || '';
         this.createdAt = createdAt || '';
         this.secret = secret || '';
+        this.lastUsedAt = lastUsedAt || '';
+        this.requestCount = requestCount || 0;
+        this.bytesUploaded = bytesUploaded || 0;
+        this.bytesDownloaded = bytesDownloaded || 0;
 
         this.isSelected = false;
     }
@@ -64,4 +73,12 @@ export class ApiKey {
 
         return new Date(this.createdAt).toLocaleDateString();
     }
+
+    public getLastUsed(): string {
+        if (!this.lastUsedAt) {
+            return 'Never';
+        }
+
+        return new Date(this.lastUsedAt).toLocaleString();
+    }
 }